@@ -0,0 +1,1444 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: auth/v1/admin.proto
+
+package authv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserStatus int32
+
+const (
+	UserStatus_USER_STATUS_UNSPECIFIED UserStatus = 0
+	UserStatus_USER_STATUS_ACTIVE      UserStatus = 1
+	UserStatus_USER_STATUS_SUSPENDED   UserStatus = 2
+	UserStatus_USER_STATUS_BANNED      UserStatus = 3
+)
+
+// Enum value maps for UserStatus.
+var (
+	UserStatus_name = map[int32]string{
+		0: "USER_STATUS_UNSPECIFIED",
+		1: "USER_STATUS_ACTIVE",
+		2: "USER_STATUS_SUSPENDED",
+		3: "USER_STATUS_BANNED",
+	}
+	UserStatus_value = map[string]int32{
+		"USER_STATUS_UNSPECIFIED": 0,
+		"USER_STATUS_ACTIVE":      1,
+		"USER_STATUS_SUSPENDED":   2,
+		"USER_STATUS_BANNED":      3,
+	}
+)
+
+func (x UserStatus) Enum() *UserStatus {
+	p := new(UserStatus)
+	*p = x
+	return p
+}
+
+func (x UserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_v1_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (UserStatus) Type() protoreflect.EnumType {
+	return &file_auth_v1_admin_proto_enumTypes[0]
+}
+
+func (x UserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserStatus.Descriptor instead.
+func (UserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type AdminUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_auth_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AdminUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AdminUser) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *AdminUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type UserSuspension struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Reason string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// not set for permanent bans
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	SuspendedBy   *string                `protobuf:"bytes,3,opt,name=suspended_by,json=suspendedBy,proto3,oneof" json:"suspended_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSuspension) Reset() {
+	*x = UserSuspension{}
+	mi := &file_auth_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSuspension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSuspension) ProtoMessage() {}
+
+func (x *UserSuspension) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSuspension.ProtoReflect.Descriptor instead.
+func (*UserSuspension) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserSuspension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserSuspension) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UserSuspension) GetSuspendedBy() string {
+	if x != nil && x.SuspendedBy != nil {
+		return *x.SuspendedBy
+	}
+	return ""
+}
+
+func (x *UserSuspension) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prefixes of the fields
+	UsernamePattern *string `protobuf:"bytes,1,opt,name=username_pattern,json=usernamePattern,proto3,oneof" json:"username_pattern,omitempty"`
+	EmailPattern    *string `protobuf:"bytes,2,opt,name=email_pattern,json=emailPattern,proto3,oneof" json:"email_pattern,omitempty"`
+	IdPattern       *string `protobuf:"bytes,3,opt,name=id_pattern,json=idPattern,proto3,oneof" json:"id_pattern,omitempty"`
+	IsAdmin         *bool   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3,oneof" json:"is_admin,omitempty"`
+	// any status if unspecified
+	Status UserStatus `protobuf:"varint,5,opt,name=status,proto3,enum=auth.v1.UserStatus" json:"status,omitempty"`
+	Limit  int32      `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32      `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	// created_at (default) or name
+	OrderBy string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// desc (default) or asc
+	OrderDirection string `protobuf:"bytes,9,opt,name=order_direction,json=orderDirection,proto3" json:"order_direction,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchUsersRequest) GetUsernamePattern() string {
+	if x != nil && x.UsernamePattern != nil {
+		return *x.UsernamePattern
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetEmailPattern() string {
+	if x != nil && x.EmailPattern != nil {
+		return *x.EmailPattern
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetIdPattern() string {
+	if x != nil && x.IdPattern != nil {
+		return *x.IdPattern
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetIsAdmin() bool {
+	if x != nil && x.IsAdmin != nil {
+		return *x.IsAdmin
+	}
+	return false
+}
+
+func (x *SearchUsersRequest) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetOrderDirection() string {
+	if x != nil {
+		return x.OrderDirection
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*AdminUser           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetUserDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDetailsRequest) Reset() {
+	*x = GetUserDetailsRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailsRequest) ProtoMessage() {}
+
+func (x *GetUserDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserDetailsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserDetailsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	User   *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Status UserStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=auth.v1.UserStatus" json:"status,omitempty"`
+	// not set if the user has never been suspended or the suspension was lifted
+	Suspension    *UserSuspension `protobuf:"bytes,3,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	Sessions      []*Session      `protobuf:"bytes,4,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDetailsResponse) Reset() {
+	*x = GetUserDetailsResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailsResponse) ProtoMessage() {}
+
+func (x *GetUserDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUserDetailsResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserDetailsResponse) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *GetUserDetailsResponse) GetSuspension() *UserSuspension {
+	if x != nil {
+		return x.Suspension
+	}
+	return nil
+}
+
+func (x *GetUserDetailsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// SuspendUser signs the user out everywhere and refuses new sessions until the time.
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+// BanUser suspends the user until UnbanUser is called.
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BanUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserResponse) ProtoMessage() {}
+
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+// UnbanUser lifts both suspensions and bans.
+type UnbanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserRequest) Reset() {
+	*x = UnbanUserRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserRequest) ProtoMessage() {}
+
+func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnbanUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnbanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserResponse) Reset() {
+	*x = UnbanUserResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserResponse) ProtoMessage() {}
+
+func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
+func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+type SetUserAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,2,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserAdminRequest) Reset() {
+	*x = SetUserAdminRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserAdminRequest) ProtoMessage() {}
+
+func (x *SetUserAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserAdminRequest.ProtoReflect.Descriptor instead.
+func (*SetUserAdminRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetUserAdminRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserAdminRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type SetUserAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserAdminResponse) Reset() {
+	*x = SetUserAdminResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserAdminResponse) ProtoMessage() {}
+
+func (x *SetUserAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserAdminResponse.ProtoReflect.Descriptor instead.
+func (*SetUserAdminResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+// DeleteUser schedules the deletion, the user can cancel it during the grace period.
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletion      *AccountDeletion       `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteUserResponse) GetDeletion() *AccountDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+type GetUserDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDeletionRequest) Reset() {
+	*x = GetUserDeletionRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDeletionRequest) ProtoMessage() {}
+
+func (x *GetUserDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDeletionRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserDeletionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletion      *AccountDeletion       `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDeletionResponse) Reset() {
+	*x = GetUserDeletionResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDeletionResponse) ProtoMessage() {}
+
+func (x *GetUserDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDeletionResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserDeletionResponse) GetDeletion() *AccountDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+type AdminSecurityEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *SecurityEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// not set if the event could not be attributed to a user, e.g. sign in with unknown email
+	UserId *string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// the administrator who made the action
+	ActorId       *string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSecurityEvent) Reset() {
+	*x = AdminSecurityEvent{}
+	mi := &file_auth_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSecurityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSecurityEvent) ProtoMessage() {}
+
+func (x *AdminSecurityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSecurityEvent.ProtoReflect.Descriptor instead.
+func (*AdminSecurityEvent) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AdminSecurityEvent) GetEvent() *SecurityEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *AdminSecurityEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *AdminSecurityEvent) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+type SearchSecurityEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events of all users if not set
+	UserId *string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// events of any type if empty
+	Types         []string               `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSecurityEventsRequest) Reset() {
+	*x = SearchSecurityEventsRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSecurityEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecurityEventsRequest) ProtoMessage() {}
+
+func (x *SearchSecurityEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSecurityEventsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSecurityEventsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchSecurityEventsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *SearchSecurityEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchSecurityEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchSecurityEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SearchSecurityEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchSecurityEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchSecurityEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most recent first
+	Events        []*AdminSecurityEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSecurityEventsResponse) Reset() {
+	*x = SearchSecurityEventsResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSecurityEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecurityEventsResponse) ProtoMessage() {}
+
+func (x *SearchSecurityEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSecurityEventsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSecurityEventsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchSecurityEventsResponse) GetEvents() []*AdminSecurityEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_auth_v1_admin_proto protoreflect.FileDescriptor
+
+const file_auth_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x13auth/v1/admin.proto\x12\aauth.v1\x1a\x12auth/v1/auth.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x01\n" +
+	"\tAdminUser\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.auth.v1.UserR\x04user\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xeb\x01\n" +
+	"\x0eUserSuspension\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12>\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12&\n" +
+	"\fsuspended_by\x18\x03 \x01(\tH\x01R\vsuspendedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_expires_atB\x0f\n" +
+	"\r_suspended_by\"\xe1\x03\n" +
+	"\x12SearchUsersRequest\x12.\n" +
+	"\x10username_pattern\x18\x01 \x01(\tH\x00R\x0fusernamePattern\x88\x01\x01\x12(\n" +
+	"\remail_pattern\x18\x02 \x01(\tH\x01R\femailPattern\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"id_pattern\x18\x03 \x01(\tH\x02R\tidPattern\x88\x01\x01\x12\x1e\n" +
+	"\bis_admin\x18\x04 \x01(\bH\x03R\aisAdmin\x88\x01\x01\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x13.auth.v1.UserStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12\x1f\n" +
+	"\x05limit\x18\x06 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06offset\x124\n" +
+	"\border_by\x18\b \x01(\tB\x19\xbaH\x16r\x14R\x00R\n" +
+	"created_atR\x04nameR\aorderBy\x12;\n" +
+	"\x0forder_direction\x18\t \x01(\tB\x12\xbaH\x0fr\rR\x00R\x03ascR\x04descR\x0eorderDirectionB\x13\n" +
+	"\x11_username_patternB\x10\n" +
+	"\x0e_email_patternB\r\n" +
+	"\v_id_patternB\v\n" +
+	"\t_is_admin\"`\n" +
+	"\x13SearchUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.auth.v1.AdminUserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\":\n" +
+	"\x15GetUserDetailsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"\xd4\x01\n" +
+	"\x16GetUserDetailsResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.auth.v1.AdminUserR\x04user\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.auth.v1.UserStatusR\x06status\x127\n" +
+	"\n" +
+	"suspension\x18\x03 \x01(\v2\x17.auth.v1.UserSuspensionR\n" +
+	"suspension\x12,\n" +
+	"\bsessions\x18\x04 \x03(\v2\x10.auth.v1.SessionR\bsessions\"\x95\x01\n" +
+	"\x12SuspendUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x06reason\x128\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x05until\"\x15\n" +
+	"\x13SuspendUserResponse\"W\n" +
+	"\x0eBanUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x06reason\"\x11\n" +
+	"\x0fBanUserResponse\"5\n" +
+	"\x10UnbanUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"\x13\n" +
+	"\x11UnbanUserResponse\"S\n" +
+	"\x13SetUserAdminRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x19\n" +
+	"\bis_admin\x18\x02 \x01(\bR\aisAdmin\"\x16\n" +
+	"\x14SetUserAdminResponse\"6\n" +
+	"\x11DeleteUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"J\n" +
+	"\x12DeleteUserResponse\x124\n" +
+	"\bdeletion\x18\x01 \x01(\v2\x18.auth.v1.AccountDeletionR\bdeletion\";\n" +
+	"\x16GetUserDeletionRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"O\n" +
+	"\x17GetUserDeletionResponse\x124\n" +
+	"\bdeletion\x18\x01 \x01(\v2\x18.auth.v1.AccountDeletionR\bdeletion\"\x99\x01\n" +
+	"\x12AdminSecurityEvent\x12,\n" +
+	"\x05event\x18\x01 \x01(\v2\x16.auth.v1.SecurityEventR\x05event\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x1e\n" +
+	"\bactor_id\x18\x03 \x01(\tH\x01R\aactorId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\v\n" +
+	"\t_actor_id\"\x9f\x02\n" +
+	"\x1bSearchSecurityEventsRequest\x12&\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x123\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x02to\x88\x01\x01\x12\x1f\n" +
+	"\x05limit\x18\x05 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06offsetB\n" +
+	"\n" +
+	"\b_user_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"S\n" +
+	"\x1cSearchSecurityEventsResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.auth.v1.AdminSecurityEventR\x06events*t\n" +
+	"\n" +
+	"UserStatus\x12\x1b\n" +
+	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15USER_STATUS_SUSPENDED\x10\x02\x12\x16\n" +
+	"\x12USER_STATUS_BANNED\x10\x032\xc6\x05\n" +
+	"\fAdminService\x12H\n" +
+	"\vSearchUsers\x12\x1b.auth.v1.SearchUsersRequest\x1a\x1c.auth.v1.SearchUsersResponse\x12Q\n" +
+	"\x0eGetUserDetails\x12\x1e.auth.v1.GetUserDetailsRequest\x1a\x1f.auth.v1.GetUserDetailsResponse\x12H\n" +
+	"\vSuspendUser\x12\x1b.auth.v1.SuspendUserRequest\x1a\x1c.auth.v1.SuspendUserResponse\x12<\n" +
+	"\aBanUser\x12\x17.auth.v1.BanUserRequest\x1a\x18.auth.v1.BanUserResponse\x12B\n" +
+	"\tUnbanUser\x12\x19.auth.v1.UnbanUserRequest\x1a\x1a.auth.v1.UnbanUserResponse\x12K\n" +
+	"\fSetUserAdmin\x12\x1c.auth.v1.SetUserAdminRequest\x1a\x1d.auth.v1.SetUserAdminResponse\x12E\n" +
+	"\n" +
+	"DeleteUser\x12\x1a.auth.v1.DeleteUserRequest\x1a\x1b.auth.v1.DeleteUserResponse\x12T\n" +
+	"\x0fGetUserDeletion\x12\x1f.auth.v1.GetUserDeletionRequest\x1a .auth.v1.GetUserDeletionResponse\x12c\n" +
+	"\x14SearchSecurityEvents\x12$.auth.v1.SearchSecurityEventsRequest\x1a%.auth.v1.SearchSecurityEventsResponseBAZ?github.com/tech-inspire/api-contracts/api/gen/go/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_admin_proto_rawDescOnce sync.Once
+	file_auth_v1_admin_proto_rawDescData []byte
+)
+
+func file_auth_v1_admin_proto_rawDescGZIP() []byte {
+	file_auth_v1_admin_proto_rawDescOnce.Do(func() {
+		file_auth_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_admin_proto_rawDesc), len(file_auth_v1_admin_proto_rawDesc)))
+	})
+	return file_auth_v1_admin_proto_rawDescData
+}
+
+var file_auth_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_auth_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_auth_v1_admin_proto_goTypes = []any{
+	(UserStatus)(0),                      // 0: auth.v1.UserStatus
+	(*AdminUser)(nil),                    // 1: auth.v1.AdminUser
+	(*UserSuspension)(nil),               // 2: auth.v1.UserSuspension
+	(*SearchUsersRequest)(nil),           // 3: auth.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),          // 4: auth.v1.SearchUsersResponse
+	(*GetUserDetailsRequest)(nil),        // 5: auth.v1.GetUserDetailsRequest
+	(*GetUserDetailsResponse)(nil),       // 6: auth.v1.GetUserDetailsResponse
+	(*SuspendUserRequest)(nil),           // 7: auth.v1.SuspendUserRequest
+	(*SuspendUserResponse)(nil),          // 8: auth.v1.SuspendUserResponse
+	(*BanUserRequest)(nil),               // 9: auth.v1.BanUserRequest
+	(*BanUserResponse)(nil),              // 10: auth.v1.BanUserResponse
+	(*UnbanUserRequest)(nil),             // 11: auth.v1.UnbanUserRequest
+	(*UnbanUserResponse)(nil),            // 12: auth.v1.UnbanUserResponse
+	(*SetUserAdminRequest)(nil),          // 13: auth.v1.SetUserAdminRequest
+	(*SetUserAdminResponse)(nil),         // 14: auth.v1.SetUserAdminResponse
+	(*DeleteUserRequest)(nil),            // 15: auth.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),           // 16: auth.v1.DeleteUserResponse
+	(*GetUserDeletionRequest)(nil),       // 17: auth.v1.GetUserDeletionRequest
+	(*GetUserDeletionResponse)(nil),      // 18: auth.v1.GetUserDeletionResponse
+	(*AdminSecurityEvent)(nil),           // 19: auth.v1.AdminSecurityEvent
+	(*SearchSecurityEventsRequest)(nil),  // 20: auth.v1.SearchSecurityEventsRequest
+	(*SearchSecurityEventsResponse)(nil), // 21: auth.v1.SearchSecurityEventsResponse
+	(*User)(nil),                         // 22: auth.v1.User
+	(*timestamppb.Timestamp)(nil),        // 23: google.protobuf.Timestamp
+	(*Session)(nil),                      // 24: auth.v1.Session
+	(*AccountDeletion)(nil),              // 25: auth.v1.AccountDeletion
+	(*SecurityEvent)(nil),                // 26: auth.v1.SecurityEvent
+}
+var file_auth_v1_admin_proto_depIdxs = []int32{
+	22, // 0: auth.v1.AdminUser.user:type_name -> auth.v1.User
+	23, // 1: auth.v1.AdminUser.created_at:type_name -> google.protobuf.Timestamp
+	23, // 2: auth.v1.UserSuspension.expires_at:type_name -> google.protobuf.Timestamp
+	23, // 3: auth.v1.UserSuspension.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: auth.v1.SearchUsersRequest.status:type_name -> auth.v1.UserStatus
+	1,  // 5: auth.v1.SearchUsersResponse.users:type_name -> auth.v1.AdminUser
+	1,  // 6: auth.v1.GetUserDetailsResponse.user:type_name -> auth.v1.AdminUser
+	0,  // 7: auth.v1.GetUserDetailsResponse.status:type_name -> auth.v1.UserStatus
+	2,  // 8: auth.v1.GetUserDetailsResponse.suspension:type_name -> auth.v1.UserSuspension
+	24, // 9: auth.v1.GetUserDetailsResponse.sessions:type_name -> auth.v1.Session
+	23, // 10: auth.v1.SuspendUserRequest.until:type_name -> google.protobuf.Timestamp
+	25, // 11: auth.v1.DeleteUserResponse.deletion:type_name -> auth.v1.AccountDeletion
+	25, // 12: auth.v1.GetUserDeletionResponse.deletion:type_name -> auth.v1.AccountDeletion
+	26, // 13: auth.v1.AdminSecurityEvent.event:type_name -> auth.v1.SecurityEvent
+	23, // 14: auth.v1.SearchSecurityEventsRequest.from:type_name -> google.protobuf.Timestamp
+	23, // 15: auth.v1.SearchSecurityEventsRequest.to:type_name -> google.protobuf.Timestamp
+	19, // 16: auth.v1.SearchSecurityEventsResponse.events:type_name -> auth.v1.AdminSecurityEvent
+	3,  // 17: auth.v1.AdminService.SearchUsers:input_type -> auth.v1.SearchUsersRequest
+	5,  // 18: auth.v1.AdminService.GetUserDetails:input_type -> auth.v1.GetUserDetailsRequest
+	7,  // 19: auth.v1.AdminService.SuspendUser:input_type -> auth.v1.SuspendUserRequest
+	9,  // 20: auth.v1.AdminService.BanUser:input_type -> auth.v1.BanUserRequest
+	11, // 21: auth.v1.AdminService.UnbanUser:input_type -> auth.v1.UnbanUserRequest
+	13, // 22: auth.v1.AdminService.SetUserAdmin:input_type -> auth.v1.SetUserAdminRequest
+	15, // 23: auth.v1.AdminService.DeleteUser:input_type -> auth.v1.DeleteUserRequest
+	17, // 24: auth.v1.AdminService.GetUserDeletion:input_type -> auth.v1.GetUserDeletionRequest
+	20, // 25: auth.v1.AdminService.SearchSecurityEvents:input_type -> auth.v1.SearchSecurityEventsRequest
+	4,  // 26: auth.v1.AdminService.SearchUsers:output_type -> auth.v1.SearchUsersResponse
+	6,  // 27: auth.v1.AdminService.GetUserDetails:output_type -> auth.v1.GetUserDetailsResponse
+	8,  // 28: auth.v1.AdminService.SuspendUser:output_type -> auth.v1.SuspendUserResponse
+	10, // 29: auth.v1.AdminService.BanUser:output_type -> auth.v1.BanUserResponse
+	12, // 30: auth.v1.AdminService.UnbanUser:output_type -> auth.v1.UnbanUserResponse
+	14, // 31: auth.v1.AdminService.SetUserAdmin:output_type -> auth.v1.SetUserAdminResponse
+	16, // 32: auth.v1.AdminService.DeleteUser:output_type -> auth.v1.DeleteUserResponse
+	18, // 33: auth.v1.AdminService.GetUserDeletion:output_type -> auth.v1.GetUserDeletionResponse
+	21, // 34: auth.v1.AdminService.SearchSecurityEvents:output_type -> auth.v1.SearchSecurityEventsResponse
+	26, // [26:35] is the sub-list for method output_type
+	17, // [17:26] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_admin_proto_init() }
+func file_auth_v1_admin_proto_init() {
+	if File_auth_v1_admin_proto != nil {
+		return
+	}
+	file_auth_v1_auth_proto_init()
+	file_auth_v1_admin_proto_msgTypes[1].OneofWrappers = []any{}
+	file_auth_v1_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	file_auth_v1_admin_proto_msgTypes[18].OneofWrappers = []any{}
+	file_auth_v1_admin_proto_msgTypes[19].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_admin_proto_rawDesc), len(file_auth_v1_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_admin_proto_goTypes,
+		DependencyIndexes: file_auth_v1_admin_proto_depIdxs,
+		EnumInfos:         file_auth_v1_admin_proto_enumTypes,
+		MessageInfos:      file_auth_v1_admin_proto_msgTypes,
+	}.Build()
+	File_auth_v1_admin_proto = out.File
+	file_auth_v1_admin_proto_goTypes = nil
+	file_auth_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: auth/v1/admin.proto
+
+package authv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "auth.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceSearchUsersProcedure is the fully-qualified name of the AdminService's SearchUsers
+	// RPC.
+	AdminServiceSearchUsersProcedure = "/auth.v1.AdminService/SearchUsers"
+	// AdminServiceGetUserDetailsProcedure is the fully-qualified name of the AdminService's
+	// GetUserDetails RPC.
+	AdminServiceGetUserDetailsProcedure = "/auth.v1.AdminService/GetUserDetails"
+	// AdminServiceSuspendUserProcedure is the fully-qualified name of the AdminService's SuspendUser
+	// RPC.
+	AdminServiceSuspendUserProcedure = "/auth.v1.AdminService/SuspendUser"
+	// AdminServiceBanUserProcedure is the fully-qualified name of the AdminService's BanUser RPC.
+	AdminServiceBanUserProcedure = "/auth.v1.AdminService/BanUser"
+	// AdminServiceUnbanUserProcedure is the fully-qualified name of the AdminService's UnbanUser RPC.
+	AdminServiceUnbanUserProcedure = "/auth.v1.AdminService/UnbanUser"
+	// AdminServiceSetUserAdminProcedure is the fully-qualified name of the AdminService's SetUserAdmin
+	// RPC.
+	AdminServiceSetUserAdminProcedure = "/auth.v1.AdminService/SetUserAdmin"
+	// AdminServiceDeleteUserProcedure is the fully-qualified name of the AdminService's DeleteUser RPC.
+	AdminServiceDeleteUserProcedure = "/auth.v1.AdminService/DeleteUser"
+	// AdminServiceGetUserDeletionProcedure is the fully-qualified name of the AdminService's
+	// GetUserDeletion RPC.
+	AdminServiceGetUserDeletionProcedure = "/auth.v1.AdminService/GetUserDeletion"
+	// AdminServiceSearchSecurityEventsProcedure is the fully-qualified name of the AdminService's
+	// SearchSecurityEvents RPC.
+	AdminServiceSearchSecurityEventsProcedure = "/auth.v1.AdminService/SearchSecurityEvents"
+)
+
+// AdminServiceClient is a client for the auth.v1.AdminService service.
+type AdminServiceClient interface {
+	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
+	GetUserDetails(context.Context, *connect.Request[v1.GetUserDetailsRequest]) (*connect.Response[v1.GetUserDetailsResponse], error)
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error)
+	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
+	UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error)
+	SetUserAdmin(context.Context, *connect.Request[v1.SetUserAdminRequest]) (*connect.Response[v1.SetUserAdminResponse], error)
+	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	GetUserDeletion(context.Context, *connect.Request[v1.GetUserDeletionRequest]) (*connect.Response[v1.GetUserDeletionResponse], error)
+	SearchSecurityEvents(context.Context, *connect.Request[v1.SearchSecurityEventsRequest]) (*connect.Response[v1.SearchSecurityEventsResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the auth.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_auth_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		searchUsers: connect.NewClient[v1.SearchUsersRequest, v1.SearchUsersResponse](
+			httpClient,
+			baseURL+AdminServiceSearchUsersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SearchUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserDetails: connect.NewClient[v1.GetUserDetailsRequest, v1.GetUserDetailsResponse](
+			httpClient,
+			baseURL+AdminServiceGetUserDetailsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetUserDetails")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendUser: connect.NewClient[v1.SuspendUserRequest, v1.SuspendUserResponse](
+			httpClient,
+			baseURL+AdminServiceSuspendUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SuspendUser")),
+			connect.WithClientOptions(opts...),
+		),
+		banUser: connect.NewClient[v1.BanUserRequest, v1.BanUserResponse](
+			httpClient,
+			baseURL+AdminServiceBanUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("BanUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unbanUser: connect.NewClient[v1.UnbanUserRequest, v1.UnbanUserResponse](
+			httpClient,
+			baseURL+AdminServiceUnbanUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UnbanUser")),
+			connect.WithClientOptions(opts...),
+		),
+		setUserAdmin: connect.NewClient[v1.SetUserAdminRequest, v1.SetUserAdminResponse](
+			httpClient,
+			baseURL+AdminServiceSetUserAdminProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetUserAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUser: connect.NewClient[v1.DeleteUserRequest, v1.DeleteUserResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteUser")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserDeletion: connect.NewClient[v1.GetUserDeletionRequest, v1.GetUserDeletionResponse](
+			httpClient,
+			baseURL+AdminServiceGetUserDeletionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetUserDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		searchSecurityEvents: connect.NewClient[v1.SearchSecurityEventsRequest, v1.SearchSecurityEventsResponse](
+			httpClient,
+			baseURL+AdminServiceSearchSecurityEventsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SearchSecurityEvents")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	searchUsers          *connect.Client[v1.SearchUsersRequest, v1.SearchUsersResponse]
+	getUserDetails       *connect.Client[v1.GetUserDetailsRequest, v1.GetUserDetailsResponse]
+	suspendUser          *connect.Client[v1.SuspendUserRequest, v1.SuspendUserResponse]
+	banUser              *connect.Client[v1.BanUserRequest, v1.BanUserResponse]
+	unbanUser            *connect.Client[v1.UnbanUserRequest, v1.UnbanUserResponse]
+	setUserAdmin         *connect.Client[v1.SetUserAdminRequest, v1.SetUserAdminResponse]
+	deleteUser           *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	getUserDeletion      *connect.Client[v1.GetUserDeletionRequest, v1.GetUserDeletionResponse]
+	searchSecurityEvents *connect.Client[v1.SearchSecurityEventsRequest, v1.SearchSecurityEventsResponse]
+}
+
+// SearchUsers calls auth.v1.AdminService.SearchUsers.
+func (c *adminServiceClient) SearchUsers(ctx context.Context, req *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
+	return c.searchUsers.CallUnary(ctx, req)
+}
+
+// GetUserDetails calls auth.v1.AdminService.GetUserDetails.
+func (c *adminServiceClient) GetUserDetails(ctx context.Context, req *connect.Request[v1.GetUserDetailsRequest]) (*connect.Response[v1.GetUserDetailsResponse], error) {
+	return c.getUserDetails.CallUnary(ctx, req)
+}
+
+// SuspendUser calls auth.v1.AdminService.SuspendUser.
+func (c *adminServiceClient) SuspendUser(ctx context.Context, req *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error) {
+	return c.suspendUser.CallUnary(ctx, req)
+}
+
+// BanUser calls auth.v1.AdminService.BanUser.
+func (c *adminServiceClient) BanUser(ctx context.Context, req *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error) {
+	return c.banUser.CallUnary(ctx, req)
+}
+
+// UnbanUser calls auth.v1.AdminService.UnbanUser.
+func (c *adminServiceClient) UnbanUser(ctx context.Context, req *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error) {
+	return c.unbanUser.CallUnary(ctx, req)
+}
+
+// SetUserAdmin calls auth.v1.AdminService.SetUserAdmin.
+func (c *adminServiceClient) SetUserAdmin(ctx context.Context, req *connect.Request[v1.SetUserAdminRequest]) (*connect.Response[v1.SetUserAdminResponse], error) {
+	return c.setUserAdmin.CallUnary(ctx, req)
+}
+
+// DeleteUser calls auth.v1.AdminService.DeleteUser.
+func (c *adminServiceClient) DeleteUser(ctx context.Context, req *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
+	return c.deleteUser.CallUnary(ctx, req)
+}
+
+// GetUserDeletion calls auth.v1.AdminService.GetUserDeletion.
+func (c *adminServiceClient) GetUserDeletion(ctx context.Context, req *connect.Request[v1.GetUserDeletionRequest]) (*connect.Response[v1.GetUserDeletionResponse], error) {
+	return c.getUserDeletion.CallUnary(ctx, req)
+}
+
+// SearchSecurityEvents calls auth.v1.AdminService.SearchSecurityEvents.
+func (c *adminServiceClient) SearchSecurityEvents(ctx context.Context, req *connect.Request[v1.SearchSecurityEventsRequest]) (*connect.Response[v1.SearchSecurityEventsResponse], error) {
+	return c.searchSecurityEvents.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the auth.v1.AdminService service.
+type AdminServiceHandler interface {
+	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
+	GetUserDetails(context.Context, *connect.Request[v1.GetUserDetailsRequest]) (*connect.Response[v1.GetUserDetailsResponse], error)
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error)
+	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
+	UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error)
+	SetUserAdmin(context.Context, *connect.Request[v1.SetUserAdminRequest]) (*connect.Response[v1.SetUserAdminResponse], error)
+	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	GetUserDeletion(context.Context, *connect.Request[v1.GetUserDeletionRequest]) (*connect.Response[v1.GetUserDeletionResponse], error)
+	SearchSecurityEvents(context.Context, *connect.Request[v1.SearchSecurityEventsRequest]) (*connect.Response[v1.SearchSecurityEventsResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_auth_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceSearchUsersHandler := connect.NewUnaryHandler(
+		AdminServiceSearchUsersProcedure,
+		svc.SearchUsers,
+		connect.WithSchema(adminServiceMethods.ByName("SearchUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetUserDetailsHandler := connect.NewUnaryHandler(
+		AdminServiceGetUserDetailsProcedure,
+		svc.GetUserDetails,
+		connect.WithSchema(adminServiceMethods.ByName("GetUserDetails")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSuspendUserHandler := connect.NewUnaryHandler(
+		AdminServiceSuspendUserProcedure,
+		svc.SuspendUser,
+		connect.WithSchema(adminServiceMethods.ByName("SuspendUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceBanUserHandler := connect.NewUnaryHandler(
+		AdminServiceBanUserProcedure,
+		svc.BanUser,
+		connect.WithSchema(adminServiceMethods.ByName("BanUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUnbanUserHandler := connect.NewUnaryHandler(
+		AdminServiceUnbanUserProcedure,
+		svc.UnbanUser,
+		connect.WithSchema(adminServiceMethods.ByName("UnbanUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetUserAdminHandler := connect.NewUnaryHandler(
+		AdminServiceSetUserAdminProcedure,
+		svc.SetUserAdmin,
+		connect.WithSchema(adminServiceMethods.ByName("SetUserAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteUserHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteUserProcedure,
+		svc.DeleteUser,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetUserDeletionHandler := connect.NewUnaryHandler(
+		AdminServiceGetUserDeletionProcedure,
+		svc.GetUserDeletion,
+		connect.WithSchema(adminServiceMethods.ByName("GetUserDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSearchSecurityEventsHandler := connect.NewUnaryHandler(
+		AdminServiceSearchSecurityEventsProcedure,
+		svc.SearchSecurityEvents,
+		connect.WithSchema(adminServiceMethods.ByName("SearchSecurityEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/auth.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceSearchUsersProcedure:
+			adminServiceSearchUsersHandler.ServeHTTP(w, r)
+		case AdminServiceGetUserDetailsProcedure:
+			adminServiceGetUserDetailsHandler.ServeHTTP(w, r)
+		case AdminServiceSuspendUserProcedure:
+			adminServiceSuspendUserHandler.ServeHTTP(w, r)
+		case AdminServiceBanUserProcedure:
+			adminServiceBanUserHandler.ServeHTTP(w, r)
+		case AdminServiceUnbanUserProcedure:
+			adminServiceUnbanUserHandler.ServeHTTP(w, r)
+		case AdminServiceSetUserAdminProcedure:
+			adminServiceSetUserAdminHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteUserProcedure:
+			adminServiceDeleteUserHandler.ServeHTTP(w, r)
+		case AdminServiceGetUserDeletionProcedure:
+			adminServiceGetUserDeletionHandler.ServeHTTP(w, r)
+		case AdminServiceSearchSecurityEventsProcedure:
+			adminServiceSearchSecurityEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.SearchUsers is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetUserDetails(context.Context, *connect.Request[v1.GetUserDetailsRequest]) (*connect.Response[v1.GetUserDetailsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.GetUserDetails is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.SuspendUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.BanUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.UnbanUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetUserAdmin(context.Context, *connect.Request[v1.SetUserAdminRequest]) (*connect.Response[v1.SetUserAdminResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.SetUserAdmin is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.DeleteUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetUserDeletion(context.Context, *connect.Request[v1.GetUserDeletionRequest]) (*connect.Response[v1.GetUserDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.GetUserDeletion is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SearchSecurityEvents(context.Context, *connect.Request[v1.SearchSecurityEventsRequest]) (*connect.Response[v1.SearchSecurityEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AdminService.SearchSecurityEvents is not implemented"))
+}
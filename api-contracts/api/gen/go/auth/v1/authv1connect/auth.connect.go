@@ -0,0 +1,1551 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: auth/v1/auth.proto
+
+package authv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuthServiceName is the fully-qualified name of the AuthService service.
+	AuthServiceName = "auth.v1.AuthService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuthServiceLoginProcedure is the fully-qualified name of the AuthService's Login RPC.
+	AuthServiceLoginProcedure = "/auth.v1.AuthService/Login"
+	// AuthServiceVerifyMFALoginProcedure is the fully-qualified name of the AuthService's
+	// VerifyMFALogin RPC.
+	AuthServiceVerifyMFALoginProcedure = "/auth.v1.AuthService/VerifyMFALogin"
+	// AuthServiceRegisterProcedure is the fully-qualified name of the AuthService's Register RPC.
+	AuthServiceRegisterProcedure = "/auth.v1.AuthService/Register"
+	// AuthServiceConfirmEmailProcedure is the fully-qualified name of the AuthService's ConfirmEmail
+	// RPC.
+	AuthServiceConfirmEmailProcedure = "/auth.v1.AuthService/ConfirmEmail"
+	// AuthServiceConfirmEmailByLinkProcedure is the fully-qualified name of the AuthService's
+	// ConfirmEmailByLink RPC.
+	AuthServiceConfirmEmailByLinkProcedure = "/auth.v1.AuthService/ConfirmEmailByLink"
+	// AuthServiceSendLoginLinkProcedure is the fully-qualified name of the AuthService's SendLoginLink
+	// RPC.
+	AuthServiceSendLoginLinkProcedure = "/auth.v1.AuthService/SendLoginLink"
+	// AuthServiceLoginByLinkProcedure is the fully-qualified name of the AuthService's LoginByLink RPC.
+	AuthServiceLoginByLinkProcedure = "/auth.v1.AuthService/LoginByLink"
+	// AuthServiceRevokeSessionByLinkProcedure is the fully-qualified name of the AuthService's
+	// RevokeSessionByLink RPC.
+	AuthServiceRevokeSessionByLinkProcedure = "/auth.v1.AuthService/RevokeSessionByLink"
+	// AuthServiceListOIDCProvidersProcedure is the fully-qualified name of the AuthService's
+	// ListOIDCProviders RPC.
+	AuthServiceListOIDCProvidersProcedure = "/auth.v1.AuthService/ListOIDCProviders"
+	// AuthServiceStartOIDCLoginProcedure is the fully-qualified name of the AuthService's
+	// StartOIDCLogin RPC.
+	AuthServiceStartOIDCLoginProcedure = "/auth.v1.AuthService/StartOIDCLogin"
+	// AuthServiceCompleteOIDCLoginProcedure is the fully-qualified name of the AuthService's
+	// CompleteOIDCLogin RPC.
+	AuthServiceCompleteOIDCLoginProcedure = "/auth.v1.AuthService/CompleteOIDCLogin"
+	// AuthServiceConfirmIdentityLinkProcedure is the fully-qualified name of the AuthService's
+	// ConfirmIdentityLink RPC.
+	AuthServiceConfirmIdentityLinkProcedure = "/auth.v1.AuthService/ConfirmIdentityLink"
+	// AuthServiceListIdentitiesProcedure is the fully-qualified name of the AuthService's
+	// ListIdentities RPC.
+	AuthServiceListIdentitiesProcedure = "/auth.v1.AuthService/ListIdentities"
+	// AuthServiceUnlinkIdentityProcedure is the fully-qualified name of the AuthService's
+	// UnlinkIdentity RPC.
+	AuthServiceUnlinkIdentityProcedure = "/auth.v1.AuthService/UnlinkIdentity"
+	// AuthServiceIssueServiceTokenProcedure is the fully-qualified name of the AuthService's
+	// IssueServiceToken RPC.
+	AuthServiceIssueServiceTokenProcedure = "/auth.v1.AuthService/IssueServiceToken"
+	// AuthServiceListSecurityEventsProcedure is the fully-qualified name of the AuthService's
+	// ListSecurityEvents RPC.
+	AuthServiceListSecurityEventsProcedure = "/auth.v1.AuthService/ListSecurityEvents"
+	// AuthServiceCreatePersonalTokenProcedure is the fully-qualified name of the AuthService's
+	// CreatePersonalToken RPC.
+	AuthServiceCreatePersonalTokenProcedure = "/auth.v1.AuthService/CreatePersonalToken"
+	// AuthServiceListPersonalTokensProcedure is the fully-qualified name of the AuthService's
+	// ListPersonalTokens RPC.
+	AuthServiceListPersonalTokensProcedure = "/auth.v1.AuthService/ListPersonalTokens"
+	// AuthServiceRevokePersonalTokenProcedure is the fully-qualified name of the AuthService's
+	// RevokePersonalToken RPC.
+	AuthServiceRevokePersonalTokenProcedure = "/auth.v1.AuthService/RevokePersonalToken"
+	// AuthServiceRequestDataExportProcedure is the fully-qualified name of the AuthService's
+	// RequestDataExport RPC.
+	AuthServiceRequestDataExportProcedure = "/auth.v1.AuthService/RequestDataExport"
+	// AuthServiceGetDataExportProcedure is the fully-qualified name of the AuthService's GetDataExport
+	// RPC.
+	AuthServiceGetDataExportProcedure = "/auth.v1.AuthService/GetDataExport"
+	// AuthServiceRefreshTokenProcedure is the fully-qualified name of the AuthService's RefreshToken
+	// RPC.
+	AuthServiceRefreshTokenProcedure = "/auth.v1.AuthService/RefreshToken"
+	// AuthServiceLogoutProcedure is the fully-qualified name of the AuthService's Logout RPC.
+	AuthServiceLogoutProcedure = "/auth.v1.AuthService/Logout"
+	// AuthServiceListSessionsProcedure is the fully-qualified name of the AuthService's ListSessions
+	// RPC.
+	AuthServiceListSessionsProcedure = "/auth.v1.AuthService/ListSessions"
+	// AuthServiceRevokeSessionProcedure is the fully-qualified name of the AuthService's RevokeSession
+	// RPC.
+	AuthServiceRevokeSessionProcedure = "/auth.v1.AuthService/RevokeSession"
+	// AuthServiceRevokeOtherSessionsProcedure is the fully-qualified name of the AuthService's
+	// RevokeOtherSessions RPC.
+	AuthServiceRevokeOtherSessionsProcedure = "/auth.v1.AuthService/RevokeOtherSessions"
+	// AuthServiceRenameSessionProcedure is the fully-qualified name of the AuthService's RenameSession
+	// RPC.
+	AuthServiceRenameSessionProcedure = "/auth.v1.AuthService/RenameSession"
+	// AuthServiceResetPasswordProcedure is the fully-qualified name of the AuthService's ResetPassword
+	// RPC.
+	AuthServiceResetPasswordProcedure = "/auth.v1.AuthService/ResetPassword"
+	// AuthServiceConfirmPasswordResetProcedure is the fully-qualified name of the AuthService's
+	// ConfirmPasswordReset RPC.
+	AuthServiceConfirmPasswordResetProcedure = "/auth.v1.AuthService/ConfirmPasswordReset"
+	// AuthServiceCheckPasswordResetCodeProcedure is the fully-qualified name of the AuthService's
+	// CheckPasswordResetCode RPC.
+	AuthServiceCheckPasswordResetCodeProcedure = "/auth.v1.AuthService/CheckPasswordResetCode"
+	// AuthServiceConfirmPasswordResetByLinkProcedure is the fully-qualified name of the AuthService's
+	// ConfirmPasswordResetByLink RPC.
+	AuthServiceConfirmPasswordResetByLinkProcedure = "/auth.v1.AuthService/ConfirmPasswordResetByLink"
+	// AuthServiceGetMeProcedure is the fully-qualified name of the AuthService's GetMe RPC.
+	AuthServiceGetMeProcedure = "/auth.v1.AuthService/GetMe"
+	// AuthServiceUpdateUserProcedure is the fully-qualified name of the AuthService's UpdateUser RPC.
+	AuthServiceUpdateUserProcedure = "/auth.v1.AuthService/UpdateUser"
+	// AuthServiceGetUserProcedure is the fully-qualified name of the AuthService's GetUser RPC.
+	AuthServiceGetUserProcedure = "/auth.v1.AuthService/GetUser"
+	// AuthServiceGetUserByUsernameProcedure is the fully-qualified name of the AuthService's
+	// GetUserByUsername RPC.
+	AuthServiceGetUserByUsernameProcedure = "/auth.v1.AuthService/GetUserByUsername"
+	// AuthServiceFollowProcedure is the fully-qualified name of the AuthService's Follow RPC.
+	AuthServiceFollowProcedure = "/auth.v1.AuthService/Follow"
+	// AuthServiceUnfollowProcedure is the fully-qualified name of the AuthService's Unfollow RPC.
+	AuthServiceUnfollowProcedure = "/auth.v1.AuthService/Unfollow"
+	// AuthServiceGetFollowStatusProcedure is the fully-qualified name of the AuthService's
+	// GetFollowStatus RPC.
+	AuthServiceGetFollowStatusProcedure = "/auth.v1.AuthService/GetFollowStatus"
+	// AuthServiceListFollowersProcedure is the fully-qualified name of the AuthService's ListFollowers
+	// RPC.
+	AuthServiceListFollowersProcedure = "/auth.v1.AuthService/ListFollowers"
+	// AuthServiceListFollowingProcedure is the fully-qualified name of the AuthService's ListFollowing
+	// RPC.
+	AuthServiceListFollowingProcedure = "/auth.v1.AuthService/ListFollowing"
+	// AuthServiceChangePasswordProcedure is the fully-qualified name of the AuthService's
+	// ChangePassword RPC.
+	AuthServiceChangePasswordProcedure = "/auth.v1.AuthService/ChangePassword"
+	// AuthServiceRequestEmailChangeProcedure is the fully-qualified name of the AuthService's
+	// RequestEmailChange RPC.
+	AuthServiceRequestEmailChangeProcedure = "/auth.v1.AuthService/RequestEmailChange"
+	// AuthServiceConfirmEmailChangeProcedure is the fully-qualified name of the AuthService's
+	// ConfirmEmailChange RPC.
+	AuthServiceConfirmEmailChangeProcedure = "/auth.v1.AuthService/ConfirmEmailChange"
+	// AuthServiceRequestAccountDeletionProcedure is the fully-qualified name of the AuthService's
+	// RequestAccountDeletion RPC.
+	AuthServiceRequestAccountDeletionProcedure = "/auth.v1.AuthService/RequestAccountDeletion"
+	// AuthServiceCancelAccountDeletionProcedure is the fully-qualified name of the AuthService's
+	// CancelAccountDeletion RPC.
+	AuthServiceCancelAccountDeletionProcedure = "/auth.v1.AuthService/CancelAccountDeletion"
+	// AuthServiceGetAccountDeletionProcedure is the fully-qualified name of the AuthService's
+	// GetAccountDeletion RPC.
+	AuthServiceGetAccountDeletionProcedure = "/auth.v1.AuthService/GetAccountDeletion"
+	// AuthServiceUploadAvatarProcedure is the fully-qualified name of the AuthService's UploadAvatar
+	// RPC.
+	AuthServiceUploadAvatarProcedure = "/auth.v1.AuthService/UploadAvatar"
+	// AuthServiceBeginTOTPEnrollmentProcedure is the fully-qualified name of the AuthService's
+	// BeginTOTPEnrollment RPC.
+	AuthServiceBeginTOTPEnrollmentProcedure = "/auth.v1.AuthService/BeginTOTPEnrollment"
+	// AuthServiceConfirmTOTPEnrollmentProcedure is the fully-qualified name of the AuthService's
+	// ConfirmTOTPEnrollment RPC.
+	AuthServiceConfirmTOTPEnrollmentProcedure = "/auth.v1.AuthService/ConfirmTOTPEnrollment"
+	// AuthServiceDisableTOTPProcedure is the fully-qualified name of the AuthService's DisableTOTP RPC.
+	AuthServiceDisableTOTPProcedure = "/auth.v1.AuthService/DisableTOTP"
+	// AuthServiceRegenerateRecoveryCodesProcedure is the fully-qualified name of the AuthService's
+	// RegenerateRecoveryCodes RPC.
+	AuthServiceRegenerateRecoveryCodesProcedure = "/auth.v1.AuthService/RegenerateRecoveryCodes"
+)
+
+// AuthServiceClient is a client for the auth.v1.AuthService service.
+type AuthServiceClient interface {
+	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	VerifyMFALogin(context.Context, *connect.Request[v1.VerifyMFALoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
+	ConfirmEmail(context.Context, *connect.Request[v1.ConfirmEmailRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	ConfirmEmailByLink(context.Context, *connect.Request[v1.ConfirmEmailByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	SendLoginLink(context.Context, *connect.Request[v1.SendLoginLinkRequest]) (*connect.Response[v1.SendLoginLinkResponse], error)
+	LoginByLink(context.Context, *connect.Request[v1.LoginByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	RevokeSessionByLink(context.Context, *connect.Request[v1.RevokeSessionByLinkRequest]) (*connect.Response[v1.RevokeSessionByLinkResponse], error)
+	ListOIDCProviders(context.Context, *connect.Request[v1.ListOIDCProvidersRequest]) (*connect.Response[v1.ListOIDCProvidersResponse], error)
+	StartOIDCLogin(context.Context, *connect.Request[v1.StartOIDCLoginRequest]) (*connect.Response[v1.StartOIDCLoginResponse], error)
+	CompleteOIDCLogin(context.Context, *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error)
+	ConfirmIdentityLink(context.Context, *connect.Request[v1.ConfirmIdentityLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// IssueServiceToken is the client credentials grant of the other services of the backend.
+	IssueServiceToken(context.Context, *connect.Request[v1.IssueServiceTokenRequest]) (*connect.Response[v1.IssueServiceTokenResponse], error)
+	ListSecurityEvents(context.Context, *connect.Request[v1.ListSecurityEventsRequest]) (*connect.Response[v1.ListSecurityEventsResponse], error)
+	CreatePersonalToken(context.Context, *connect.Request[v1.CreatePersonalTokenRequest]) (*connect.Response[v1.CreatePersonalTokenResponse], error)
+	ListPersonalTokens(context.Context, *connect.Request[v1.ListPersonalTokensRequest]) (*connect.Response[v1.ListPersonalTokensResponse], error)
+	RevokePersonalToken(context.Context, *connect.Request[v1.RevokePersonalTokenRequest]) (*connect.Response[v1.RevokePersonalTokenResponse], error)
+	RequestDataExport(context.Context, *connect.Request[v1.RequestDataExportRequest]) (*connect.Response[v1.RequestDataExportResponse], error)
+	GetDataExport(context.Context, *connect.Request[v1.GetDataExportRequest]) (*connect.Response[v1.GetDataExportResponse], error)
+	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error)
+	RenameSession(context.Context, *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error)
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	ConfirmPasswordReset(context.Context, *connect.Request[v1.ConfirmPasswordResetRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error)
+	CheckPasswordResetCode(context.Context, *connect.Request[v1.CheckPasswordResetCodeRequest]) (*connect.Response[v1.CheckPasswordResetCodeResponse], error)
+	ConfirmPasswordResetByLink(context.Context, *connect.Request[v1.ConfirmPasswordResetByLinkRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error)
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetUserResponse], error)
+	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.User], error)
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	GetUserByUsername(context.Context, *connect.Request[v1.GetUserByUsernameRequest]) (*connect.Response[v1.GetUserByUsernameResponse], error)
+	Follow(context.Context, *connect.Request[v1.FollowRequest]) (*connect.Response[v1.FollowResponse], error)
+	Unfollow(context.Context, *connect.Request[v1.UnfollowRequest]) (*connect.Response[v1.UnfollowResponse], error)
+	GetFollowStatus(context.Context, *connect.Request[v1.GetFollowStatusRequest]) (*connect.Response[v1.GetFollowStatusResponse], error)
+	ListFollowers(context.Context, *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error)
+	ListFollowing(context.Context, *connect.Request[v1.ListFollowingRequest]) (*connect.Response[v1.ListFollowingResponse], error)
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
+	RequestEmailChange(context.Context, *connect.Request[v1.RequestEmailChangeRequest]) (*connect.Response[v1.RequestEmailChangeResponse], error)
+	ConfirmEmailChange(context.Context, *connect.Request[v1.ConfirmEmailChangeRequest]) (*connect.Response[v1.ConfirmEmailChangeResponse], error)
+	RequestAccountDeletion(context.Context, *connect.Request[v1.RequestAccountDeletionRequest]) (*connect.Response[v1.RequestAccountDeletionResponse], error)
+	CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error)
+	GetAccountDeletion(context.Context, *connect.Request[v1.GetAccountDeletionRequest]) (*connect.Response[v1.GetAccountDeletionResponse], error)
+	UploadAvatar(context.Context, *connect.Request[v1.UploadUserAvatarRequest]) (*connect.Response[v1.UploadUserAvatarResponse], error)
+	BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error)
+	ConfirmTOTPEnrollment(context.Context, *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error)
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+}
+
+// NewAuthServiceClient constructs a client for the auth.v1.AuthService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuthServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	authServiceMethods := v1.File_auth_v1_auth_proto.Services().ByName("AuthService").Methods()
+	return &authServiceClient{
+		login: connect.NewClient[v1.LoginRequest, v1.SuccessLoginResponse](
+			httpClient,
+			baseURL+AuthServiceLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Login")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMFALogin: connect.NewClient[v1.VerifyMFALoginRequest, v1.SuccessLoginResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyMFALoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyMFALogin")),
+			connect.WithClientOptions(opts...),
+		),
+		register: connect.NewClient[v1.RegisterRequest, v1.RegisterResponse](
+			httpClient,
+			baseURL+AuthServiceRegisterProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Register")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmEmail: connect.NewClient[v1.ConfirmEmailRequest, v1.SuccessLoginResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmEmailProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmEmailByLink: connect.NewClient[v1.ConfirmEmailByLinkRequest, v1.SuccessLoginResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmEmailByLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmEmailByLink")),
+			connect.WithClientOptions(opts...),
+		),
+		sendLoginLink: connect.NewClient[v1.SendLoginLinkRequest, v1.SendLoginLinkResponse](
+			httpClient,
+			baseURL+AuthServiceSendLoginLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SendLoginLink")),
+			connect.WithClientOptions(opts...),
+		),
+		loginByLink: connect.NewClient[v1.LoginByLinkRequest, v1.SuccessLoginResponse](
+			httpClient,
+			baseURL+AuthServiceLoginByLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("LoginByLink")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSessionByLink: connect.NewClient[v1.RevokeSessionByLinkRequest, v1.RevokeSessionByLinkResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeSessionByLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeSessionByLink")),
+			connect.WithClientOptions(opts...),
+		),
+		listOIDCProviders: connect.NewClient[v1.ListOIDCProvidersRequest, v1.ListOIDCProvidersResponse](
+			httpClient,
+			baseURL+AuthServiceListOIDCProvidersProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListOIDCProviders")),
+			connect.WithClientOptions(opts...),
+		),
+		startOIDCLogin: connect.NewClient[v1.StartOIDCLoginRequest, v1.StartOIDCLoginResponse](
+			httpClient,
+			baseURL+AuthServiceStartOIDCLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("StartOIDCLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		completeOIDCLogin: connect.NewClient[v1.CompleteOIDCLoginRequest, v1.CompleteOIDCLoginResponse](
+			httpClient,
+			baseURL+AuthServiceCompleteOIDCLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CompleteOIDCLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmIdentityLink: connect.NewClient[v1.ConfirmIdentityLinkRequest, v1.SuccessLoginResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmIdentityLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmIdentityLink")),
+			connect.WithClientOptions(opts...),
+		),
+		listIdentities: connect.NewClient[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse](
+			httpClient,
+			baseURL+AuthServiceListIdentitiesProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListIdentities")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkIdentity: connect.NewClient[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse](
+			httpClient,
+			baseURL+AuthServiceUnlinkIdentityProcedure,
+			connect.WithSchema(authServiceMethods.ByName("UnlinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		issueServiceToken: connect.NewClient[v1.IssueServiceTokenRequest, v1.IssueServiceTokenResponse](
+			httpClient,
+			baseURL+AuthServiceIssueServiceTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("IssueServiceToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecurityEvents: connect.NewClient[v1.ListSecurityEventsRequest, v1.ListSecurityEventsResponse](
+			httpClient,
+			baseURL+AuthServiceListSecurityEventsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListSecurityEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		createPersonalToken: connect.NewClient[v1.CreatePersonalTokenRequest, v1.CreatePersonalTokenResponse](
+			httpClient,
+			baseURL+AuthServiceCreatePersonalTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CreatePersonalToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listPersonalTokens: connect.NewClient[v1.ListPersonalTokensRequest, v1.ListPersonalTokensResponse](
+			httpClient,
+			baseURL+AuthServiceListPersonalTokensProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListPersonalTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokePersonalToken: connect.NewClient[v1.RevokePersonalTokenRequest, v1.RevokePersonalTokenResponse](
+			httpClient,
+			baseURL+AuthServiceRevokePersonalTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokePersonalToken")),
+			connect.WithClientOptions(opts...),
+		),
+		requestDataExport: connect.NewClient[v1.RequestDataExportRequest, v1.RequestDataExportResponse](
+			httpClient,
+			baseURL+AuthServiceRequestDataExportProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RequestDataExport")),
+			connect.WithClientOptions(opts...),
+		),
+		getDataExport: connect.NewClient[v1.GetDataExportRequest, v1.GetDataExportResponse](
+			httpClient,
+			baseURL+AuthServiceGetDataExportProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetDataExport")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshToken: connect.NewClient[v1.RefreshTokenRequest, v1.SuccessLoginResponse](
+			httpClient,
+			baseURL+AuthServiceRefreshTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RefreshToken")),
+			connect.WithClientOptions(opts...),
+		),
+		logout: connect.NewClient[v1.LogoutRequest, v1.LogoutResponse](
+			httpClient,
+			baseURL+AuthServiceLogoutProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Logout")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceListSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeSessionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeOtherSessions: connect.NewClient[v1.RevokeOtherSessionsRequest, v1.RevokeOtherSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeOtherSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeOtherSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		renameSession: connect.NewClient[v1.RenameSessionRequest, v1.RenameSessionResponse](
+			httpClient,
+			baseURL+AuthServiceRenameSessionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RenameSession")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPassword: connect.NewClient[v1.ResetPasswordRequest, v1.ResetPasswordResponse](
+			httpClient,
+			baseURL+AuthServiceResetPasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmPasswordReset: connect.NewClient[v1.ConfirmPasswordResetRequest, v1.ConfirmPasswordResetResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmPasswordResetProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmPasswordReset")),
+			connect.WithClientOptions(opts...),
+		),
+		checkPasswordResetCode: connect.NewClient[v1.CheckPasswordResetCodeRequest, v1.CheckPasswordResetCodeResponse](
+			httpClient,
+			baseURL+AuthServiceCheckPasswordResetCodeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CheckPasswordResetCode")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmPasswordResetByLink: connect.NewClient[v1.ConfirmPasswordResetByLinkRequest, v1.ConfirmPasswordResetResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmPasswordResetByLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmPasswordResetByLink")),
+			connect.WithClientOptions(opts...),
+		),
+		getMe: connect.NewClient[v1.GetMeRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+AuthServiceGetMeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetMe")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUser: connect.NewClient[v1.UpdateUserRequest, v1.User](
+			httpClient,
+			baseURL+AuthServiceUpdateUserProcedure,
+			connect.WithSchema(authServiceMethods.ByName("UpdateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+AuthServiceGetUserProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetUser")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserByUsername: connect.NewClient[v1.GetUserByUsernameRequest, v1.GetUserByUsernameResponse](
+			httpClient,
+			baseURL+AuthServiceGetUserByUsernameProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetUserByUsername")),
+			connect.WithClientOptions(opts...),
+		),
+		follow: connect.NewClient[v1.FollowRequest, v1.FollowResponse](
+			httpClient,
+			baseURL+AuthServiceFollowProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Follow")),
+			connect.WithClientOptions(opts...),
+		),
+		unfollow: connect.NewClient[v1.UnfollowRequest, v1.UnfollowResponse](
+			httpClient,
+			baseURL+AuthServiceUnfollowProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Unfollow")),
+			connect.WithClientOptions(opts...),
+		),
+		getFollowStatus: connect.NewClient[v1.GetFollowStatusRequest, v1.GetFollowStatusResponse](
+			httpClient,
+			baseURL+AuthServiceGetFollowStatusProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetFollowStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		listFollowers: connect.NewClient[v1.ListFollowersRequest, v1.ListFollowersResponse](
+			httpClient,
+			baseURL+AuthServiceListFollowersProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListFollowers")),
+			connect.WithClientOptions(opts...),
+		),
+		listFollowing: connect.NewClient[v1.ListFollowingRequest, v1.ListFollowingResponse](
+			httpClient,
+			baseURL+AuthServiceListFollowingProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListFollowing")),
+			connect.WithClientOptions(opts...),
+		),
+		changePassword: connect.NewClient[v1.ChangePasswordRequest, v1.ChangePasswordResponse](
+			httpClient,
+			baseURL+AuthServiceChangePasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ChangePassword")),
+			connect.WithClientOptions(opts...),
+		),
+		requestEmailChange: connect.NewClient[v1.RequestEmailChangeRequest, v1.RequestEmailChangeResponse](
+			httpClient,
+			baseURL+AuthServiceRequestEmailChangeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RequestEmailChange")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmEmailChange: connect.NewClient[v1.ConfirmEmailChangeRequest, v1.ConfirmEmailChangeResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmEmailChangeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmEmailChange")),
+			connect.WithClientOptions(opts...),
+		),
+		requestAccountDeletion: connect.NewClient[v1.RequestAccountDeletionRequest, v1.RequestAccountDeletionResponse](
+			httpClient,
+			baseURL+AuthServiceRequestAccountDeletionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RequestAccountDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelAccountDeletion: connect.NewClient[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse](
+			httpClient,
+			baseURL+AuthServiceCancelAccountDeletionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CancelAccountDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountDeletion: connect.NewClient[v1.GetAccountDeletionRequest, v1.GetAccountDeletionResponse](
+			httpClient,
+			baseURL+AuthServiceGetAccountDeletionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetAccountDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAvatar: connect.NewClient[v1.UploadUserAvatarRequest, v1.UploadUserAvatarResponse](
+			httpClient,
+			baseURL+AuthServiceUploadAvatarProcedure,
+			connect.WithSchema(authServiceMethods.ByName("UploadAvatar")),
+			connect.WithClientOptions(opts...),
+		),
+		beginTOTPEnrollment: connect.NewClient[v1.BeginTOTPEnrollmentRequest, v1.BeginTOTPEnrollmentResponse](
+			httpClient,
+			baseURL+AuthServiceBeginTOTPEnrollmentProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginTOTPEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTOTPEnrollment: connect.NewClient[v1.ConfirmTOTPEnrollmentRequest, v1.ConfirmTOTPEnrollmentResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmTOTPEnrollmentProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmTOTPEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1.DisableTOTPRequest, v1.DisableTOTPResponse](
+			httpClient,
+			baseURL+AuthServiceDisableTOTPProcedure,
+			connect.WithSchema(authServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse](
+			httpClient,
+			baseURL+AuthServiceRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RegenerateRecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// authServiceClient implements AuthServiceClient.
+type authServiceClient struct {
+	login                      *connect.Client[v1.LoginRequest, v1.SuccessLoginResponse]
+	verifyMFALogin             *connect.Client[v1.VerifyMFALoginRequest, v1.SuccessLoginResponse]
+	register                   *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	confirmEmail               *connect.Client[v1.ConfirmEmailRequest, v1.SuccessLoginResponse]
+	confirmEmailByLink         *connect.Client[v1.ConfirmEmailByLinkRequest, v1.SuccessLoginResponse]
+	sendLoginLink              *connect.Client[v1.SendLoginLinkRequest, v1.SendLoginLinkResponse]
+	loginByLink                *connect.Client[v1.LoginByLinkRequest, v1.SuccessLoginResponse]
+	revokeSessionByLink        *connect.Client[v1.RevokeSessionByLinkRequest, v1.RevokeSessionByLinkResponse]
+	listOIDCProviders          *connect.Client[v1.ListOIDCProvidersRequest, v1.ListOIDCProvidersResponse]
+	startOIDCLogin             *connect.Client[v1.StartOIDCLoginRequest, v1.StartOIDCLoginResponse]
+	completeOIDCLogin          *connect.Client[v1.CompleteOIDCLoginRequest, v1.CompleteOIDCLoginResponse]
+	confirmIdentityLink        *connect.Client[v1.ConfirmIdentityLinkRequest, v1.SuccessLoginResponse]
+	listIdentities             *connect.Client[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse]
+	unlinkIdentity             *connect.Client[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse]
+	issueServiceToken          *connect.Client[v1.IssueServiceTokenRequest, v1.IssueServiceTokenResponse]
+	listSecurityEvents         *connect.Client[v1.ListSecurityEventsRequest, v1.ListSecurityEventsResponse]
+	createPersonalToken        *connect.Client[v1.CreatePersonalTokenRequest, v1.CreatePersonalTokenResponse]
+	listPersonalTokens         *connect.Client[v1.ListPersonalTokensRequest, v1.ListPersonalTokensResponse]
+	revokePersonalToken        *connect.Client[v1.RevokePersonalTokenRequest, v1.RevokePersonalTokenResponse]
+	requestDataExport          *connect.Client[v1.RequestDataExportRequest, v1.RequestDataExportResponse]
+	getDataExport              *connect.Client[v1.GetDataExportRequest, v1.GetDataExportResponse]
+	refreshToken               *connect.Client[v1.RefreshTokenRequest, v1.SuccessLoginResponse]
+	logout                     *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	listSessions               *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession              *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeOtherSessions        *connect.Client[v1.RevokeOtherSessionsRequest, v1.RevokeOtherSessionsResponse]
+	renameSession              *connect.Client[v1.RenameSessionRequest, v1.RenameSessionResponse]
+	resetPassword              *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
+	confirmPasswordReset       *connect.Client[v1.ConfirmPasswordResetRequest, v1.ConfirmPasswordResetResponse]
+	checkPasswordResetCode     *connect.Client[v1.CheckPasswordResetCodeRequest, v1.CheckPasswordResetCodeResponse]
+	confirmPasswordResetByLink *connect.Client[v1.ConfirmPasswordResetByLinkRequest, v1.ConfirmPasswordResetResponse]
+	getMe                      *connect.Client[v1.GetMeRequest, v1.GetUserResponse]
+	updateUser                 *connect.Client[v1.UpdateUserRequest, v1.User]
+	getUser                    *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	getUserByUsername          *connect.Client[v1.GetUserByUsernameRequest, v1.GetUserByUsernameResponse]
+	follow                     *connect.Client[v1.FollowRequest, v1.FollowResponse]
+	unfollow                   *connect.Client[v1.UnfollowRequest, v1.UnfollowResponse]
+	getFollowStatus            *connect.Client[v1.GetFollowStatusRequest, v1.GetFollowStatusResponse]
+	listFollowers              *connect.Client[v1.ListFollowersRequest, v1.ListFollowersResponse]
+	listFollowing              *connect.Client[v1.ListFollowingRequest, v1.ListFollowingResponse]
+	changePassword             *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
+	requestEmailChange         *connect.Client[v1.RequestEmailChangeRequest, v1.RequestEmailChangeResponse]
+	confirmEmailChange         *connect.Client[v1.ConfirmEmailChangeRequest, v1.ConfirmEmailChangeResponse]
+	requestAccountDeletion     *connect.Client[v1.RequestAccountDeletionRequest, v1.RequestAccountDeletionResponse]
+	cancelAccountDeletion      *connect.Client[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse]
+	getAccountDeletion         *connect.Client[v1.GetAccountDeletionRequest, v1.GetAccountDeletionResponse]
+	uploadAvatar               *connect.Client[v1.UploadUserAvatarRequest, v1.UploadUserAvatarResponse]
+	beginTOTPEnrollment        *connect.Client[v1.BeginTOTPEnrollmentRequest, v1.BeginTOTPEnrollmentResponse]
+	confirmTOTPEnrollment      *connect.Client[v1.ConfirmTOTPEnrollmentRequest, v1.ConfirmTOTPEnrollmentResponse]
+	disableTOTP                *connect.Client[v1.DisableTOTPRequest, v1.DisableTOTPResponse]
+	regenerateRecoveryCodes    *connect.Client[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse]
+}
+
+// Login calls auth.v1.AuthService.Login.
+func (c *authServiceClient) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return c.login.CallUnary(ctx, req)
+}
+
+// VerifyMFALogin calls auth.v1.AuthService.VerifyMFALogin.
+func (c *authServiceClient) VerifyMFALogin(ctx context.Context, req *connect.Request[v1.VerifyMFALoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return c.verifyMFALogin.CallUnary(ctx, req)
+}
+
+// Register calls auth.v1.AuthService.Register.
+func (c *authServiceClient) Register(ctx context.Context, req *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
+	return c.register.CallUnary(ctx, req)
+}
+
+// ConfirmEmail calls auth.v1.AuthService.ConfirmEmail.
+func (c *authServiceClient) ConfirmEmail(ctx context.Context, req *connect.Request[v1.ConfirmEmailRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return c.confirmEmail.CallUnary(ctx, req)
+}
+
+// ConfirmEmailByLink calls auth.v1.AuthService.ConfirmEmailByLink.
+func (c *authServiceClient) ConfirmEmailByLink(ctx context.Context, req *connect.Request[v1.ConfirmEmailByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return c.confirmEmailByLink.CallUnary(ctx, req)
+}
+
+// SendLoginLink calls auth.v1.AuthService.SendLoginLink.
+func (c *authServiceClient) SendLoginLink(ctx context.Context, req *connect.Request[v1.SendLoginLinkRequest]) (*connect.Response[v1.SendLoginLinkResponse], error) {
+	return c.sendLoginLink.CallUnary(ctx, req)
+}
+
+// LoginByLink calls auth.v1.AuthService.LoginByLink.
+func (c *authServiceClient) LoginByLink(ctx context.Context, req *connect.Request[v1.LoginByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return c.loginByLink.CallUnary(ctx, req)
+}
+
+// RevokeSessionByLink calls auth.v1.AuthService.RevokeSessionByLink.
+func (c *authServiceClient) RevokeSessionByLink(ctx context.Context, req *connect.Request[v1.RevokeSessionByLinkRequest]) (*connect.Response[v1.RevokeSessionByLinkResponse], error) {
+	return c.revokeSessionByLink.CallUnary(ctx, req)
+}
+
+// ListOIDCProviders calls auth.v1.AuthService.ListOIDCProviders.
+func (c *authServiceClient) ListOIDCProviders(ctx context.Context, req *connect.Request[v1.ListOIDCProvidersRequest]) (*connect.Response[v1.ListOIDCProvidersResponse], error) {
+	return c.listOIDCProviders.CallUnary(ctx, req)
+}
+
+// StartOIDCLogin calls auth.v1.AuthService.StartOIDCLogin.
+func (c *authServiceClient) StartOIDCLogin(ctx context.Context, req *connect.Request[v1.StartOIDCLoginRequest]) (*connect.Response[v1.StartOIDCLoginResponse], error) {
+	return c.startOIDCLogin.CallUnary(ctx, req)
+}
+
+// CompleteOIDCLogin calls auth.v1.AuthService.CompleteOIDCLogin.
+func (c *authServiceClient) CompleteOIDCLogin(ctx context.Context, req *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error) {
+	return c.completeOIDCLogin.CallUnary(ctx, req)
+}
+
+// ConfirmIdentityLink calls auth.v1.AuthService.ConfirmIdentityLink.
+func (c *authServiceClient) ConfirmIdentityLink(ctx context.Context, req *connect.Request[v1.ConfirmIdentityLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return c.confirmIdentityLink.CallUnary(ctx, req)
+}
+
+// ListIdentities calls auth.v1.AuthService.ListIdentities.
+func (c *authServiceClient) ListIdentities(ctx context.Context, req *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return c.listIdentities.CallUnary(ctx, req)
+}
+
+// UnlinkIdentity calls auth.v1.AuthService.UnlinkIdentity.
+func (c *authServiceClient) UnlinkIdentity(ctx context.Context, req *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return c.unlinkIdentity.CallUnary(ctx, req)
+}
+
+// IssueServiceToken calls auth.v1.AuthService.IssueServiceToken.
+func (c *authServiceClient) IssueServiceToken(ctx context.Context, req *connect.Request[v1.IssueServiceTokenRequest]) (*connect.Response[v1.IssueServiceTokenResponse], error) {
+	return c.issueServiceToken.CallUnary(ctx, req)
+}
+
+// ListSecurityEvents calls auth.v1.AuthService.ListSecurityEvents.
+func (c *authServiceClient) ListSecurityEvents(ctx context.Context, req *connect.Request[v1.ListSecurityEventsRequest]) (*connect.Response[v1.ListSecurityEventsResponse], error) {
+	return c.listSecurityEvents.CallUnary(ctx, req)
+}
+
+// CreatePersonalToken calls auth.v1.AuthService.CreatePersonalToken.
+func (c *authServiceClient) CreatePersonalToken(ctx context.Context, req *connect.Request[v1.CreatePersonalTokenRequest]) (*connect.Response[v1.CreatePersonalTokenResponse], error) {
+	return c.createPersonalToken.CallUnary(ctx, req)
+}
+
+// ListPersonalTokens calls auth.v1.AuthService.ListPersonalTokens.
+func (c *authServiceClient) ListPersonalTokens(ctx context.Context, req *connect.Request[v1.ListPersonalTokensRequest]) (*connect.Response[v1.ListPersonalTokensResponse], error) {
+	return c.listPersonalTokens.CallUnary(ctx, req)
+}
+
+// RevokePersonalToken calls auth.v1.AuthService.RevokePersonalToken.
+func (c *authServiceClient) RevokePersonalToken(ctx context.Context, req *connect.Request[v1.RevokePersonalTokenRequest]) (*connect.Response[v1.RevokePersonalTokenResponse], error) {
+	return c.revokePersonalToken.CallUnary(ctx, req)
+}
+
+// RequestDataExport calls auth.v1.AuthService.RequestDataExport.
+func (c *authServiceClient) RequestDataExport(ctx context.Context, req *connect.Request[v1.RequestDataExportRequest]) (*connect.Response[v1.RequestDataExportResponse], error) {
+	return c.requestDataExport.CallUnary(ctx, req)
+}
+
+// GetDataExport calls auth.v1.AuthService.GetDataExport.
+func (c *authServiceClient) GetDataExport(ctx context.Context, req *connect.Request[v1.GetDataExportRequest]) (*connect.Response[v1.GetDataExportResponse], error) {
+	return c.getDataExport.CallUnary(ctx, req)
+}
+
+// RefreshToken calls auth.v1.AuthService.RefreshToken.
+func (c *authServiceClient) RefreshToken(ctx context.Context, req *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return c.refreshToken.CallUnary(ctx, req)
+}
+
+// Logout calls auth.v1.AuthService.Logout.
+func (c *authServiceClient) Logout(ctx context.Context, req *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
+	return c.logout.CallUnary(ctx, req)
+}
+
+// ListSessions calls auth.v1.AuthService.ListSessions.
+func (c *authServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls auth.v1.AuthService.RevokeSession.
+func (c *authServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeOtherSessions calls auth.v1.AuthService.RevokeOtherSessions.
+func (c *authServiceClient) RevokeOtherSessions(ctx context.Context, req *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error) {
+	return c.revokeOtherSessions.CallUnary(ctx, req)
+}
+
+// RenameSession calls auth.v1.AuthService.RenameSession.
+func (c *authServiceClient) RenameSession(ctx context.Context, req *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error) {
+	return c.renameSession.CallUnary(ctx, req)
+}
+
+// ResetPassword calls auth.v1.AuthService.ResetPassword.
+func (c *authServiceClient) ResetPassword(ctx context.Context, req *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
+// ConfirmPasswordReset calls auth.v1.AuthService.ConfirmPasswordReset.
+func (c *authServiceClient) ConfirmPasswordReset(ctx context.Context, req *connect.Request[v1.ConfirmPasswordResetRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error) {
+	return c.confirmPasswordReset.CallUnary(ctx, req)
+}
+
+// CheckPasswordResetCode calls auth.v1.AuthService.CheckPasswordResetCode.
+func (c *authServiceClient) CheckPasswordResetCode(ctx context.Context, req *connect.Request[v1.CheckPasswordResetCodeRequest]) (*connect.Response[v1.CheckPasswordResetCodeResponse], error) {
+	return c.checkPasswordResetCode.CallUnary(ctx, req)
+}
+
+// ConfirmPasswordResetByLink calls auth.v1.AuthService.ConfirmPasswordResetByLink.
+func (c *authServiceClient) ConfirmPasswordResetByLink(ctx context.Context, req *connect.Request[v1.ConfirmPasswordResetByLinkRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error) {
+	return c.confirmPasswordResetByLink.CallUnary(ctx, req)
+}
+
+// GetMe calls auth.v1.AuthService.GetMe.
+func (c *authServiceClient) GetMe(ctx context.Context, req *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getMe.CallUnary(ctx, req)
+}
+
+// UpdateUser calls auth.v1.AuthService.UpdateUser.
+func (c *authServiceClient) UpdateUser(ctx context.Context, req *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.User], error) {
+	return c.updateUser.CallUnary(ctx, req)
+}
+
+// GetUser calls auth.v1.AuthService.GetUser.
+func (c *authServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// GetUserByUsername calls auth.v1.AuthService.GetUserByUsername.
+func (c *authServiceClient) GetUserByUsername(ctx context.Context, req *connect.Request[v1.GetUserByUsernameRequest]) (*connect.Response[v1.GetUserByUsernameResponse], error) {
+	return c.getUserByUsername.CallUnary(ctx, req)
+}
+
+// Follow calls auth.v1.AuthService.Follow.
+func (c *authServiceClient) Follow(ctx context.Context, req *connect.Request[v1.FollowRequest]) (*connect.Response[v1.FollowResponse], error) {
+	return c.follow.CallUnary(ctx, req)
+}
+
+// Unfollow calls auth.v1.AuthService.Unfollow.
+func (c *authServiceClient) Unfollow(ctx context.Context, req *connect.Request[v1.UnfollowRequest]) (*connect.Response[v1.UnfollowResponse], error) {
+	return c.unfollow.CallUnary(ctx, req)
+}
+
+// GetFollowStatus calls auth.v1.AuthService.GetFollowStatus.
+func (c *authServiceClient) GetFollowStatus(ctx context.Context, req *connect.Request[v1.GetFollowStatusRequest]) (*connect.Response[v1.GetFollowStatusResponse], error) {
+	return c.getFollowStatus.CallUnary(ctx, req)
+}
+
+// ListFollowers calls auth.v1.AuthService.ListFollowers.
+func (c *authServiceClient) ListFollowers(ctx context.Context, req *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error) {
+	return c.listFollowers.CallUnary(ctx, req)
+}
+
+// ListFollowing calls auth.v1.AuthService.ListFollowing.
+func (c *authServiceClient) ListFollowing(ctx context.Context, req *connect.Request[v1.ListFollowingRequest]) (*connect.Response[v1.ListFollowingResponse], error) {
+	return c.listFollowing.CallUnary(ctx, req)
+}
+
+// ChangePassword calls auth.v1.AuthService.ChangePassword.
+func (c *authServiceClient) ChangePassword(ctx context.Context, req *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
+// RequestEmailChange calls auth.v1.AuthService.RequestEmailChange.
+func (c *authServiceClient) RequestEmailChange(ctx context.Context, req *connect.Request[v1.RequestEmailChangeRequest]) (*connect.Response[v1.RequestEmailChangeResponse], error) {
+	return c.requestEmailChange.CallUnary(ctx, req)
+}
+
+// ConfirmEmailChange calls auth.v1.AuthService.ConfirmEmailChange.
+func (c *authServiceClient) ConfirmEmailChange(ctx context.Context, req *connect.Request[v1.ConfirmEmailChangeRequest]) (*connect.Response[v1.ConfirmEmailChangeResponse], error) {
+	return c.confirmEmailChange.CallUnary(ctx, req)
+}
+
+// RequestAccountDeletion calls auth.v1.AuthService.RequestAccountDeletion.
+func (c *authServiceClient) RequestAccountDeletion(ctx context.Context, req *connect.Request[v1.RequestAccountDeletionRequest]) (*connect.Response[v1.RequestAccountDeletionResponse], error) {
+	return c.requestAccountDeletion.CallUnary(ctx, req)
+}
+
+// CancelAccountDeletion calls auth.v1.AuthService.CancelAccountDeletion.
+func (c *authServiceClient) CancelAccountDeletion(ctx context.Context, req *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error) {
+	return c.cancelAccountDeletion.CallUnary(ctx, req)
+}
+
+// GetAccountDeletion calls auth.v1.AuthService.GetAccountDeletion.
+func (c *authServiceClient) GetAccountDeletion(ctx context.Context, req *connect.Request[v1.GetAccountDeletionRequest]) (*connect.Response[v1.GetAccountDeletionResponse], error) {
+	return c.getAccountDeletion.CallUnary(ctx, req)
+}
+
+// UploadAvatar calls auth.v1.AuthService.UploadAvatar.
+func (c *authServiceClient) UploadAvatar(ctx context.Context, req *connect.Request[v1.UploadUserAvatarRequest]) (*connect.Response[v1.UploadUserAvatarResponse], error) {
+	return c.uploadAvatar.CallUnary(ctx, req)
+}
+
+// BeginTOTPEnrollment calls auth.v1.AuthService.BeginTOTPEnrollment.
+func (c *authServiceClient) BeginTOTPEnrollment(ctx context.Context, req *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error) {
+	return c.beginTOTPEnrollment.CallUnary(ctx, req)
+}
+
+// ConfirmTOTPEnrollment calls auth.v1.AuthService.ConfirmTOTPEnrollment.
+func (c *authServiceClient) ConfirmTOTPEnrollment(ctx context.Context, req *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error) {
+	return c.confirmTOTPEnrollment.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls auth.v1.AuthService.DisableTOTP.
+func (c *authServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
+// RegenerateRecoveryCodes calls auth.v1.AuthService.RegenerateRecoveryCodes.
+func (c *authServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return c.regenerateRecoveryCodes.CallUnary(ctx, req)
+}
+
+// AuthServiceHandler is an implementation of the auth.v1.AuthService service.
+type AuthServiceHandler interface {
+	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	VerifyMFALogin(context.Context, *connect.Request[v1.VerifyMFALoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
+	ConfirmEmail(context.Context, *connect.Request[v1.ConfirmEmailRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	ConfirmEmailByLink(context.Context, *connect.Request[v1.ConfirmEmailByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	SendLoginLink(context.Context, *connect.Request[v1.SendLoginLinkRequest]) (*connect.Response[v1.SendLoginLinkResponse], error)
+	LoginByLink(context.Context, *connect.Request[v1.LoginByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	RevokeSessionByLink(context.Context, *connect.Request[v1.RevokeSessionByLinkRequest]) (*connect.Response[v1.RevokeSessionByLinkResponse], error)
+	ListOIDCProviders(context.Context, *connect.Request[v1.ListOIDCProvidersRequest]) (*connect.Response[v1.ListOIDCProvidersResponse], error)
+	StartOIDCLogin(context.Context, *connect.Request[v1.StartOIDCLoginRequest]) (*connect.Response[v1.StartOIDCLoginResponse], error)
+	CompleteOIDCLogin(context.Context, *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error)
+	ConfirmIdentityLink(context.Context, *connect.Request[v1.ConfirmIdentityLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// IssueServiceToken is the client credentials grant of the other services of the backend.
+	IssueServiceToken(context.Context, *connect.Request[v1.IssueServiceTokenRequest]) (*connect.Response[v1.IssueServiceTokenResponse], error)
+	ListSecurityEvents(context.Context, *connect.Request[v1.ListSecurityEventsRequest]) (*connect.Response[v1.ListSecurityEventsResponse], error)
+	CreatePersonalToken(context.Context, *connect.Request[v1.CreatePersonalTokenRequest]) (*connect.Response[v1.CreatePersonalTokenResponse], error)
+	ListPersonalTokens(context.Context, *connect.Request[v1.ListPersonalTokensRequest]) (*connect.Response[v1.ListPersonalTokensResponse], error)
+	RevokePersonalToken(context.Context, *connect.Request[v1.RevokePersonalTokenRequest]) (*connect.Response[v1.RevokePersonalTokenResponse], error)
+	RequestDataExport(context.Context, *connect.Request[v1.RequestDataExportRequest]) (*connect.Response[v1.RequestDataExportResponse], error)
+	GetDataExport(context.Context, *connect.Request[v1.GetDataExportRequest]) (*connect.Response[v1.GetDataExportResponse], error)
+	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.SuccessLoginResponse], error)
+	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error)
+	RenameSession(context.Context, *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error)
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	ConfirmPasswordReset(context.Context, *connect.Request[v1.ConfirmPasswordResetRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error)
+	CheckPasswordResetCode(context.Context, *connect.Request[v1.CheckPasswordResetCodeRequest]) (*connect.Response[v1.CheckPasswordResetCodeResponse], error)
+	ConfirmPasswordResetByLink(context.Context, *connect.Request[v1.ConfirmPasswordResetByLinkRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error)
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetUserResponse], error)
+	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.User], error)
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	GetUserByUsername(context.Context, *connect.Request[v1.GetUserByUsernameRequest]) (*connect.Response[v1.GetUserByUsernameResponse], error)
+	Follow(context.Context, *connect.Request[v1.FollowRequest]) (*connect.Response[v1.FollowResponse], error)
+	Unfollow(context.Context, *connect.Request[v1.UnfollowRequest]) (*connect.Response[v1.UnfollowResponse], error)
+	GetFollowStatus(context.Context, *connect.Request[v1.GetFollowStatusRequest]) (*connect.Response[v1.GetFollowStatusResponse], error)
+	ListFollowers(context.Context, *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error)
+	ListFollowing(context.Context, *connect.Request[v1.ListFollowingRequest]) (*connect.Response[v1.ListFollowingResponse], error)
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
+	RequestEmailChange(context.Context, *connect.Request[v1.RequestEmailChangeRequest]) (*connect.Response[v1.RequestEmailChangeResponse], error)
+	ConfirmEmailChange(context.Context, *connect.Request[v1.ConfirmEmailChangeRequest]) (*connect.Response[v1.ConfirmEmailChangeResponse], error)
+	RequestAccountDeletion(context.Context, *connect.Request[v1.RequestAccountDeletionRequest]) (*connect.Response[v1.RequestAccountDeletionResponse], error)
+	CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error)
+	GetAccountDeletion(context.Context, *connect.Request[v1.GetAccountDeletionRequest]) (*connect.Response[v1.GetAccountDeletionResponse], error)
+	UploadAvatar(context.Context, *connect.Request[v1.UploadUserAvatarRequest]) (*connect.Response[v1.UploadUserAvatarResponse], error)
+	BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error)
+	ConfirmTOTPEnrollment(context.Context, *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error)
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+}
+
+// NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	authServiceMethods := v1.File_auth_v1_auth_proto.Services().ByName("AuthService").Methods()
+	authServiceLoginHandler := connect.NewUnaryHandler(
+		AuthServiceLoginProcedure,
+		svc.Login,
+		connect.WithSchema(authServiceMethods.ByName("Login")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyMFALoginHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyMFALoginProcedure,
+		svc.VerifyMFALogin,
+		connect.WithSchema(authServiceMethods.ByName("VerifyMFALogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRegisterHandler := connect.NewUnaryHandler(
+		AuthServiceRegisterProcedure,
+		svc.Register,
+		connect.WithSchema(authServiceMethods.ByName("Register")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmEmailHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmEmailProcedure,
+		svc.ConfirmEmail,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmEmailByLinkHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmEmailByLinkProcedure,
+		svc.ConfirmEmailByLink,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmEmailByLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSendLoginLinkHandler := connect.NewUnaryHandler(
+		AuthServiceSendLoginLinkProcedure,
+		svc.SendLoginLink,
+		connect.WithSchema(authServiceMethods.ByName("SendLoginLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceLoginByLinkHandler := connect.NewUnaryHandler(
+		AuthServiceLoginByLinkProcedure,
+		svc.LoginByLink,
+		connect.WithSchema(authServiceMethods.ByName("LoginByLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeSessionByLinkHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeSessionByLinkProcedure,
+		svc.RevokeSessionByLink,
+		connect.WithSchema(authServiceMethods.ByName("RevokeSessionByLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListOIDCProvidersHandler := connect.NewUnaryHandler(
+		AuthServiceListOIDCProvidersProcedure,
+		svc.ListOIDCProviders,
+		connect.WithSchema(authServiceMethods.ByName("ListOIDCProviders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceStartOIDCLoginHandler := connect.NewUnaryHandler(
+		AuthServiceStartOIDCLoginProcedure,
+		svc.StartOIDCLogin,
+		connect.WithSchema(authServiceMethods.ByName("StartOIDCLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCompleteOIDCLoginHandler := connect.NewUnaryHandler(
+		AuthServiceCompleteOIDCLoginProcedure,
+		svc.CompleteOIDCLogin,
+		connect.WithSchema(authServiceMethods.ByName("CompleteOIDCLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmIdentityLinkHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmIdentityLinkProcedure,
+		svc.ConfirmIdentityLink,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmIdentityLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListIdentitiesHandler := connect.NewUnaryHandler(
+		AuthServiceListIdentitiesProcedure,
+		svc.ListIdentities,
+		connect.WithSchema(authServiceMethods.ByName("ListIdentities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceUnlinkIdentityHandler := connect.NewUnaryHandler(
+		AuthServiceUnlinkIdentityProcedure,
+		svc.UnlinkIdentity,
+		connect.WithSchema(authServiceMethods.ByName("UnlinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceIssueServiceTokenHandler := connect.NewUnaryHandler(
+		AuthServiceIssueServiceTokenProcedure,
+		svc.IssueServiceToken,
+		connect.WithSchema(authServiceMethods.ByName("IssueServiceToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListSecurityEventsHandler := connect.NewUnaryHandler(
+		AuthServiceListSecurityEventsProcedure,
+		svc.ListSecurityEvents,
+		connect.WithSchema(authServiceMethods.ByName("ListSecurityEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCreatePersonalTokenHandler := connect.NewUnaryHandler(
+		AuthServiceCreatePersonalTokenProcedure,
+		svc.CreatePersonalToken,
+		connect.WithSchema(authServiceMethods.ByName("CreatePersonalToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListPersonalTokensHandler := connect.NewUnaryHandler(
+		AuthServiceListPersonalTokensProcedure,
+		svc.ListPersonalTokens,
+		connect.WithSchema(authServiceMethods.ByName("ListPersonalTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokePersonalTokenHandler := connect.NewUnaryHandler(
+		AuthServiceRevokePersonalTokenProcedure,
+		svc.RevokePersonalToken,
+		connect.WithSchema(authServiceMethods.ByName("RevokePersonalToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRequestDataExportHandler := connect.NewUnaryHandler(
+		AuthServiceRequestDataExportProcedure,
+		svc.RequestDataExport,
+		connect.WithSchema(authServiceMethods.ByName("RequestDataExport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetDataExportHandler := connect.NewUnaryHandler(
+		AuthServiceGetDataExportProcedure,
+		svc.GetDataExport,
+		connect.WithSchema(authServiceMethods.ByName("GetDataExport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRefreshTokenHandler := connect.NewUnaryHandler(
+		AuthServiceRefreshTokenProcedure,
+		svc.RefreshToken,
+		connect.WithSchema(authServiceMethods.ByName("RefreshToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceLogoutHandler := connect.NewUnaryHandler(
+		AuthServiceLogoutProcedure,
+		svc.Logout,
+		connect.WithSchema(authServiceMethods.ByName("Logout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeOtherSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeOtherSessionsProcedure,
+		svc.RevokeOtherSessions,
+		connect.WithSchema(authServiceMethods.ByName("RevokeOtherSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRenameSessionHandler := connect.NewUnaryHandler(
+		AuthServiceRenameSessionProcedure,
+		svc.RenameSession,
+		connect.WithSchema(authServiceMethods.ByName("RenameSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceResetPasswordHandler := connect.NewUnaryHandler(
+		AuthServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmPasswordResetHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmPasswordResetProcedure,
+		svc.ConfirmPasswordReset,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmPasswordReset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCheckPasswordResetCodeHandler := connect.NewUnaryHandler(
+		AuthServiceCheckPasswordResetCodeProcedure,
+		svc.CheckPasswordResetCode,
+		connect.WithSchema(authServiceMethods.ByName("CheckPasswordResetCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmPasswordResetByLinkHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmPasswordResetByLinkProcedure,
+		svc.ConfirmPasswordResetByLink,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmPasswordResetByLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetMeHandler := connect.NewUnaryHandler(
+		AuthServiceGetMeProcedure,
+		svc.GetMe,
+		connect.WithSchema(authServiceMethods.ByName("GetMe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceUpdateUserHandler := connect.NewUnaryHandler(
+		AuthServiceUpdateUserProcedure,
+		svc.UpdateUser,
+		connect.WithSchema(authServiceMethods.ByName("UpdateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetUserHandler := connect.NewUnaryHandler(
+		AuthServiceGetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(authServiceMethods.ByName("GetUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetUserByUsernameHandler := connect.NewUnaryHandler(
+		AuthServiceGetUserByUsernameProcedure,
+		svc.GetUserByUsername,
+		connect.WithSchema(authServiceMethods.ByName("GetUserByUsername")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceFollowHandler := connect.NewUnaryHandler(
+		AuthServiceFollowProcedure,
+		svc.Follow,
+		connect.WithSchema(authServiceMethods.ByName("Follow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceUnfollowHandler := connect.NewUnaryHandler(
+		AuthServiceUnfollowProcedure,
+		svc.Unfollow,
+		connect.WithSchema(authServiceMethods.ByName("Unfollow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetFollowStatusHandler := connect.NewUnaryHandler(
+		AuthServiceGetFollowStatusProcedure,
+		svc.GetFollowStatus,
+		connect.WithSchema(authServiceMethods.ByName("GetFollowStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListFollowersHandler := connect.NewUnaryHandler(
+		AuthServiceListFollowersProcedure,
+		svc.ListFollowers,
+		connect.WithSchema(authServiceMethods.ByName("ListFollowers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListFollowingHandler := connect.NewUnaryHandler(
+		AuthServiceListFollowingProcedure,
+		svc.ListFollowing,
+		connect.WithSchema(authServiceMethods.ByName("ListFollowing")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceChangePasswordHandler := connect.NewUnaryHandler(
+		AuthServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		connect.WithSchema(authServiceMethods.ByName("ChangePassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRequestEmailChangeHandler := connect.NewUnaryHandler(
+		AuthServiceRequestEmailChangeProcedure,
+		svc.RequestEmailChange,
+		connect.WithSchema(authServiceMethods.ByName("RequestEmailChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmEmailChangeHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmEmailChangeProcedure,
+		svc.ConfirmEmailChange,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmEmailChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRequestAccountDeletionHandler := connect.NewUnaryHandler(
+		AuthServiceRequestAccountDeletionProcedure,
+		svc.RequestAccountDeletion,
+		connect.WithSchema(authServiceMethods.ByName("RequestAccountDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCancelAccountDeletionHandler := connect.NewUnaryHandler(
+		AuthServiceCancelAccountDeletionProcedure,
+		svc.CancelAccountDeletion,
+		connect.WithSchema(authServiceMethods.ByName("CancelAccountDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetAccountDeletionHandler := connect.NewUnaryHandler(
+		AuthServiceGetAccountDeletionProcedure,
+		svc.GetAccountDeletion,
+		connect.WithSchema(authServiceMethods.ByName("GetAccountDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceUploadAvatarHandler := connect.NewUnaryHandler(
+		AuthServiceUploadAvatarProcedure,
+		svc.UploadAvatar,
+		connect.WithSchema(authServiceMethods.ByName("UploadAvatar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceBeginTOTPEnrollmentHandler := connect.NewUnaryHandler(
+		AuthServiceBeginTOTPEnrollmentProcedure,
+		svc.BeginTOTPEnrollment,
+		connect.WithSchema(authServiceMethods.ByName("BeginTOTPEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmTOTPEnrollmentHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmTOTPEnrollmentProcedure,
+		svc.ConfirmTOTPEnrollment,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmTOTPEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		AuthServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(authServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRegenerateRecoveryCodesHandler := connect.NewUnaryHandler(
+		AuthServiceRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(authServiceMethods.ByName("RegenerateRecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/auth.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuthServiceLoginProcedure:
+			authServiceLoginHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyMFALoginProcedure:
+			authServiceVerifyMFALoginHandler.ServeHTTP(w, r)
+		case AuthServiceRegisterProcedure:
+			authServiceRegisterHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmEmailProcedure:
+			authServiceConfirmEmailHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmEmailByLinkProcedure:
+			authServiceConfirmEmailByLinkHandler.ServeHTTP(w, r)
+		case AuthServiceSendLoginLinkProcedure:
+			authServiceSendLoginLinkHandler.ServeHTTP(w, r)
+		case AuthServiceLoginByLinkProcedure:
+			authServiceLoginByLinkHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeSessionByLinkProcedure:
+			authServiceRevokeSessionByLinkHandler.ServeHTTP(w, r)
+		case AuthServiceListOIDCProvidersProcedure:
+			authServiceListOIDCProvidersHandler.ServeHTTP(w, r)
+		case AuthServiceStartOIDCLoginProcedure:
+			authServiceStartOIDCLoginHandler.ServeHTTP(w, r)
+		case AuthServiceCompleteOIDCLoginProcedure:
+			authServiceCompleteOIDCLoginHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmIdentityLinkProcedure:
+			authServiceConfirmIdentityLinkHandler.ServeHTTP(w, r)
+		case AuthServiceListIdentitiesProcedure:
+			authServiceListIdentitiesHandler.ServeHTTP(w, r)
+		case AuthServiceUnlinkIdentityProcedure:
+			authServiceUnlinkIdentityHandler.ServeHTTP(w, r)
+		case AuthServiceIssueServiceTokenProcedure:
+			authServiceIssueServiceTokenHandler.ServeHTTP(w, r)
+		case AuthServiceListSecurityEventsProcedure:
+			authServiceListSecurityEventsHandler.ServeHTTP(w, r)
+		case AuthServiceCreatePersonalTokenProcedure:
+			authServiceCreatePersonalTokenHandler.ServeHTTP(w, r)
+		case AuthServiceListPersonalTokensProcedure:
+			authServiceListPersonalTokensHandler.ServeHTTP(w, r)
+		case AuthServiceRevokePersonalTokenProcedure:
+			authServiceRevokePersonalTokenHandler.ServeHTTP(w, r)
+		case AuthServiceRequestDataExportProcedure:
+			authServiceRequestDataExportHandler.ServeHTTP(w, r)
+		case AuthServiceGetDataExportProcedure:
+			authServiceGetDataExportHandler.ServeHTTP(w, r)
+		case AuthServiceRefreshTokenProcedure:
+			authServiceRefreshTokenHandler.ServeHTTP(w, r)
+		case AuthServiceLogoutProcedure:
+			authServiceLogoutHandler.ServeHTTP(w, r)
+		case AuthServiceListSessionsProcedure:
+			authServiceListSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeSessionProcedure:
+			authServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeOtherSessionsProcedure:
+			authServiceRevokeOtherSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceRenameSessionProcedure:
+			authServiceRenameSessionHandler.ServeHTTP(w, r)
+		case AuthServiceResetPasswordProcedure:
+			authServiceResetPasswordHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmPasswordResetProcedure:
+			authServiceConfirmPasswordResetHandler.ServeHTTP(w, r)
+		case AuthServiceCheckPasswordResetCodeProcedure:
+			authServiceCheckPasswordResetCodeHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmPasswordResetByLinkProcedure:
+			authServiceConfirmPasswordResetByLinkHandler.ServeHTTP(w, r)
+		case AuthServiceGetMeProcedure:
+			authServiceGetMeHandler.ServeHTTP(w, r)
+		case AuthServiceUpdateUserProcedure:
+			authServiceUpdateUserHandler.ServeHTTP(w, r)
+		case AuthServiceGetUserProcedure:
+			authServiceGetUserHandler.ServeHTTP(w, r)
+		case AuthServiceGetUserByUsernameProcedure:
+			authServiceGetUserByUsernameHandler.ServeHTTP(w, r)
+		case AuthServiceFollowProcedure:
+			authServiceFollowHandler.ServeHTTP(w, r)
+		case AuthServiceUnfollowProcedure:
+			authServiceUnfollowHandler.ServeHTTP(w, r)
+		case AuthServiceGetFollowStatusProcedure:
+			authServiceGetFollowStatusHandler.ServeHTTP(w, r)
+		case AuthServiceListFollowersProcedure:
+			authServiceListFollowersHandler.ServeHTTP(w, r)
+		case AuthServiceListFollowingProcedure:
+			authServiceListFollowingHandler.ServeHTTP(w, r)
+		case AuthServiceChangePasswordProcedure:
+			authServiceChangePasswordHandler.ServeHTTP(w, r)
+		case AuthServiceRequestEmailChangeProcedure:
+			authServiceRequestEmailChangeHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmEmailChangeProcedure:
+			authServiceConfirmEmailChangeHandler.ServeHTTP(w, r)
+		case AuthServiceRequestAccountDeletionProcedure:
+			authServiceRequestAccountDeletionHandler.ServeHTTP(w, r)
+		case AuthServiceCancelAccountDeletionProcedure:
+			authServiceCancelAccountDeletionHandler.ServeHTTP(w, r)
+		case AuthServiceGetAccountDeletionProcedure:
+			authServiceGetAccountDeletionHandler.ServeHTTP(w, r)
+		case AuthServiceUploadAvatarProcedure:
+			authServiceUploadAvatarHandler.ServeHTTP(w, r)
+		case AuthServiceBeginTOTPEnrollmentProcedure:
+			authServiceBeginTOTPEnrollmentHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmTOTPEnrollmentProcedure:
+			authServiceConfirmTOTPEnrollmentHandler.ServeHTTP(w, r)
+		case AuthServiceDisableTOTPProcedure:
+			authServiceDisableTOTPHandler.ServeHTTP(w, r)
+		case AuthServiceRegenerateRecoveryCodesProcedure:
+			authServiceRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuthServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuthServiceHandler struct{}
+
+func (UnimplementedAuthServiceHandler) Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Login is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyMFALogin(context.Context, *connect.Request[v1.VerifyMFALoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.VerifyMFALogin is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Register is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmEmail(context.Context, *connect.Request[v1.ConfirmEmailRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ConfirmEmail is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmEmailByLink(context.Context, *connect.Request[v1.ConfirmEmailByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ConfirmEmailByLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SendLoginLink(context.Context, *connect.Request[v1.SendLoginLinkRequest]) (*connect.Response[v1.SendLoginLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.SendLoginLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) LoginByLink(context.Context, *connect.Request[v1.LoginByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.LoginByLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeSessionByLink(context.Context, *connect.Request[v1.RevokeSessionByLinkRequest]) (*connect.Response[v1.RevokeSessionByLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RevokeSessionByLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListOIDCProviders(context.Context, *connect.Request[v1.ListOIDCProvidersRequest]) (*connect.Response[v1.ListOIDCProvidersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListOIDCProviders is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) StartOIDCLogin(context.Context, *connect.Request[v1.StartOIDCLoginRequest]) (*connect.Response[v1.StartOIDCLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.StartOIDCLogin is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CompleteOIDCLogin(context.Context, *connect.Request[v1.CompleteOIDCLoginRequest]) (*connect.Response[v1.CompleteOIDCLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.CompleteOIDCLogin is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmIdentityLink(context.Context, *connect.Request[v1.ConfirmIdentityLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ConfirmIdentityLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListIdentities is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.UnlinkIdentity is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) IssueServiceToken(context.Context, *connect.Request[v1.IssueServiceTokenRequest]) (*connect.Response[v1.IssueServiceTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.IssueServiceToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListSecurityEvents(context.Context, *connect.Request[v1.ListSecurityEventsRequest]) (*connect.Response[v1.ListSecurityEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListSecurityEvents is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CreatePersonalToken(context.Context, *connect.Request[v1.CreatePersonalTokenRequest]) (*connect.Response[v1.CreatePersonalTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.CreatePersonalToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListPersonalTokens(context.Context, *connect.Request[v1.ListPersonalTokensRequest]) (*connect.Response[v1.ListPersonalTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListPersonalTokens is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokePersonalToken(context.Context, *connect.Request[v1.RevokePersonalTokenRequest]) (*connect.Response[v1.RevokePersonalTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RevokePersonalToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RequestDataExport(context.Context, *connect.Request[v1.RequestDataExportRequest]) (*connect.Response[v1.RequestDataExportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RequestDataExport is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetDataExport(context.Context, *connect.Request[v1.GetDataExportRequest]) (*connect.Response[v1.GetDataExportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetDataExport is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RefreshToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Logout is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RevokeOtherSessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RenameSession(context.Context, *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RenameSession is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ResetPassword is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmPasswordReset(context.Context, *connect.Request[v1.ConfirmPasswordResetRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ConfirmPasswordReset is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CheckPasswordResetCode(context.Context, *connect.Request[v1.CheckPasswordResetCodeRequest]) (*connect.Response[v1.CheckPasswordResetCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.CheckPasswordResetCode is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmPasswordResetByLink(context.Context, *connect.Request[v1.ConfirmPasswordResetByLinkRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ConfirmPasswordResetByLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetMe is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.UpdateUser is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetUser is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetUserByUsername(context.Context, *connect.Request[v1.GetUserByUsernameRequest]) (*connect.Response[v1.GetUserByUsernameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetUserByUsername is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Follow(context.Context, *connect.Request[v1.FollowRequest]) (*connect.Response[v1.FollowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Follow is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Unfollow(context.Context, *connect.Request[v1.UnfollowRequest]) (*connect.Response[v1.UnfollowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Unfollow is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetFollowStatus(context.Context, *connect.Request[v1.GetFollowStatusRequest]) (*connect.Response[v1.GetFollowStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetFollowStatus is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListFollowers(context.Context, *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListFollowers is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListFollowing(context.Context, *connect.Request[v1.ListFollowingRequest]) (*connect.Response[v1.ListFollowingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListFollowing is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ChangePassword is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RequestEmailChange(context.Context, *connect.Request[v1.RequestEmailChangeRequest]) (*connect.Response[v1.RequestEmailChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RequestEmailChange is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmEmailChange(context.Context, *connect.Request[v1.ConfirmEmailChangeRequest]) (*connect.Response[v1.ConfirmEmailChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ConfirmEmailChange is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RequestAccountDeletion(context.Context, *connect.Request[v1.RequestAccountDeletionRequest]) (*connect.Response[v1.RequestAccountDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RequestAccountDeletion is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.CancelAccountDeletion is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetAccountDeletion(context.Context, *connect.Request[v1.GetAccountDeletionRequest]) (*connect.Response[v1.GetAccountDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetAccountDeletion is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) UploadAvatar(context.Context, *connect.Request[v1.UploadUserAvatarRequest]) (*connect.Response[v1.UploadUserAvatarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.UploadAvatar is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.BeginTOTPEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmTOTPEnrollment(context.Context, *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ConfirmTOTPEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.DisableTOTP is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RegenerateRecoveryCodes is not implemented"))
+}
@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: auth/v1/internal.proto
+
+package authv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuthInternalServiceName is the fully-qualified name of the AuthInternalService service.
+	AuthInternalServiceName = "auth.v1.AuthInternalService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuthInternalServiceValidatePersonalTokenProcedure is the fully-qualified name of the
+	// AuthInternalService's ValidatePersonalToken RPC.
+	AuthInternalServiceValidatePersonalTokenProcedure = "/auth.v1.AuthInternalService/ValidatePersonalToken"
+)
+
+// AuthInternalServiceClient is a client for the auth.v1.AuthInternalService service.
+type AuthInternalServiceClient interface {
+	// ValidatePersonalToken authenticates the requests made with the personal access tokens,
+	// which are opaque and known only to auth-service. Requires the "personal_tokens:validate" scope.
+	ValidatePersonalToken(context.Context, *connect.Request[v1.ValidatePersonalTokenRequest]) (*connect.Response[v1.ValidatePersonalTokenResponse], error)
+}
+
+// NewAuthInternalServiceClient constructs a client for the auth.v1.AuthInternalService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuthInternalServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuthInternalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	authInternalServiceMethods := v1.File_auth_v1_internal_proto.Services().ByName("AuthInternalService").Methods()
+	return &authInternalServiceClient{
+		validatePersonalToken: connect.NewClient[v1.ValidatePersonalTokenRequest, v1.ValidatePersonalTokenResponse](
+			httpClient,
+			baseURL+AuthInternalServiceValidatePersonalTokenProcedure,
+			connect.WithSchema(authInternalServiceMethods.ByName("ValidatePersonalToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// authInternalServiceClient implements AuthInternalServiceClient.
+type authInternalServiceClient struct {
+	validatePersonalToken *connect.Client[v1.ValidatePersonalTokenRequest, v1.ValidatePersonalTokenResponse]
+}
+
+// ValidatePersonalToken calls auth.v1.AuthInternalService.ValidatePersonalToken.
+func (c *authInternalServiceClient) ValidatePersonalToken(ctx context.Context, req *connect.Request[v1.ValidatePersonalTokenRequest]) (*connect.Response[v1.ValidatePersonalTokenResponse], error) {
+	return c.validatePersonalToken.CallUnary(ctx, req)
+}
+
+// AuthInternalServiceHandler is an implementation of the auth.v1.AuthInternalService service.
+type AuthInternalServiceHandler interface {
+	// ValidatePersonalToken authenticates the requests made with the personal access tokens,
+	// which are opaque and known only to auth-service. Requires the "personal_tokens:validate" scope.
+	ValidatePersonalToken(context.Context, *connect.Request[v1.ValidatePersonalTokenRequest]) (*connect.Response[v1.ValidatePersonalTokenResponse], error)
+}
+
+// NewAuthInternalServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuthInternalServiceHandler(svc AuthInternalServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	authInternalServiceMethods := v1.File_auth_v1_internal_proto.Services().ByName("AuthInternalService").Methods()
+	authInternalServiceValidatePersonalTokenHandler := connect.NewUnaryHandler(
+		AuthInternalServiceValidatePersonalTokenProcedure,
+		svc.ValidatePersonalToken,
+		connect.WithSchema(authInternalServiceMethods.ByName("ValidatePersonalToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/auth.v1.AuthInternalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuthInternalServiceValidatePersonalTokenProcedure:
+			authInternalServiceValidatePersonalTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuthInternalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuthInternalServiceHandler struct{}
+
+func (UnimplementedAuthInternalServiceHandler) ValidatePersonalToken(context.Context, *connect.Request[v1.ValidatePersonalTokenRequest]) (*connect.Response[v1.ValidatePersonalTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthInternalService.ValidatePersonalToken is not implemented"))
+}
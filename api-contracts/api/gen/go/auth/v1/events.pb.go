@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: auth/v1/events.proto
+
+package authv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Published to users.<user_id>.export_requested, the services collect the data of the user
+// and report it back to users.<user_id>.export_part_completed.
+type UserExportRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExportRequestedEvent) Reset() {
+	*x = UserExportRequestedEvent{}
+	mi := &file_auth_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportRequestedEvent) ProtoMessage() {}
+
+func (x *UserExportRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportRequestedEvent.ProtoReflect.Descriptor instead.
+func (*UserExportRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_auth_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserExportRequestedEvent) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *UserExportRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserExportRequestedEvent) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+// Published to users.<follower_id>.followed and users.<follower_id>.unfollowed. The follows of the purged
+// users are removed with unfollowed events published before users.<user_id>.deleted.
+type UserFollowEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId    string                 `protobuf:"bytes,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFollowEvent) Reset() {
+	*x = UserFollowEvent{}
+	mi := &file_auth_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFollowEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFollowEvent) ProtoMessage() {}
+
+func (x *UserFollowEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFollowEvent.ProtoReflect.Descriptor instead.
+func (*UserFollowEvent) Descriptor() ([]byte, []int) {
+	return file_auth_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserFollowEvent) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *UserFollowEvent) GetFolloweeId() string {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return ""
+}
+
+func (x *UserFollowEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Published to users.<user_id>.deletion_completed by every service once it has deleted the data of the user.
+type UserDeletionCompletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeletionCompletedEvent) Reset() {
+	*x = UserDeletionCompletedEvent{}
+	mi := &file_auth_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletionCompletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletionCompletedEvent) ProtoMessage() {}
+
+func (x *UserDeletionCompletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletionCompletedEvent.ProtoReflect.Descriptor instead.
+func (*UserDeletionCompletedEvent) Descriptor() ([]byte, []int) {
+	return file_auth_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserDeletionCompletedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDeletionCompletedEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *UserDeletionCompletedEvent) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// Published to users.<user_id>.export_part_completed by every service once it has collected the data of the user.
+// The part is either uploaded to the exports bucket or, if it is small, sent in the event.
+type UserExportPartCompletedEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ExportId string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	UserId   string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Service  string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	// Types that are valid to be assigned to Part:
+	//
+	//	*UserExportPartCompletedEvent_ArchiveKey
+	//	*UserExportPartCompletedEvent_Data
+	Part          isUserExportPartCompletedEvent_Part `protobuf_oneof:"part"`
+	CompletedAt   *timestamppb.Timestamp              `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExportPartCompletedEvent) Reset() {
+	*x = UserExportPartCompletedEvent{}
+	mi := &file_auth_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportPartCompletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportPartCompletedEvent) ProtoMessage() {}
+
+func (x *UserExportPartCompletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportPartCompletedEvent.ProtoReflect.Descriptor instead.
+func (*UserExportPartCompletedEvent) Descriptor() ([]byte, []int) {
+	return file_auth_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserExportPartCompletedEvent) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *UserExportPartCompletedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserExportPartCompletedEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *UserExportPartCompletedEvent) GetPart() isUserExportPartCompletedEvent_Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *UserExportPartCompletedEvent) GetArchiveKey() string {
+	if x != nil {
+		if x, ok := x.Part.(*UserExportPartCompletedEvent_ArchiveKey); ok {
+			return x.ArchiveKey
+		}
+	}
+	return ""
+}
+
+func (x *UserExportPartCompletedEvent) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Part.(*UserExportPartCompletedEvent_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *UserExportPartCompletedEvent) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type isUserExportPartCompletedEvent_Part interface {
+	isUserExportPartCompletedEvent_Part()
+}
+
+type UserExportPartCompletedEvent_ArchiveKey struct {
+	ArchiveKey string `protobuf:"bytes,4,opt,name=archive_key,json=archiveKey,proto3,oneof"`
+}
+
+type UserExportPartCompletedEvent_Data struct {
+	// JSON document with the data of the user.
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3,oneof"`
+}
+
+func (*UserExportPartCompletedEvent_ArchiveKey) isUserExportPartCompletedEvent_Part() {}
+
+func (*UserExportPartCompletedEvent_Data) isUserExportPartCompletedEvent_Part() {}
+
+var File_auth_v1_events_proto protoreflect.FileDescriptor
+
+const file_auth_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x14auth/v1/events.proto\x12\aauth.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x01\n" +
+	"\x18UserExportRequestedEvent\x12%\n" +
+	"\texport_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bexportId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12=\n" +
+	"\frequested_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xa4\x01\n" +
+	"\x0fUserFollowEvent\x12)\n" +
+	"\vfollower_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"followerId\x12)\n" +
+	"\vfollowee_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"followeeId\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xa1\x01\n" +
+	"\x1aUserDeletionCompletedEvent\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
+	"\aservice\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aservice\x12=\n" +
+	"\fcompleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x8b\x02\n" +
+	"\x1cUserExportPartCompletedEvent\x12%\n" +
+	"\texport_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bexportId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
+	"\aservice\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aservice\x12!\n" +
+	"\varchive_key\x18\x04 \x01(\tH\x00R\n" +
+	"archiveKey\x12\x14\n" +
+	"\x04data\x18\x05 \x01(\fH\x00R\x04data\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAtB\x06\n" +
+	"\x04partBAZ?github.com/tech-inspire/api-contracts/api/gen/go/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_events_proto_rawDescOnce sync.Once
+	file_auth_v1_events_proto_rawDescData []byte
+)
+
+func file_auth_v1_events_proto_rawDescGZIP() []byte {
+	file_auth_v1_events_proto_rawDescOnce.Do(func() {
+		file_auth_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_events_proto_rawDesc), len(file_auth_v1_events_proto_rawDesc)))
+	})
+	return file_auth_v1_events_proto_rawDescData
+}
+
+var file_auth_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_auth_v1_events_proto_goTypes = []any{
+	(*UserExportRequestedEvent)(nil),     // 0: auth.v1.UserExportRequestedEvent
+	(*UserFollowEvent)(nil),              // 1: auth.v1.UserFollowEvent
+	(*UserDeletionCompletedEvent)(nil),   // 2: auth.v1.UserDeletionCompletedEvent
+	(*UserExportPartCompletedEvent)(nil), // 3: auth.v1.UserExportPartCompletedEvent
+	(*timestamppb.Timestamp)(nil),        // 4: google.protobuf.Timestamp
+}
+var file_auth_v1_events_proto_depIdxs = []int32{
+	4, // 0: auth.v1.UserExportRequestedEvent.requested_at:type_name -> google.protobuf.Timestamp
+	4, // 1: auth.v1.UserFollowEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // 2: auth.v1.UserDeletionCompletedEvent.completed_at:type_name -> google.protobuf.Timestamp
+	4, // 3: auth.v1.UserExportPartCompletedEvent.completed_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_events_proto_init() }
+func file_auth_v1_events_proto_init() {
+	if File_auth_v1_events_proto != nil {
+		return
+	}
+	file_auth_v1_events_proto_msgTypes[3].OneofWrappers = []any{
+		(*UserExportPartCompletedEvent_ArchiveKey)(nil),
+		(*UserExportPartCompletedEvent_Data)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_events_proto_rawDesc), len(file_auth_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_auth_v1_events_proto_goTypes,
+		DependencyIndexes: file_auth_v1_events_proto_depIdxs,
+		MessageInfos:      file_auth_v1_events_proto_msgTypes,
+	}.Build()
+	File_auth_v1_events_proto = out.File
+	file_auth_v1_events_proto_goTypes = nil
+	file_auth_v1_events_proto_depIdxs = nil
+}
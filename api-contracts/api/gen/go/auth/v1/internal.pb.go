@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: auth/v1/internal.proto
+
+package authv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidatePersonalTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePersonalTokenRequest) Reset() {
+	*x = ValidatePersonalTokenRequest{}
+	mi := &file_auth_v1_internal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePersonalTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePersonalTokenRequest) ProtoMessage() {}
+
+func (x *ValidatePersonalTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_internal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePersonalTokenRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePersonalTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_internal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatePersonalTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ValidatePersonalTokenResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PersonalTokenId string                 `protobuf:"bytes,2,opt,name=personal_token_id,json=personalTokenId,proto3" json:"personal_token_id,omitempty"`
+	Scopes          []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidatePersonalTokenResponse) Reset() {
+	*x = ValidatePersonalTokenResponse{}
+	mi := &file_auth_v1_internal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePersonalTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePersonalTokenResponse) ProtoMessage() {}
+
+func (x *ValidatePersonalTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_internal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePersonalTokenResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePersonalTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_internal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatePersonalTokenResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidatePersonalTokenResponse) GetPersonalTokenId() string {
+	if x != nil {
+		return x.PersonalTokenId
+	}
+	return ""
+}
+
+func (x *ValidatePersonalTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ValidatePersonalTokenResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_auth_v1_internal_proto protoreflect.FileDescriptor
+
+const file_auth_v1_internal_proto_rawDesc = "" +
+	"\n" +
+	"\x16auth/v1/internal.proto\x12\aauth.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"=\n" +
+	"\x1cValidatePersonalTokenRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05token\"\xb7\x01\n" +
+	"\x1dValidatePersonalTokenResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x11personal_token_id\x18\x02 \x01(\tR\x0fpersonalTokenId\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt2}\n" +
+	"\x13AuthInternalService\x12f\n" +
+	"\x15ValidatePersonalToken\x12%.auth.v1.ValidatePersonalTokenRequest\x1a&.auth.v1.ValidatePersonalTokenResponseBAZ?github.com/tech-inspire/api-contracts/api/gen/go/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_internal_proto_rawDescOnce sync.Once
+	file_auth_v1_internal_proto_rawDescData []byte
+)
+
+func file_auth_v1_internal_proto_rawDescGZIP() []byte {
+	file_auth_v1_internal_proto_rawDescOnce.Do(func() {
+		file_auth_v1_internal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_internal_proto_rawDesc), len(file_auth_v1_internal_proto_rawDesc)))
+	})
+	return file_auth_v1_internal_proto_rawDescData
+}
+
+var file_auth_v1_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_auth_v1_internal_proto_goTypes = []any{
+	(*ValidatePersonalTokenRequest)(nil),  // 0: auth.v1.ValidatePersonalTokenRequest
+	(*ValidatePersonalTokenResponse)(nil), // 1: auth.v1.ValidatePersonalTokenResponse
+	(*timestamppb.Timestamp)(nil),         // 2: google.protobuf.Timestamp
+}
+var file_auth_v1_internal_proto_depIdxs = []int32{
+	2, // 0: auth.v1.ValidatePersonalTokenResponse.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: auth.v1.AuthInternalService.ValidatePersonalToken:input_type -> auth.v1.ValidatePersonalTokenRequest
+	1, // 2: auth.v1.AuthInternalService.ValidatePersonalToken:output_type -> auth.v1.ValidatePersonalTokenResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_internal_proto_init() }
+func file_auth_v1_internal_proto_init() {
+	if File_auth_v1_internal_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_internal_proto_rawDesc), len(file_auth_v1_internal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_internal_proto_goTypes,
+		DependencyIndexes: file_auth_v1_internal_proto_depIdxs,
+		MessageInfos:      file_auth_v1_internal_proto_msgTypes,
+	}.Build()
+	File_auth_v1_internal_proto = out.File
+	file_auth_v1_internal_proto_goTypes = nil
+	file_auth_v1_internal_proto_depIdxs = nil
+}
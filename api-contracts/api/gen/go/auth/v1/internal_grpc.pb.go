@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: auth/v1/internal.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthInternalService_ValidatePersonalToken_FullMethodName = "/auth.v1.AuthInternalService/ValidatePersonalToken"
+)
+
+// AuthInternalServiceClient is the client API for AuthInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuthInternalService is called by the other services of the backend with the service tokens.
+// It is served under its own path, so that it can be kept off the public gateway.
+type AuthInternalServiceClient interface {
+	// ValidatePersonalToken authenticates the requests made with the personal access tokens,
+	// which are opaque and known only to auth-service. Requires the "personal_tokens:validate" scope.
+	ValidatePersonalToken(ctx context.Context, in *ValidatePersonalTokenRequest, opts ...grpc.CallOption) (*ValidatePersonalTokenResponse, error)
+}
+
+type authInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthInternalServiceClient(cc grpc.ClientConnInterface) AuthInternalServiceClient {
+	return &authInternalServiceClient{cc}
+}
+
+func (c *authInternalServiceClient) ValidatePersonalToken(ctx context.Context, in *ValidatePersonalTokenRequest, opts ...grpc.CallOption) (*ValidatePersonalTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatePersonalTokenResponse)
+	err := c.cc.Invoke(ctx, AuthInternalService_ValidatePersonalToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthInternalServiceServer is the server API for AuthInternalService service.
+// All implementations must embed UnimplementedAuthInternalServiceServer
+// for forward compatibility.
+//
+// AuthInternalService is called by the other services of the backend with the service tokens.
+// It is served under its own path, so that it can be kept off the public gateway.
+type AuthInternalServiceServer interface {
+	// ValidatePersonalToken authenticates the requests made with the personal access tokens,
+	// which are opaque and known only to auth-service. Requires the "personal_tokens:validate" scope.
+	ValidatePersonalToken(context.Context, *ValidatePersonalTokenRequest) (*ValidatePersonalTokenResponse, error)
+	mustEmbedUnimplementedAuthInternalServiceServer()
+}
+
+// UnimplementedAuthInternalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthInternalServiceServer struct{}
+
+func (UnimplementedAuthInternalServiceServer) ValidatePersonalToken(context.Context, *ValidatePersonalTokenRequest) (*ValidatePersonalTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePersonalToken not implemented")
+}
+func (UnimplementedAuthInternalServiceServer) mustEmbedUnimplementedAuthInternalServiceServer() {}
+func (UnimplementedAuthInternalServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAuthInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthInternalServiceServer will
+// result in compilation errors.
+type UnsafeAuthInternalServiceServer interface {
+	mustEmbedUnimplementedAuthInternalServiceServer()
+}
+
+func RegisterAuthInternalServiceServer(s grpc.ServiceRegistrar, srv AuthInternalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthInternalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthInternalService_ServiceDesc, srv)
+}
+
+func _AuthInternalService_ValidatePersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePersonalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthInternalServiceServer).ValidatePersonalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthInternalService_ValidatePersonalToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthInternalServiceServer).ValidatePersonalToken(ctx, req.(*ValidatePersonalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthInternalService_ServiceDesc is the grpc.ServiceDesc for AuthInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.AuthInternalService",
+	HandlerType: (*AuthInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidatePersonalToken",
+			Handler:    _AuthInternalService_ValidatePersonalToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/internal.proto",
+}
@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: embeddings/v1/embeddings.proto
+
+package embeddingsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GenerateTextEmbeddingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTextEmbeddingsRequest) Reset() {
+	*x = GenerateTextEmbeddingsRequest{}
+	mi := &file_embeddings_v1_embeddings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTextEmbeddingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTextEmbeddingsRequest) ProtoMessage() {}
+
+func (x *GenerateTextEmbeddingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embeddings_v1_embeddings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTextEmbeddingsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTextEmbeddingsRequest) Descriptor() ([]byte, []int) {
+	return file_embeddings_v1_embeddings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenerateTextEmbeddingsRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type GenerateTextEmbeddingsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmbeddingVector []float32              `protobuf:"fixed32,1,rep,packed,name=embedding_vector,json=embeddingVector,proto3" json:"embedding_vector,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateTextEmbeddingsResponse) Reset() {
+	*x = GenerateTextEmbeddingsResponse{}
+	mi := &file_embeddings_v1_embeddings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTextEmbeddingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTextEmbeddingsResponse) ProtoMessage() {}
+
+func (x *GenerateTextEmbeddingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embeddings_v1_embeddings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTextEmbeddingsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTextEmbeddingsResponse) Descriptor() ([]byte, []int) {
+	return file_embeddings_v1_embeddings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateTextEmbeddingsResponse) GetEmbeddingVector() []float32 {
+	if x != nil {
+		return x.EmbeddingVector
+	}
+	return nil
+}
+
+var File_embeddings_v1_embeddings_proto protoreflect.FileDescriptor
+
+const file_embeddings_v1_embeddings_proto_rawDesc = "" +
+	"\n" +
+	"\x1eembeddings/v1/embeddings.proto\x12\rembeddings.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"3\n" +
+	"\x1dGenerateTextEmbeddingsRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"K\n" +
+	"\x1eGenerateTextEmbeddingsResponse\x12)\n" +
+	"\x10embedding_vector\x18\x01 \x03(\x02R\x0fembeddingVector2\x8a\x01\n" +
+	"\x11EmbeddingsService\x12u\n" +
+	"\x16GenerateTextEmbeddings\x12,.embeddings.v1.GenerateTextEmbeddingsRequest\x1a-.embeddings.v1.GenerateTextEmbeddingsResponseBMZKgithub.com/tech-inspire/api-contracts/api/gen/go/embeddings/v1;embeddingsv1b\x06proto3"
+
+var (
+	file_embeddings_v1_embeddings_proto_rawDescOnce sync.Once
+	file_embeddings_v1_embeddings_proto_rawDescData []byte
+)
+
+func file_embeddings_v1_embeddings_proto_rawDescGZIP() []byte {
+	file_embeddings_v1_embeddings_proto_rawDescOnce.Do(func() {
+		file_embeddings_v1_embeddings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_embeddings_v1_embeddings_proto_rawDesc), len(file_embeddings_v1_embeddings_proto_rawDesc)))
+	})
+	return file_embeddings_v1_embeddings_proto_rawDescData
+}
+
+var file_embeddings_v1_embeddings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_embeddings_v1_embeddings_proto_goTypes = []any{
+	(*GenerateTextEmbeddingsRequest)(nil),  // 0: embeddings.v1.GenerateTextEmbeddingsRequest
+	(*GenerateTextEmbeddingsResponse)(nil), // 1: embeddings.v1.GenerateTextEmbeddingsResponse
+}
+var file_embeddings_v1_embeddings_proto_depIdxs = []int32{
+	0, // 0: embeddings.v1.EmbeddingsService.GenerateTextEmbeddings:input_type -> embeddings.v1.GenerateTextEmbeddingsRequest
+	1, // 1: embeddings.v1.EmbeddingsService.GenerateTextEmbeddings:output_type -> embeddings.v1.GenerateTextEmbeddingsResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_embeddings_v1_embeddings_proto_init() }
+func file_embeddings_v1_embeddings_proto_init() {
+	if File_embeddings_v1_embeddings_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_embeddings_v1_embeddings_proto_rawDesc), len(file_embeddings_v1_embeddings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_embeddings_v1_embeddings_proto_goTypes,
+		DependencyIndexes: file_embeddings_v1_embeddings_proto_depIdxs,
+		MessageInfos:      file_embeddings_v1_embeddings_proto_msgTypes,
+	}.Build()
+	File_embeddings_v1_embeddings_proto = out.File
+	file_embeddings_v1_embeddings_proto_goTypes = nil
+	file_embeddings_v1_embeddings_proto_depIdxs = nil
+}
@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: embeddings/v1/embeddings.proto
+
+package embeddingsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmbeddingsService_GenerateTextEmbeddings_FullMethodName = "/embeddings.v1.EmbeddingsService/GenerateTextEmbeddings"
+)
+
+// EmbeddingsServiceClient is the client API for EmbeddingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmbeddingsServiceClient interface {
+	GenerateTextEmbeddings(ctx context.Context, in *GenerateTextEmbeddingsRequest, opts ...grpc.CallOption) (*GenerateTextEmbeddingsResponse, error)
+}
+
+type embeddingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmbeddingsServiceClient(cc grpc.ClientConnInterface) EmbeddingsServiceClient {
+	return &embeddingsServiceClient{cc}
+}
+
+func (c *embeddingsServiceClient) GenerateTextEmbeddings(ctx context.Context, in *GenerateTextEmbeddingsRequest, opts ...grpc.CallOption) (*GenerateTextEmbeddingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateTextEmbeddingsResponse)
+	err := c.cc.Invoke(ctx, EmbeddingsService_GenerateTextEmbeddings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmbeddingsServiceServer is the server API for EmbeddingsService service.
+// All implementations must embed UnimplementedEmbeddingsServiceServer
+// for forward compatibility.
+type EmbeddingsServiceServer interface {
+	GenerateTextEmbeddings(context.Context, *GenerateTextEmbeddingsRequest) (*GenerateTextEmbeddingsResponse, error)
+	mustEmbedUnimplementedEmbeddingsServiceServer()
+}
+
+// UnimplementedEmbeddingsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmbeddingsServiceServer struct{}
+
+func (UnimplementedEmbeddingsServiceServer) GenerateTextEmbeddings(context.Context, *GenerateTextEmbeddingsRequest) (*GenerateTextEmbeddingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTextEmbeddings not implemented")
+}
+func (UnimplementedEmbeddingsServiceServer) mustEmbedUnimplementedEmbeddingsServiceServer() {}
+func (UnimplementedEmbeddingsServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeEmbeddingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmbeddingsServiceServer will
+// result in compilation errors.
+type UnsafeEmbeddingsServiceServer interface {
+	mustEmbedUnimplementedEmbeddingsServiceServer()
+}
+
+func RegisterEmbeddingsServiceServer(s grpc.ServiceRegistrar, srv EmbeddingsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmbeddingsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmbeddingsService_ServiceDesc, srv)
+}
+
+func _EmbeddingsService_GenerateTextEmbeddings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateTextEmbeddingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmbeddingsServiceServer).GenerateTextEmbeddings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmbeddingsService_GenerateTextEmbeddings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmbeddingsServiceServer).GenerateTextEmbeddings(ctx, req.(*GenerateTextEmbeddingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmbeddingsService_ServiceDesc is the grpc.ServiceDesc for EmbeddingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmbeddingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embeddings.v1.EmbeddingsService",
+	HandlerType: (*EmbeddingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateTextEmbeddings",
+			Handler:    _EmbeddingsService_GenerateTextEmbeddings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "embeddings/v1/embeddings.proto",
+}
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: posts/v1/events.proto
+
+package postsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PostCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Post          *Post                  `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostCreatedEvent) Reset() {
+	*x = PostCreatedEvent{}
+	mi := &file_posts_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCreatedEvent) ProtoMessage() {}
+
+func (x *PostCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCreatedEvent.ProtoReflect.Descriptor instead.
+func (*PostCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_posts_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PostCreatedEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PostCreatedEvent) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Post          *Post                  `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostUpdatedEvent) Reset() {
+	*x = PostUpdatedEvent{}
+	mi := &file_posts_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostUpdatedEvent) ProtoMessage() {}
+
+func (x *PostUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*PostUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_posts_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PostUpdatedEvent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PostUpdatedEvent) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Post          *Post                  `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostDeletedEvent) Reset() {
+	*x = PostDeletedEvent{}
+	mi := &file_posts_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostDeletedEvent) ProtoMessage() {}
+
+func (x *PostDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostDeletedEvent.ProtoReflect.Descriptor instead.
+func (*PostDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_posts_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PostDeletedEvent) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *PostDeletedEvent) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+var File_posts_v1_events_proto protoreflect.FileDescriptor
+
+const file_posts_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x15posts/v1/events.proto\x12\bposts.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14posts/v1/posts.proto\"q\n" +
+	"\x10PostCreatedEvent\x129\n" +
+	"\n" +
+	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\"\n" +
+	"\x04post\x18\x02 \x01(\v2\x0e.posts.v1.PostR\x04post\"q\n" +
+	"\x10PostUpdatedEvent\x129\n" +
+	"\n" +
+	"updated_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\"\n" +
+	"\x04post\x18\x02 \x01(\v2\x0e.posts.v1.PostR\x04post\"q\n" +
+	"\x10PostDeletedEvent\x129\n" +
+	"\n" +
+	"deleted_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\"\n" +
+	"\x04post\x18\x02 \x01(\v2\x0e.posts.v1.PostR\x04postBCZAgithub.com/tech-inspire/api-contracts/api/gen/go/posts/v1;postsv1b\x06proto3"
+
+var (
+	file_posts_v1_events_proto_rawDescOnce sync.Once
+	file_posts_v1_events_proto_rawDescData []byte
+)
+
+func file_posts_v1_events_proto_rawDescGZIP() []byte {
+	file_posts_v1_events_proto_rawDescOnce.Do(func() {
+		file_posts_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_posts_v1_events_proto_rawDesc), len(file_posts_v1_events_proto_rawDesc)))
+	})
+	return file_posts_v1_events_proto_rawDescData
+}
+
+var file_posts_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_posts_v1_events_proto_goTypes = []any{
+	(*PostCreatedEvent)(nil),      // 0: posts.v1.PostCreatedEvent
+	(*PostUpdatedEvent)(nil),      // 1: posts.v1.PostUpdatedEvent
+	(*PostDeletedEvent)(nil),      // 2: posts.v1.PostDeletedEvent
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*Post)(nil),                  // 4: posts.v1.Post
+}
+var file_posts_v1_events_proto_depIdxs = []int32{
+	3, // 0: posts.v1.PostCreatedEvent.created_at:type_name -> google.protobuf.Timestamp
+	4, // 1: posts.v1.PostCreatedEvent.post:type_name -> posts.v1.Post
+	3, // 2: posts.v1.PostUpdatedEvent.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 3: posts.v1.PostUpdatedEvent.post:type_name -> posts.v1.Post
+	3, // 4: posts.v1.PostDeletedEvent.deleted_at:type_name -> google.protobuf.Timestamp
+	4, // 5: posts.v1.PostDeletedEvent.post:type_name -> posts.v1.Post
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_posts_v1_events_proto_init() }
+func file_posts_v1_events_proto_init() {
+	if File_posts_v1_events_proto != nil {
+		return
+	}
+	file_posts_v1_posts_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_posts_v1_events_proto_rawDesc), len(file_posts_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_posts_v1_events_proto_goTypes,
+		DependencyIndexes: file_posts_v1_events_proto_depIdxs,
+		MessageInfos:      file_posts_v1_events_proto_msgTypes,
+	}.Build()
+	File_posts_v1_events_proto = out.File
+	file_posts_v1_events_proto_goTypes = nil
+	file_posts_v1_events_proto_depIdxs = nil
+}
@@ -0,0 +1,67 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: posts/v1/internal.proto
+
+package postsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_posts_v1_internal_proto protoreflect.FileDescriptor
+
+const file_posts_v1_internal_proto_rawDesc = "" +
+	"\n" +
+	"\x17posts/v1/internal.proto\x12\bposts.v1\x1a\x14posts/v1/posts.proto2b\n" +
+	"\x14PostsInternalService\x12J\n" +
+	"\vGetPostByID\x12\x1c.posts.v1.GetPostByIDRequest\x1a\x1d.posts.v1.GetPostByIDResponseBCZAgithub.com/tech-inspire/api-contracts/api/gen/go/posts/v1;postsv1b\x06proto3"
+
+var file_posts_v1_internal_proto_goTypes = []any{
+	(*GetPostByIDRequest)(nil),  // 0: posts.v1.GetPostByIDRequest
+	(*GetPostByIDResponse)(nil), // 1: posts.v1.GetPostByIDResponse
+}
+var file_posts_v1_internal_proto_depIdxs = []int32{
+	0, // 0: posts.v1.PostsInternalService.GetPostByID:input_type -> posts.v1.GetPostByIDRequest
+	1, // 1: posts.v1.PostsInternalService.GetPostByID:output_type -> posts.v1.GetPostByIDResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_posts_v1_internal_proto_init() }
+func file_posts_v1_internal_proto_init() {
+	if File_posts_v1_internal_proto != nil {
+		return
+	}
+	file_posts_v1_posts_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_posts_v1_internal_proto_rawDesc), len(file_posts_v1_internal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_posts_v1_internal_proto_goTypes,
+		DependencyIndexes: file_posts_v1_internal_proto_depIdxs,
+	}.Build()
+	File_posts_v1_internal_proto = out.File
+	file_posts_v1_internal_proto_goTypes = nil
+	file_posts_v1_internal_proto_depIdxs = nil
+}
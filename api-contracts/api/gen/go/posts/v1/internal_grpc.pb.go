@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: posts/v1/internal.proto
+
+package postsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PostsInternalService_GetPostByID_FullMethodName = "/posts.v1.PostsInternalService/GetPostByID"
+)
+
+// PostsInternalServiceClient is the client API for PostsInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PostsInternalService is called by the other services of the backend with the service tokens
+// issued by auth-service. It is served under its own path, so that it can be kept off the public gateway.
+type PostsInternalServiceClient interface {
+	// GetPostByID retrieves a single post by its UUID, requires the "posts:read" scope.
+	GetPostByID(ctx context.Context, in *GetPostByIDRequest, opts ...grpc.CallOption) (*GetPostByIDResponse, error)
+}
+
+type postsInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPostsInternalServiceClient(cc grpc.ClientConnInterface) PostsInternalServiceClient {
+	return &postsInternalServiceClient{cc}
+}
+
+func (c *postsInternalServiceClient) GetPostByID(ctx context.Context, in *GetPostByIDRequest, opts ...grpc.CallOption) (*GetPostByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostByIDResponse)
+	err := c.cc.Invoke(ctx, PostsInternalService_GetPostByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PostsInternalServiceServer is the server API for PostsInternalService service.
+// All implementations must embed UnimplementedPostsInternalServiceServer
+// for forward compatibility.
+//
+// PostsInternalService is called by the other services of the backend with the service tokens
+// issued by auth-service. It is served under its own path, so that it can be kept off the public gateway.
+type PostsInternalServiceServer interface {
+	// GetPostByID retrieves a single post by its UUID, requires the "posts:read" scope.
+	GetPostByID(context.Context, *GetPostByIDRequest) (*GetPostByIDResponse, error)
+	mustEmbedUnimplementedPostsInternalServiceServer()
+}
+
+// UnimplementedPostsInternalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPostsInternalServiceServer struct{}
+
+func (UnimplementedPostsInternalServiceServer) GetPostByID(context.Context, *GetPostByIDRequest) (*GetPostByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostByID not implemented")
+}
+func (UnimplementedPostsInternalServiceServer) mustEmbedUnimplementedPostsInternalServiceServer() {}
+func (UnimplementedPostsInternalServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafePostsInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PostsInternalServiceServer will
+// result in compilation errors.
+type UnsafePostsInternalServiceServer interface {
+	mustEmbedUnimplementedPostsInternalServiceServer()
+}
+
+func RegisterPostsInternalServiceServer(s grpc.ServiceRegistrar, srv PostsInternalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPostsInternalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PostsInternalService_ServiceDesc, srv)
+}
+
+func _PostsInternalService_GetPostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsInternalServiceServer).GetPostByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsInternalService_GetPostByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsInternalServiceServer).GetPostByID(ctx, req.(*GetPostByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PostsInternalService_ServiceDesc is the grpc.ServiceDesc for PostsInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PostsInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "posts.v1.PostsInternalService",
+	HandlerType: (*PostsInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPostByID",
+			Handler:    _PostsInternalService_GetPostByID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "posts/v1/internal.proto",
+}
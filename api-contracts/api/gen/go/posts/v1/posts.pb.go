@@ -0,0 +1,953 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: posts/v1/posts.proto
+
+package postsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Defines the types of image variants.
+type VariantType int32
+
+const (
+	VariantType_VARIANT_TYPE_UNSPECIFIED VariantType = 0
+	VariantType_ORIGINAL                 VariantType = 1 // Original full-size image
+	VariantType_THUMBNAIL                VariantType = 2 // Thumbnail or preview image
+)
+
+// Enum value maps for VariantType.
+var (
+	VariantType_name = map[int32]string{
+		0: "VARIANT_TYPE_UNSPECIFIED",
+		1: "ORIGINAL",
+		2: "THUMBNAIL",
+	}
+	VariantType_value = map[string]int32{
+		"VARIANT_TYPE_UNSPECIFIED": 0,
+		"ORIGINAL":                 1,
+		"THUMBNAIL":                2,
+	}
+)
+
+func (x VariantType) Enum() *VariantType {
+	p := new(VariantType)
+	*p = x
+	return p
+}
+
+func (x VariantType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariantType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_v1_posts_proto_enumTypes[0].Descriptor()
+}
+
+func (VariantType) Type() protoreflect.EnumType {
+	return &file_posts_v1_posts_proto_enumTypes[0]
+}
+
+func (x VariantType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VariantType.Descriptor instead.
+func (VariantType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{0}
+}
+
+// ImageVariant describes a derived version of a post image, such as an original
+// or thumbnail variant.
+type ImageVariant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// S3 URL of the image variant.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Width of the image in pixels.
+	Width int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	// Height of the image in pixels.
+	Height int32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Size of the image variant in bytes.
+	Size int32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Type of the variant (e.g., ORIG, THUMB). Must be one of the defined variants.
+	VariantType   VariantType `protobuf:"varint,5,opt,name=variant_type,json=variantType,proto3,enum=posts.v1.VariantType" json:"variant_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
+	mi := &file_posts_v1_posts_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariant) ProtoMessage() {}
+
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImageVariant) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetVariantType() VariantType {
+	if x != nil {
+		return x.VariantType
+	}
+	return VariantType_VARIANT_TYPE_UNSPECIFIED
+}
+
+// Post represents a user-created post with one or more image variants and optional
+// SoundCloud metadata.
+type Post struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUIDv7 of the post.
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UUID of the post's author.
+	AuthorId string `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// List of image variants in order, such as original followed by thumbnails.
+	Images []*ImageVariant `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	// Optional SoundCloud track identifier.
+	SoundcloudSong *string `protobuf:"bytes,4,opt,name=soundcloud_song,json=soundcloudSong,proto3,oneof" json:"soundcloud_song,omitempty"`
+	// Optional start time for the SoundCloud track in seconds.
+	SoundcloudSongStart *int32 `protobuf:"varint,5,opt,name=soundcloud_song_start,json=soundcloudSongStart,proto3,oneof" json:"soundcloud_song_start,omitempty"`
+	// Text description provided by the author.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Timestamp when the post was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_posts_v1_posts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Post) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Post) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Post) GetImages() []*ImageVariant {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Post) GetSoundcloudSong() string {
+	if x != nil && x.SoundcloudSong != nil {
+		return *x.SoundcloudSong
+	}
+	return ""
+}
+
+func (x *Post) GetSoundcloudSongStart() int32 {
+	if x != nil && x.SoundcloudSongStart != nil {
+		return *x.SoundcloudSongStart
+	}
+	return 0
+}
+
+func (x *Post) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AddPostRequest contains the data required to create a new post, including the
+// upload session key and metadata for the original image.
+type AddPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key returned by GetUploadUrl, used to validate the upload.
+	UploadSessionKey string `protobuf:"bytes,2,opt,name=upload_session_key,json=uploadSessionKey,proto3" json:"upload_session_key,omitempty"`
+	// Width of the original image in pixels.
+	ImageWidth int32 `protobuf:"varint,4,opt,name=image_width,json=imageWidth,proto3" json:"image_width,omitempty"`
+	// Height of the original image in pixels.
+	ImageHeight int32 `protobuf:"varint,5,opt,name=image_height,json=imageHeight,proto3" json:"image_height,omitempty"`
+	// Size of the original image in bytes.
+	ImageSize int32 `protobuf:"varint,6,opt,name=image_size,json=imageSize,proto3" json:"image_size,omitempty"`
+	// Optional SoundCloud track identifier.
+	SoundcloudSong *string `protobuf:"bytes,7,opt,name=soundcloud_song,json=soundcloudSong,proto3,oneof" json:"soundcloud_song,omitempty"`
+	// Optional start time for the SoundCloud track in seconds.
+	SoundcloudSongStart *int32 `protobuf:"varint,8,opt,name=soundcloud_song_start,json=soundcloudSongStart,proto3,oneof" json:"soundcloud_song_start,omitempty"`
+	// Text description for the post.
+	Description   string `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPostRequest) Reset() {
+	*x = AddPostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPostRequest) ProtoMessage() {}
+
+func (x *AddPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPostRequest.ProtoReflect.Descriptor instead.
+func (*AddPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddPostRequest) GetUploadSessionKey() string {
+	if x != nil {
+		return x.UploadSessionKey
+	}
+	return ""
+}
+
+func (x *AddPostRequest) GetImageWidth() int32 {
+	if x != nil {
+		return x.ImageWidth
+	}
+	return 0
+}
+
+func (x *AddPostRequest) GetImageHeight() int32 {
+	if x != nil {
+		return x.ImageHeight
+	}
+	return 0
+}
+
+func (x *AddPostRequest) GetImageSize() int32 {
+	if x != nil {
+		return x.ImageSize
+	}
+	return 0
+}
+
+func (x *AddPostRequest) GetSoundcloudSong() string {
+	if x != nil && x.SoundcloudSong != nil {
+		return *x.SoundcloudSong
+	}
+	return ""
+}
+
+func (x *AddPostRequest) GetSoundcloudSongStart() int32 {
+	if x != nil && x.SoundcloudSongStart != nil {
+		return *x.SoundcloudSongStart
+	}
+	return 0
+}
+
+func (x *AddPostRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// AddPostResponse returns the newly created post object.
+type AddPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newly created post object.
+	Post          *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPostResponse) Reset() {
+	*x = AddPostResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPostResponse) ProtoMessage() {}
+
+func (x *AddPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPostResponse.ProtoReflect.Descriptor instead.
+func (*AddPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// GetPostByIDRequest requests a post by its UUID.
+type GetPostByIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the post to retrieve.
+	PostId        string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostByIDRequest) Reset() {
+	*x = GetPostByIDRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostByIDRequest) ProtoMessage() {}
+
+func (x *GetPostByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetPostByIDRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPostByIDRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// GetPostByIDResponse returns a single post object.
+type GetPostByIDResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Requested post object.
+	Post          *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostByIDResponse) Reset() {
+	*x = GetPostByIDResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostByIDResponse) ProtoMessage() {}
+
+func (x *GetPostByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetPostByIDResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPostByIDResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// GetPostsRequest requests multiple posts by a list of UUIDs.
+type GetPostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of UUIDs for the posts to retrieve.
+	PostIds       []string `protobuf:"bytes,1,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsRequest) Reset() {
+	*x = GetPostsRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsRequest) ProtoMessage() {}
+
+func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPostsRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+// GetPostsResponse returns a list of retrieved post objects.
+type GetPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of retrieved post objects.
+	Posts         []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsResponse) Reset() {
+	*x = GetPostsResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsResponse) ProtoMessage() {}
+
+func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// DeletePostRequest requests removal of a post by its UUID.
+type DeletePostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the post to delete.
+	PostId        string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeletePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// DeletePostResponse is returned when a post has been deleted successfully.
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{9}
+}
+
+// GetUploadUrlRequest requests a presigned S3 URL for image upload.
+type GetUploadUrlRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expected MIME type of the uploaded file, e.g., "image/jpeg".
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// Expected size of the uploaded file in bytes.
+	FileSize      int32 `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadUrlRequest) Reset() {
+	*x = GetUploadUrlRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadUrlRequest) ProtoMessage() {}
+
+func (x *GetUploadUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadUrlRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUploadUrlRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *GetUploadUrlRequest) GetFileSize() int32 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+// GetUploadUrlResponse returns a presigned URL and session key for S3 uploads.
+type GetUploadUrlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Presigned PUT URL for direct S3 upload.
+	UploadUrl string `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	// Session key clients must provide when calling AddPost.
+	UploadSessionKey string            `protobuf:"bytes,2,opt,name=upload_session_key,json=uploadSessionKey,proto3" json:"upload_session_key,omitempty"`
+	Method           string            `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Headers          map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetUploadUrlResponse) Reset() {
+	*x = GetUploadUrlResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadUrlResponse) ProtoMessage() {}
+
+func (x *GetUploadUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadUrlResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadUrlResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUploadUrlResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *GetUploadUrlResponse) GetUploadSessionKey() string {
+	if x != nil {
+		return x.UploadSessionKey
+	}
+	return ""
+}
+
+func (x *GetUploadUrlResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GetUploadUrlResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+var File_posts_v1_posts_proto protoreflect.FileDescriptor
+
+const file_posts_v1_posts_proto_rawDesc = "" +
+	"\n" +
+	"\x14posts/v1/posts.proto\x12\bposts.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x01\n" +
+	"\fImageVariant\x12\x1a\n" +
+	"\x03url\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\x03url\x12\x1d\n" +
+	"\x05width\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x05width\x12\x1f\n" +
+	"\x06height\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x06height\x12\x1b\n" +
+	"\x04size\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x04size\x12D\n" +
+	"\fvariant_type\x18\x05 \x01(\x0e2\x15.posts.v1.VariantTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x18\x01\x18\x02R\vvariantType\"\x97\x03\n" +
+	"\x04Post\x12!\n" +
+	"\apost_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06postId\x12%\n" +
+	"\tauthor_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bauthorId\x128\n" +
+	"\x06images\x18\x03 \x03(\v2\x16.posts.v1.ImageVariantB\b\xbaH\x05\x92\x01\x02\b\x01R\x06images\x125\n" +
+	"\x0fsoundcloud_song\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x0esoundcloudSong\x88\x01\x01\x12@\n" +
+	"\x15soundcloud_song_start\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\x13soundcloudSongStart\x88\x01\x01\x12)\n" +
+	"\vdescription\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10_soundcloud_songB\x18\n" +
+	"\x16_soundcloud_song_start\"\x97\x03\n" +
+	"\x0eAddPostRequest\x125\n" +
+	"\x12upload_session_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x10uploadSessionKey\x12(\n" +
+	"\vimage_width\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\n" +
+	"imageWidth\x12*\n" +
+	"\fimage_height\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\vimageHeight\x12&\n" +
+	"\n" +
+	"image_size\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\timageSize\x125\n" +
+	"\x0fsoundcloud_song\x18\a \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x0esoundcloudSong\x88\x01\x01\x12@\n" +
+	"\x15soundcloud_song_start\x18\b \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\x13soundcloudSongStart\x88\x01\x01\x12)\n" +
+	"\vdescription\x18\t \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vdescriptionB\x12\n" +
+	"\x10_soundcloud_songB\x18\n" +
+	"\x16_soundcloud_song_start\"5\n" +
+	"\x0fAddPostResponse\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.posts.v1.PostR\x04post\"7\n" +
+	"\x12GetPostByIDRequest\x12!\n" +
+	"\apost_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06postId\"9\n" +
+	"\x13GetPostByIDResponse\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.posts.v1.PostR\x04post\"6\n" +
+	"\x0fGetPostsRequest\x12#\n" +
+	"\bpost_ids\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\apostIds\"8\n" +
+	"\x10GetPostsResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\"6\n" +
+	"\x11DeletePostRequest\x12!\n" +
+	"\apost_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06postId\"\x14\n" +
+	"\x12DeletePostResponse\"o\n" +
+	"\x13GetUploadUrlRequest\x122\n" +
+	"\tmime_type\x18\x02 \x01(\tB\x15\xbaH\x12r\x102\x0e^\\w+/[-+.\\w]+$R\bmimeType\x12$\n" +
+	"\tfile_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bfileSize\"\x91\x02\n" +
+	"\x14GetUploadUrlResponse\x12'\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\tuploadUrl\x125\n" +
+	"\x12upload_session_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x10uploadSessionKey\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12E\n" +
+	"\aheaders\x18\x04 \x03(\v2+.posts.v1.GetUploadUrlResponse.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*H\n" +
+	"\vVariantType\x12\x1c\n" +
+	"\x18VARIANT_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bORIGINAL\x10\x01\x12\r\n" +
+	"\tTHUMBNAIL\x10\x022\xf5\x02\n" +
+	"\fPostsService\x12>\n" +
+	"\aAddPost\x12\x18.posts.v1.AddPostRequest\x1a\x19.posts.v1.AddPostResponse\x12J\n" +
+	"\vGetPostByID\x12\x1c.posts.v1.GetPostByIDRequest\x1a\x1d.posts.v1.GetPostByIDResponse\x12A\n" +
+	"\bGetPosts\x12\x19.posts.v1.GetPostsRequest\x1a\x1a.posts.v1.GetPostsResponse\x12G\n" +
+	"\n" +
+	"DeletePost\x12\x1b.posts.v1.DeletePostRequest\x1a\x1c.posts.v1.DeletePostResponse\x12M\n" +
+	"\fGetUploadUrl\x12\x1d.posts.v1.GetUploadUrlRequest\x1a\x1e.posts.v1.GetUploadUrlResponseBCZAgithub.com/tech-inspire/api-contracts/api/gen/go/posts/v1;postsv1b\x06proto3"
+
+var (
+	file_posts_v1_posts_proto_rawDescOnce sync.Once
+	file_posts_v1_posts_proto_rawDescData []byte
+)
+
+func file_posts_v1_posts_proto_rawDescGZIP() []byte {
+	file_posts_v1_posts_proto_rawDescOnce.Do(func() {
+		file_posts_v1_posts_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_posts_v1_posts_proto_rawDesc), len(file_posts_v1_posts_proto_rawDesc)))
+	})
+	return file_posts_v1_posts_proto_rawDescData
+}
+
+var file_posts_v1_posts_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_posts_v1_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_posts_v1_posts_proto_goTypes = []any{
+	(VariantType)(0),              // 0: posts.v1.VariantType
+	(*ImageVariant)(nil),          // 1: posts.v1.ImageVariant
+	(*Post)(nil),                  // 2: posts.v1.Post
+	(*AddPostRequest)(nil),        // 3: posts.v1.AddPostRequest
+	(*AddPostResponse)(nil),       // 4: posts.v1.AddPostResponse
+	(*GetPostByIDRequest)(nil),    // 5: posts.v1.GetPostByIDRequest
+	(*GetPostByIDResponse)(nil),   // 6: posts.v1.GetPostByIDResponse
+	(*GetPostsRequest)(nil),       // 7: posts.v1.GetPostsRequest
+	(*GetPostsResponse)(nil),      // 8: posts.v1.GetPostsResponse
+	(*DeletePostRequest)(nil),     // 9: posts.v1.DeletePostRequest
+	(*DeletePostResponse)(nil),    // 10: posts.v1.DeletePostResponse
+	(*GetUploadUrlRequest)(nil),   // 11: posts.v1.GetUploadUrlRequest
+	(*GetUploadUrlResponse)(nil),  // 12: posts.v1.GetUploadUrlResponse
+	nil,                           // 13: posts.v1.GetUploadUrlResponse.HeadersEntry
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+}
+var file_posts_v1_posts_proto_depIdxs = []int32{
+	0,  // 0: posts.v1.ImageVariant.variant_type:type_name -> posts.v1.VariantType
+	1,  // 1: posts.v1.Post.images:type_name -> posts.v1.ImageVariant
+	14, // 2: posts.v1.Post.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: posts.v1.AddPostResponse.post:type_name -> posts.v1.Post
+	2,  // 4: posts.v1.GetPostByIDResponse.post:type_name -> posts.v1.Post
+	2,  // 5: posts.v1.GetPostsResponse.posts:type_name -> posts.v1.Post
+	13, // 6: posts.v1.GetUploadUrlResponse.headers:type_name -> posts.v1.GetUploadUrlResponse.HeadersEntry
+	3,  // 7: posts.v1.PostsService.AddPost:input_type -> posts.v1.AddPostRequest
+	5,  // 8: posts.v1.PostsService.GetPostByID:input_type -> posts.v1.GetPostByIDRequest
+	7,  // 9: posts.v1.PostsService.GetPosts:input_type -> posts.v1.GetPostsRequest
+	9,  // 10: posts.v1.PostsService.DeletePost:input_type -> posts.v1.DeletePostRequest
+	11, // 11: posts.v1.PostsService.GetUploadUrl:input_type -> posts.v1.GetUploadUrlRequest
+	4,  // 12: posts.v1.PostsService.AddPost:output_type -> posts.v1.AddPostResponse
+	6,  // 13: posts.v1.PostsService.GetPostByID:output_type -> posts.v1.GetPostByIDResponse
+	8,  // 14: posts.v1.PostsService.GetPosts:output_type -> posts.v1.GetPostsResponse
+	10, // 15: posts.v1.PostsService.DeletePost:output_type -> posts.v1.DeletePostResponse
+	12, // 16: posts.v1.PostsService.GetUploadUrl:output_type -> posts.v1.GetUploadUrlResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_posts_v1_posts_proto_init() }
+func file_posts_v1_posts_proto_init() {
+	if File_posts_v1_posts_proto != nil {
+		return
+	}
+	file_posts_v1_posts_proto_msgTypes[1].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_posts_v1_posts_proto_rawDesc), len(file_posts_v1_posts_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_posts_v1_posts_proto_goTypes,
+		DependencyIndexes: file_posts_v1_posts_proto_depIdxs,
+		EnumInfos:         file_posts_v1_posts_proto_enumTypes,
+		MessageInfos:      file_posts_v1_posts_proto_msgTypes,
+	}.Build()
+	File_posts_v1_posts_proto = out.File
+	file_posts_v1_posts_proto_goTypes = nil
+	file_posts_v1_posts_proto_depIdxs = nil
+}
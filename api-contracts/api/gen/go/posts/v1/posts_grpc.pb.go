@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: posts/v1/posts.proto
+
+package postsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PostsService_AddPost_FullMethodName      = "/posts.v1.PostsService/AddPost"
+	PostsService_GetPostByID_FullMethodName  = "/posts.v1.PostsService/GetPostByID"
+	PostsService_GetPosts_FullMethodName     = "/posts.v1.PostsService/GetPosts"
+	PostsService_DeletePost_FullMethodName   = "/posts.v1.PostsService/DeletePost"
+	PostsService_GetUploadUrl_FullMethodName = "/posts.v1.PostsService/GetUploadUrl"
+)
+
+// PostsServiceClient is the client API for PostsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PostsService provides operations to create, retrieve, and delete posts, as well
+// as to obtain upload URLs for post images.
+type PostsServiceClient interface {
+	// AddPost creates a new post record. The client must have already uploaded the
+	// image to S3 and provide the upload session key.
+	AddPost(ctx context.Context, in *AddPostRequest, opts ...grpc.CallOption) (*AddPostResponse, error)
+	// GetPostByID retrieves a single post by its UUID.
+	GetPostByID(ctx context.Context, in *GetPostByIDRequest, opts ...grpc.CallOption) (*GetPostByIDResponse, error)
+	// GetPosts retrieves multiple posts by their UUIDs in a single batch request.
+	GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	// DeletePost removes an existing post by its UUID.
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	// GetUploadUrl returns a presigned S3 URL and a session key for uploading a post image.
+	GetUploadUrl(ctx context.Context, in *GetUploadUrlRequest, opts ...grpc.CallOption) (*GetUploadUrlResponse, error)
+}
+
+type postsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPostsServiceClient(cc grpc.ClientConnInterface) PostsServiceClient {
+	return &postsServiceClient{cc}
+}
+
+func (c *postsServiceClient) AddPost(ctx context.Context, in *AddPostRequest, opts ...grpc.CallOption) (*AddPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPostResponse)
+	err := c.cc.Invoke(ctx, PostsService_AddPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetPostByID(ctx context.Context, in *GetPostByIDRequest, opts ...grpc.CallOption) (*GetPostByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostByIDResponse)
+	err := c.cc.Invoke(ctx, PostsService_GetPostByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsResponse)
+	err := c.cc.Invoke(ctx, PostsService_GetPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, PostsService_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetUploadUrl(ctx context.Context, in *GetUploadUrlRequest, opts ...grpc.CallOption) (*GetUploadUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUploadUrlResponse)
+	err := c.cc.Invoke(ctx, PostsService_GetUploadUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PostsServiceServer is the server API for PostsService service.
+// All implementations must embed UnimplementedPostsServiceServer
+// for forward compatibility.
+//
+// PostsService provides operations to create, retrieve, and delete posts, as well
+// as to obtain upload URLs for post images.
+type PostsServiceServer interface {
+	// AddPost creates a new post record. The client must have already uploaded the
+	// image to S3 and provide the upload session key.
+	AddPost(context.Context, *AddPostRequest) (*AddPostResponse, error)
+	// GetPostByID retrieves a single post by its UUID.
+	GetPostByID(context.Context, *GetPostByIDRequest) (*GetPostByIDResponse, error)
+	// GetPosts retrieves multiple posts by their UUIDs in a single batch request.
+	GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error)
+	// DeletePost removes an existing post by its UUID.
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// GetUploadUrl returns a presigned S3 URL and a session key for uploading a post image.
+	GetUploadUrl(context.Context, *GetUploadUrlRequest) (*GetUploadUrlResponse, error)
+	mustEmbedUnimplementedPostsServiceServer()
+}
+
+// UnimplementedPostsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPostsServiceServer struct{}
+
+func (UnimplementedPostsServiceServer) AddPost(context.Context, *AddPostRequest) (*AddPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPost not implemented")
+}
+func (UnimplementedPostsServiceServer) GetPostByID(context.Context, *GetPostByIDRequest) (*GetPostByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostByID not implemented")
+}
+func (UnimplementedPostsServiceServer) GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPosts not implemented")
+}
+func (UnimplementedPostsServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedPostsServiceServer) GetUploadUrl(context.Context, *GetUploadUrlRequest) (*GetUploadUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadUrl not implemented")
+}
+func (UnimplementedPostsServiceServer) mustEmbedUnimplementedPostsServiceServer() {}
+func (UnimplementedPostsServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafePostsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PostsServiceServer will
+// result in compilation errors.
+type UnsafePostsServiceServer interface {
+	mustEmbedUnimplementedPostsServiceServer()
+}
+
+func RegisterPostsServiceServer(s grpc.ServiceRegistrar, srv PostsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPostsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PostsService_ServiceDesc, srv)
+}
+
+func _PostsService_AddPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).AddPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_AddPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).AddPost(ctx, req.(*AddPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetPostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetPostByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetPostByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetPostByID(ctx, req.(*GetPostByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetPosts(ctx, req.(*GetPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetUploadUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetUploadUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetUploadUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetUploadUrl(ctx, req.(*GetUploadUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PostsService_ServiceDesc is the grpc.ServiceDesc for PostsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PostsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "posts.v1.PostsService",
+	HandlerType: (*PostsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddPost",
+			Handler:    _PostsService_AddPost_Handler,
+		},
+		{
+			MethodName: "GetPostByID",
+			Handler:    _PostsService_GetPostByID_Handler,
+		},
+		{
+			MethodName: "GetPosts",
+			Handler:    _PostsService_GetPosts_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _PostsService_DeletePost_Handler,
+		},
+		{
+			MethodName: "GetUploadUrl",
+			Handler:    _PostsService_GetUploadUrl_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "posts/v1/posts.proto",
+}
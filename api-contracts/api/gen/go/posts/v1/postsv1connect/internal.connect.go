@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: posts/v1/internal.proto
+
+package postsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/posts/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PostsInternalServiceName is the fully-qualified name of the PostsInternalService service.
+	PostsInternalServiceName = "posts.v1.PostsInternalService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PostsInternalServiceGetPostByIDProcedure is the fully-qualified name of the
+	// PostsInternalService's GetPostByID RPC.
+	PostsInternalServiceGetPostByIDProcedure = "/posts.v1.PostsInternalService/GetPostByID"
+)
+
+// PostsInternalServiceClient is a client for the posts.v1.PostsInternalService service.
+type PostsInternalServiceClient interface {
+	// GetPostByID retrieves a single post by its UUID, requires the "posts:read" scope.
+	GetPostByID(context.Context, *connect.Request[v1.GetPostByIDRequest]) (*connect.Response[v1.GetPostByIDResponse], error)
+}
+
+// NewPostsInternalServiceClient constructs a client for the posts.v1.PostsInternalService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPostsInternalServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PostsInternalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	postsInternalServiceMethods := v1.File_posts_v1_internal_proto.Services().ByName("PostsInternalService").Methods()
+	return &postsInternalServiceClient{
+		getPostByID: connect.NewClient[v1.GetPostByIDRequest, v1.GetPostByIDResponse](
+			httpClient,
+			baseURL+PostsInternalServiceGetPostByIDProcedure,
+			connect.WithSchema(postsInternalServiceMethods.ByName("GetPostByID")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// postsInternalServiceClient implements PostsInternalServiceClient.
+type postsInternalServiceClient struct {
+	getPostByID *connect.Client[v1.GetPostByIDRequest, v1.GetPostByIDResponse]
+}
+
+// GetPostByID calls posts.v1.PostsInternalService.GetPostByID.
+func (c *postsInternalServiceClient) GetPostByID(ctx context.Context, req *connect.Request[v1.GetPostByIDRequest]) (*connect.Response[v1.GetPostByIDResponse], error) {
+	return c.getPostByID.CallUnary(ctx, req)
+}
+
+// PostsInternalServiceHandler is an implementation of the posts.v1.PostsInternalService service.
+type PostsInternalServiceHandler interface {
+	// GetPostByID retrieves a single post by its UUID, requires the "posts:read" scope.
+	GetPostByID(context.Context, *connect.Request[v1.GetPostByIDRequest]) (*connect.Response[v1.GetPostByIDResponse], error)
+}
+
+// NewPostsInternalServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPostsInternalServiceHandler(svc PostsInternalServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	postsInternalServiceMethods := v1.File_posts_v1_internal_proto.Services().ByName("PostsInternalService").Methods()
+	postsInternalServiceGetPostByIDHandler := connect.NewUnaryHandler(
+		PostsInternalServiceGetPostByIDProcedure,
+		svc.GetPostByID,
+		connect.WithSchema(postsInternalServiceMethods.ByName("GetPostByID")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/posts.v1.PostsInternalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PostsInternalServiceGetPostByIDProcedure:
+			postsInternalServiceGetPostByIDHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPostsInternalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPostsInternalServiceHandler struct{}
+
+func (UnimplementedPostsInternalServiceHandler) GetPostByID(context.Context, *connect.Request[v1.GetPostByIDRequest]) (*connect.Response[v1.GetPostByIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostsInternalService.GetPostByID is not implemented"))
+}
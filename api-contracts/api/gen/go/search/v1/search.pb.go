@@ -0,0 +1,542 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: search/v1/search.proto
+
+package searchv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PhotoOrientation int32
+
+const (
+	PhotoOrientation_ORIENTATION_UNSPECIFIED PhotoOrientation = 0
+	PhotoOrientation_PORTRAIT                PhotoOrientation = 1 // height > width
+	PhotoOrientation_LANDSCAPE               PhotoOrientation = 2 // width > height
+	PhotoOrientation_SQUARE                  PhotoOrientation = 3 // width == height (within tolerance)
+)
+
+// Enum value maps for PhotoOrientation.
+var (
+	PhotoOrientation_name = map[int32]string{
+		0: "ORIENTATION_UNSPECIFIED",
+		1: "PORTRAIT",
+		2: "LANDSCAPE",
+		3: "SQUARE",
+	}
+	PhotoOrientation_value = map[string]int32{
+		"ORIENTATION_UNSPECIFIED": 0,
+		"PORTRAIT":                1,
+		"LANDSCAPE":               2,
+		"SQUARE":                  3,
+	}
+)
+
+func (x PhotoOrientation) Enum() *PhotoOrientation {
+	p := new(PhotoOrientation)
+	*p = x
+	return p
+}
+
+func (x PhotoOrientation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PhotoOrientation) Descriptor() protoreflect.EnumDescriptor {
+	return file_search_v1_search_proto_enumTypes[0].Descriptor()
+}
+
+func (PhotoOrientation) Type() protoreflect.EnumType {
+	return &file_search_v1_search_proto_enumTypes[0]
+}
+
+func (x PhotoOrientation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PhotoOrientation.Descriptor instead.
+func (PhotoOrientation) EnumDescriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+// --- Sorting Enums ---
+type SortField int32
+
+const (
+	SortField_SORT_FIELD_UNSPECIFIED SortField = 0
+	SortField_CREATED_AT             SortField = 1
+)
+
+// Enum value maps for SortField.
+var (
+	SortField_name = map[int32]string{
+		0: "SORT_FIELD_UNSPECIFIED",
+		1: "CREATED_AT",
+	}
+	SortField_value = map[string]int32{
+		"SORT_FIELD_UNSPECIFIED": 0,
+		"CREATED_AT":             1,
+	}
+)
+
+func (x SortField) Enum() *SortField {
+	p := new(SortField)
+	*p = x
+	return p
+}
+
+func (x SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_search_v1_search_proto_enumTypes[1].Descriptor()
+}
+
+func (SortField) Type() protoreflect.EnumType {
+	return &file_search_v1_search_proto_enumTypes[1]
+}
+
+func (x SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortField.Descriptor instead.
+func (SortField) EnumDescriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	SortOrder_ASC                    SortOrder = 1
+	SortOrder_DESC                   SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "ASC",
+		2: "DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"ASC":                    1,
+		"DESC":                   2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_search_v1_search_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_search_v1_search_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+type SearchImagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to SearchBy:
+	//
+	//	*SearchImagesRequest_TextQuery
+	//	*SearchImagesRequest_ReferencePostId
+	SearchBy    isSearchImagesRequest_SearchBy `protobuf_oneof:"search_by"`
+	AuthorId    *string                        `protobuf:"bytes,9,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
+	Orientation *PhotoOrientation              `protobuf:"varint,3,opt,name=orientation,proto3,enum=search.v1.PhotoOrientation,oneof" json:"orientation,omitempty"`
+	SortBy      SortField                      `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=search.v1.SortField" json:"sort_by,omitempty"`
+	SortOrder   SortOrder                      `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3,enum=search.v1.SortOrder" json:"sort_order,omitempty"`
+	Limit       uint32                         `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      uint32                         `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Minimum similarity score threshold for embedding search. Default is 0.7 (subject to change)
+	MinSimilarityScore *float32 `protobuf:"fixed32,8,opt,name=min_similarity_score,json=minSimilarityScore,proto3,oneof" json:"min_similarity_score,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchImagesRequest) Reset() {
+	*x = SearchImagesRequest{}
+	mi := &file_search_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchImagesRequest) ProtoMessage() {}
+
+func (x *SearchImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchImagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchImagesRequest) Descriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchImagesRequest) GetSearchBy() isSearchImagesRequest_SearchBy {
+	if x != nil {
+		return x.SearchBy
+	}
+	return nil
+}
+
+func (x *SearchImagesRequest) GetTextQuery() string {
+	if x != nil {
+		if x, ok := x.SearchBy.(*SearchImagesRequest_TextQuery); ok {
+			return x.TextQuery
+		}
+	}
+	return ""
+}
+
+func (x *SearchImagesRequest) GetReferencePostId() string {
+	if x != nil {
+		if x, ok := x.SearchBy.(*SearchImagesRequest_ReferencePostId); ok {
+			return x.ReferencePostId
+		}
+	}
+	return ""
+}
+
+func (x *SearchImagesRequest) GetAuthorId() string {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return ""
+}
+
+func (x *SearchImagesRequest) GetOrientation() PhotoOrientation {
+	if x != nil && x.Orientation != nil {
+		return *x.Orientation
+	}
+	return PhotoOrientation_ORIENTATION_UNSPECIFIED
+}
+
+func (x *SearchImagesRequest) GetSortBy() SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *SearchImagesRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *SearchImagesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchImagesRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchImagesRequest) GetMinSimilarityScore() float32 {
+	if x != nil && x.MinSimilarityScore != nil {
+		return *x.MinSimilarityScore
+	}
+	return 0
+}
+
+type isSearchImagesRequest_SearchBy interface {
+	isSearchImagesRequest_SearchBy()
+}
+
+type SearchImagesRequest_TextQuery struct {
+	TextQuery string `protobuf:"bytes,1,opt,name=text_query,json=textQuery,proto3,oneof"`
+}
+
+type SearchImagesRequest_ReferencePostId struct {
+	ReferencePostId string `protobuf:"bytes,2,opt,name=reference_post_id,json=referencePostId,proto3,oneof"`
+}
+
+func (*SearchImagesRequest_TextQuery) isSearchImagesRequest_SearchBy() {}
+
+func (*SearchImagesRequest_ReferencePostId) isSearchImagesRequest_SearchBy() {}
+
+type SearchImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Matches with score ≥ threshold
+	Limit         uint32                 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32                 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchImagesResponse) Reset() {
+	*x = SearchImagesResponse{}
+	mi := &file_search_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchImagesResponse) ProtoMessage() {}
+
+func (x *SearchImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchImagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchImagesResponse) Descriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchImagesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchImagesResponse) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchImagesResponse) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Similarity    *float32               `protobuf:"fixed32,2,opt,name=similarity,proto3,oneof" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_search_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_search_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResult) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSimilarity() float32 {
+	if x != nil && x.Similarity != nil {
+		return *x.Similarity
+	}
+	return 0
+}
+
+var File_search_v1_search_proto protoreflect.FileDescriptor
+
+const file_search_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x16search/v1/search.proto\x12\tsearch.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x04\n" +
+	"\x13SearchImagesRequest\x12(\n" +
+	"\n" +
+	"text_query\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\ttextQuery\x126\n" +
+	"\x11reference_post_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x0freferencePostId\x12*\n" +
+	"\tauthor_id\x18\t \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\bauthorId\x88\x01\x01\x12B\n" +
+	"\vorientation\x18\x03 \x01(\x0e2\x1b.search.v1.PhotoOrientationH\x02R\vorientation\x88\x01\x01\x12-\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x14.search.v1.SortFieldR\x06sortBy\x123\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x0e2\x14.search.v1.SortOrderR\tsortOrder\x12\x1d\n" +
+	"\x05limit\x18\x06 \x01(\rB\a\xbaH\x04*\x02\x18dR\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\rR\x06offset\x12F\n" +
+	"\x14min_similarity_score\x18\b \x01(\x02B\x0f\xbaH\f\n" +
+	"\n" +
+	"\x1d\x00\x00\x80?-\x00\x00\x00\x00H\x03R\x12minSimilarityScore\x88\x01\x01B\v\n" +
+	"\tsearch_byB\f\n" +
+	"\n" +
+	"_author_idB\x0e\n" +
+	"\f_orientationB\x17\n" +
+	"\x15_min_similarity_score\"w\n" +
+	"\x14SearchImagesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.search.v1.SearchResultR\aresults\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\rR\x06offset\"v\n" +
+	"\fSearchResult\x12!\n" +
+	"\apost_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06postId\x124\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x02B\x0f\xbaH\f\n" +
+	"\n" +
+	"\x1d\x00\x00\x80?-\x00\x00\x00\x00H\x00R\n" +
+	"similarity\x88\x01\x01B\r\n" +
+	"\v_similarity*X\n" +
+	"\x10PhotoOrientation\x12\x1b\n" +
+	"\x17ORIENTATION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bPORTRAIT\x10\x01\x12\r\n" +
+	"\tLANDSCAPE\x10\x02\x12\n" +
+	"\n" +
+	"\x06SQUARE\x10\x03*7\n" +
+	"\tSortField\x12\x1a\n" +
+	"\x16SORT_FIELD_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"CREATED_AT\x10\x01*:\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03ASC\x10\x01\x12\b\n" +
+	"\x04DESC\x10\x022_\n" +
+	"\rSearchService\x12N\n" +
+	"\vSearchPosts\x12\x1e.search.v1.SearchImagesRequest\x1a\x1f.search.v1.SearchImagesResponseBEZCgithub.com/tech-inspire/api-contracts/api/gen/go/search/v1;searchv1b\x06proto3"
+
+var (
+	file_search_v1_search_proto_rawDescOnce sync.Once
+	file_search_v1_search_proto_rawDescData []byte
+)
+
+func file_search_v1_search_proto_rawDescGZIP() []byte {
+	file_search_v1_search_proto_rawDescOnce.Do(func() {
+		file_search_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_search_v1_search_proto_rawDesc), len(file_search_v1_search_proto_rawDesc)))
+	})
+	return file_search_v1_search_proto_rawDescData
+}
+
+var file_search_v1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_search_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_search_v1_search_proto_goTypes = []any{
+	(PhotoOrientation)(0),        // 0: search.v1.PhotoOrientation
+	(SortField)(0),               // 1: search.v1.SortField
+	(SortOrder)(0),               // 2: search.v1.SortOrder
+	(*SearchImagesRequest)(nil),  // 3: search.v1.SearchImagesRequest
+	(*SearchImagesResponse)(nil), // 4: search.v1.SearchImagesResponse
+	(*SearchResult)(nil),         // 5: search.v1.SearchResult
+}
+var file_search_v1_search_proto_depIdxs = []int32{
+	0, // 0: search.v1.SearchImagesRequest.orientation:type_name -> search.v1.PhotoOrientation
+	1, // 1: search.v1.SearchImagesRequest.sort_by:type_name -> search.v1.SortField
+	2, // 2: search.v1.SearchImagesRequest.sort_order:type_name -> search.v1.SortOrder
+	5, // 3: search.v1.SearchImagesResponse.results:type_name -> search.v1.SearchResult
+	3, // 4: search.v1.SearchService.SearchPosts:input_type -> search.v1.SearchImagesRequest
+	4, // 5: search.v1.SearchService.SearchPosts:output_type -> search.v1.SearchImagesResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_search_v1_search_proto_init() }
+func file_search_v1_search_proto_init() {
+	if File_search_v1_search_proto != nil {
+		return
+	}
+	file_search_v1_search_proto_msgTypes[0].OneofWrappers = []any{
+		(*SearchImagesRequest_TextQuery)(nil),
+		(*SearchImagesRequest_ReferencePostId)(nil),
+	}
+	file_search_v1_search_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_v1_search_proto_rawDesc), len(file_search_v1_search_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_search_v1_search_proto_goTypes,
+		DependencyIndexes: file_search_v1_search_proto_depIdxs,
+		EnumInfos:         file_search_v1_search_proto_enumTypes,
+		MessageInfos:      file_search_v1_search_proto_msgTypes,
+	}.Build()
+	File_search_v1_search_proto = out.File
+	file_search_v1_search_proto_goTypes = nil
+	file_search_v1_search_proto_depIdxs = nil
+}
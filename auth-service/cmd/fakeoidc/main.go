@@ -0,0 +1,57 @@
+// Command fakeoidc runs an OpenID Connect provider, which signs in the configured user
+// without asking anything, so that the sign in can be tried locally:
+//
+//	go run ./cmd/fakeoidc -addr :9000 -email user@example.com
+//
+// with the following environment of the service:
+//
+//	OIDC_PROVIDERS=fake
+//	OIDC_FAKE_ISSUER=http://localhost:9000
+//	OIDC_FAKE_CLIENT_ID=inspire
+//	OIDC_FAKE_CLIENT_SECRET=secret
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/tech-inspire/backend/auth-service/internal/clients/oidc/oidctest"
+)
+
+func main() {
+	var (
+		addr         = flag.String("addr", ":9000", "listen address")
+		issuer       = flag.String("issuer", "http://localhost:9000", "issuer url, must point to the listen address")
+		clientID     = flag.String("client-id", "inspire", "client id of the service")
+		clientSecret = flag.String("client-secret", "secret", "client secret of the service")
+
+		subject       = flag.String("subject", "fake-user", "subject of the signed in user")
+		email         = flag.String("email", "user@example.com", "email of the signed in user")
+		emailVerified = flag.Bool("email-verified", true, "whether the email is verified")
+		name          = flag.String("name", "Fake User", "name of the signed in user")
+		username      = flag.String("username", "", "preferred username of the signed in user")
+	)
+	flag.Parse()
+
+	provider, err := oidctest.New(*issuer, *clientID, *clientSecret, oidctest.User{
+		Subject:           *subject,
+		Email:             *email,
+		EmailVerified:     *emailVerified,
+		Name:              *name,
+		PreferredUsername: *username,
+	})
+	if err != nil {
+		log.Fatalf("create provider: %v", err)
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           provider,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Printf("fake oidc provider %s is listening on %s", *issuer, *addr)
+	log.Fatal(server.ListenAndServe())
+}
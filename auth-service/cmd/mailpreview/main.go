@@ -0,0 +1,62 @@
+// Command mailpreview renders every mail template in every locale, so that they can be
+// checked in a browser or a mail client without sending anything:
+//
+//	go run ./cmd/mailpreview -out ./mail-preview
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+)
+
+func main() {
+	var (
+		out           = flag.String("out", "./mail-preview", "output directory")
+		defaultLocale = flag.String("default-locale", "en", "locale used when a template is not translated")
+		from          = flag.String("from", "noreply@example.com", "sender of the .eml files")
+		to            = flag.String("to", "user@example.com", "recipient of the .eml files")
+	)
+	flag.Parse()
+
+	renderer, err := mail.NewRenderer(*defaultLocale)
+	if err != nil {
+		log.Fatalf("create renderer: %v", err)
+	}
+
+	for _, locale := range renderer.Locales() {
+		dir := filepath.Join(*out, locale)
+		if err = os.MkdirAll(dir, 0o755); err != nil {
+			log.Fatalf("create directory: %v", err)
+		}
+
+		for _, template := range mail.PreviewTemplates() {
+			message, err := renderer.Render(locale, template)
+			if err != nil {
+				log.Fatalf("render %s/%s: %v", locale, template.Name, err)
+			}
+
+			eml, err := mail.Format(*from, *to, message)
+			if err != nil {
+				log.Fatalf("format %s/%s: %v", locale, template.Name, err)
+			}
+
+			files := map[string][]byte{
+				".html": []byte(message.HTML),
+				".txt":  []byte(message.Subject + "\n\n" + message.Text + "\n"),
+				".eml":  eml,
+			}
+			for ext, content := range files {
+				if err = os.WriteFile(filepath.Join(dir, template.Name+ext), content, 0o644); err != nil {
+					log.Fatalf("write preview: %v", err)
+				}
+			}
+		}
+	}
+
+	fmt.Printf("previews are written to %s\n", *out)
+}
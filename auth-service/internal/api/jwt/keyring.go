@@ -0,0 +1,297 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/ed25519"
+)
+
+// currentKeyFile contains the name of the key file used for signing.
+const currentKeyFile = "current"
+
+type signingKey struct {
+	kid        string
+	privateKey ed25519.PrivateKey
+}
+
+type retiredKey struct {
+	KID       string            `json:"kid"`
+	PublicKey ed25519.PublicKey `json:"public_key"`
+	// RetiredAt is the time the key was first found missing from the keys directory.
+	RetiredAt time.Time `json:"retired_at"`
+}
+
+type ringKey struct {
+	publicKey ed25519.PublicKey
+
+	// retiredAt is set when the key is removed from the keys directory.
+	retiredAt time.Time
+}
+
+// keyRing holds signing keys and publishes their public parts.
+// Retired keys stay published until every token signed with them has expired.
+type keyRing struct {
+	mu      sync.RWMutex
+	current signingKey
+	keys    map[string]ringKey
+
+	jwks      jwkset.Storage
+	retention time.Duration
+}
+
+func newKeyRing(retention time.Duration) *keyRing {
+	return &keyRing{
+		keys:      make(map[string]ringKey),
+		jwks:      jwkset.NewMemoryStorage(),
+		retention: retention,
+	}
+}
+
+func (r *keyRing) currentKey() signingKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.current
+}
+
+// update replaces the set of loaded keys and the current key. The retired keys are read from the source
+// of the keys, the returned ones include the keys retired now and should be written back.
+func (r *keyRing) update(
+	ctx context.Context, loaded []signingKey, currentKID string, retired []retiredKey, now time.Time,
+) ([]retiredKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make(map[string]ringKey, len(loaded)+len(retired))
+
+	var current *signingKey
+	for _, key := range loaded {
+		keys[key.kid] = ringKey{publicKey: key.privateKey.Public().(ed25519.PublicKey)}
+
+		if key.kid == currentKID {
+			current = &key
+		}
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("current key '%s' is not loaded", currentKID)
+	}
+
+	for _, key := range retired {
+		if existing, ok := keys[key.KID]; ok && (existing.retiredAt.IsZero() || existing.retiredAt.Before(key.RetiredAt)) {
+			continue // the key was put back or is listed twice
+		}
+		keys[key.KID] = ringKey{publicKey: key.PublicKey, retiredAt: key.RetiredAt}
+	}
+
+	// the keys removed since the previous update
+	for kid, key := range r.keys {
+		if _, ok := keys[kid]; ok {
+			continue
+		}
+
+		if key.retiredAt.IsZero() {
+			key.retiredAt = now
+		}
+		keys[kid] = key
+	}
+
+	for kid, key := range keys {
+		if !key.retiredAt.IsZero() && now.Sub(key.retiredAt) >= r.retention {
+			delete(keys, kid)
+		}
+	}
+
+	for kid := range r.keys {
+		if _, ok := keys[kid]; ok {
+			continue
+		}
+
+		if _, err := r.jwks.KeyDelete(ctx, kid); err != nil {
+			return nil, fmt.Errorf("delete key from storage: %w", err)
+		}
+	}
+
+	for kid, key := range keys {
+		if _, ok := r.keys[kid]; ok {
+			continue
+		}
+
+		jwk, err := jwkset.NewJWKFromKey(key.publicKey, jwkset.JWKOptions{
+			Metadata: jwkset.JWKMetadataOptions{
+				KID: kid,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create jwk from key: %w", err)
+		}
+
+		if err = r.jwks.KeyWrite(ctx, jwk); err != nil {
+			return nil, fmt.Errorf("add key to storage: %w", err)
+		}
+	}
+
+	r.keys = keys
+	r.current = *current
+
+	stillRetired := []retiredKey{}
+	for kid, key := range keys {
+		if !key.retiredAt.IsZero() {
+			stillRetired = append(stillRetired, retiredKey{KID: kid, PublicKey: key.publicKey, RetiredAt: key.retiredAt})
+		}
+	}
+
+	slices.SortFunc(stillRetired, func(a, b retiredKey) int {
+		return strings.Compare(a.KID, b.KID)
+	})
+
+	return stillRetired, nil
+}
+
+// loadRetiredKeys reads the public parts of the keys removed from the directory, so they stay published
+// after restarts and on every replica until the tokens signed with them have expired.
+// It returns nil if no key has been retired yet.
+func loadRetiredKeys(path string) ([]retiredKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read retired keys: %w", err)
+	}
+
+	var keys []retiredKey
+	if err = json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("parse retired keys: %w", err)
+	}
+
+	for _, key := range keys {
+		if len(key.PublicKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("retired key '%s': invalid public key", key.KID)
+		}
+	}
+
+	return keys, nil
+}
+
+// saveRetiredKeys replaces the file atomically, so a replica reading it concurrently gets either version.
+func saveRetiredKeys(path string, keys []retiredKey) error {
+	data, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal retired keys: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("create retired keys file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write retired keys: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("write retired keys: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("replace retired keys file: %w", err)
+	}
+
+	return nil
+}
+
+// checkRetiredKeysWritable fails at startup instead of the first rotation, when the key would be lost.
+func checkRetiredKeysWritable(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("retired keys directory '%s' is not writable: %w", filepath.Dir(path), err)
+	}
+
+	_ = tmp.Close()
+	return os.Remove(tmp.Name())
+}
+
+func retiredKeysEqual(a, b []retiredKey) bool {
+	return slices.EqualFunc(a, b, func(a, b retiredKey) bool {
+		return a.KID == b.KID && a.RetiredAt.Equal(b.RetiredAt)
+	})
+}
+
+// loadKeysDir loads all *.pem keys from the directory. The current key is named in the 'current' file,
+// which can be omitted if the directory contains only one key.
+//
+// To rotate the key without invalidating tokens of the consumers, put the new key into the directory first,
+// so it is published in the JWKS, and then point 'current' to it. Old key file can be removed afterward:
+// its public key is kept in the retired keys file and published until all tokens signed with it have expired,
+// so the directory of that file has to be writable for the service.
+func loadKeysDir(dir string) (keys []signingKey, currentKID string, err error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, "", fmt.Errorf("list keys: %w", err)
+	}
+
+	if len(paths) == 0 {
+		return nil, "", fmt.Errorf("no keys found in '%s'", dir)
+	}
+
+	currentName, err := os.ReadFile(filepath.Join(dir, currentKeyFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, "", fmt.Errorf("read current key name: %w", err)
+	}
+
+	if len(currentName) == 0 && len(paths) > 1 {
+		return nil, "", fmt.Errorf("'%s' file is required when there are multiple keys", currentKeyFile)
+	}
+
+	keys = make([]signingKey, 0, len(paths))
+	for _, path := range paths {
+		key, err := loadKeyFile(path)
+		if err != nil {
+			return nil, "", err
+		}
+
+		keys = append(keys, key)
+
+		if len(paths) == 1 || filepath.Base(path) == strings.TrimSpace(string(currentName)) {
+			currentKID = key.kid
+		}
+	}
+
+	if currentKID == "" {
+		return nil, "", fmt.Errorf("current key '%s' not found", strings.TrimSpace(string(currentName)))
+	}
+
+	return keys, currentKID, nil
+}
+
+func loadKeyFile(path string) (signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("read JWT key '%s': %w", path, err)
+	}
+
+	key, err := jwt.ParseEdPrivateKeyFromPEM(data)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("parse ed private key '%s': %w", path, err)
+	}
+
+	privateKey := key.(ed25519.PrivateKey)
+
+	return signingKey{
+		kid:        mustGenerateKID(privateKey.Public().(ed25519.PublicKey)),
+		privateKey: privateKey,
+	}, nil
+}
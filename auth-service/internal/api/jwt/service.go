@@ -0,0 +1,41 @@
+package jwt
+
+import (
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	authjwt "github.com/tech-inspire/backend/auth-service/pkg/jwt"
+)
+
+// BuildServiceToken signs the token of the authenticated service client, it can not be refreshed:
+// the client requests a new one with its credentials.
+func (j Signer) BuildServiceToken(client models.ServiceClient) (token string, expiresAt time.Time, err error) {
+	var (
+		now              = time.Now()
+		serviceExpiresAt = now.Add(j.serviceTokenDuration)
+	)
+
+	serviceToken := jwt.NewWithClaims(new(jwt.SigningMethodEd25519), authjwt.ServiceTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    authjwt.Issuer,
+			Subject:   client.ID,
+			Audience:  []string{authjwt.ServiceAudience},
+			ExpiresAt: jwt.NewNumericDate(serviceExpiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		TokenUse: authjwt.ServiceToken,
+		Scopes:   client.Scopes,
+	})
+
+	key := j.keys.currentKey()
+	serviceToken.Header["kid"] = key.kid
+
+	signedServiceToken, err := serviceToken.SignedString(key.privateKey)
+	if err != nil {
+		return "", serviceExpiresAt, errors.Errorf("sign service token: %w", err)
+	}
+
+	return signedServiceToken, serviceExpiresAt, nil
+}
@@ -4,73 +4,140 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
+	"log/slog"
 	"time"
 
-	"github.com/MicahParks/jwkset"
 	"github.com/MicahParks/keyfunc/v3"
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/tech-inspire/backend/auth-service/internal/config"
 	authjwt "github.com/tech-inspire/backend/auth-service/pkg/jwt"
-	"golang.org/x/crypto/ed25519"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
 )
 
 type Signer struct {
-	privateKey ed25519.PrivateKey
-	jwtKID     string
-	jwks       jwkset.Storage
+	keys *keyRing
+
+	keysDir         string
+	keyPath         string
+	retiredKeysPath string
 
 	accessTokenDuration  time.Duration
 	refreshTokenDuration time.Duration
+	serviceTokenDuration time.Duration
 }
 
 func NewSigner(
+	lc fx.Lifecycle,
 	cfg *config.Config,
 ) (*Signer, error) {
-	data, err := os.ReadFile(cfg.JWT.UserJWKPath)
-	if err != nil {
-		return nil, fmt.Errorf("read JWT key: %w", err)
+	signer := &Signer{
+		keys: newKeyRing(max(cfg.JWT.AccessTokenDuration, cfg.JWT.RefreshTokenDuration, cfg.JWT.ServiceTokenDuration)),
+
+		keysDir:         cfg.JWT.UserKeysDir,
+		keyPath:         cfg.JWT.UserJWKPath,
+		retiredKeysPath: cfg.JWT.RetiredKeysPath,
+
+		accessTokenDuration:  cfg.JWT.AccessTokenDuration,
+		refreshTokenDuration: cfg.JWT.RefreshTokenDuration,
+		serviceTokenDuration: cfg.JWT.ServiceTokenDuration,
 	}
 
-	key, err := jwt.ParseEdPrivateKeyFromPEM(data)
-	if err != nil {
-		return nil, fmt.Errorf("parse ed private key: %w", err)
+	if signer.keysDir != "" {
+		if err := checkRetiredKeysWritable(signer.retiredKeysPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := signer.Reload(context.TODO()); err != nil {
+		return nil, err
+	}
+
+	if signer.keysDir != "" && cfg.JWT.KeysReloadInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		lc.Append(fx.Hook{
+			OnStart: func(context.Context) error {
+				go signer.watchKeys(ctx, cfg.JWT.KeysReloadInterval)
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				cancel()
+				return nil
+			},
+		})
 	}
 
-	privateKey := key.(ed25519.PrivateKey)
-	publicKey := privateKey.Public().(ed25519.PublicKey)
-	kid := mustGenerateKID(publicKey)
+	return signer, nil
+}
+
+// Reload loads keys again, so that the current key can be rotated without restart.
+func (j Signer) Reload(ctx context.Context) error {
+	var (
+		keys       []signingKey
+		currentKID string
+		retired    []retiredKey
+		err        error
+	)
+
+	if j.keysDir != "" {
+		keys, currentKID, err = loadKeysDir(j.keysDir)
+		if err != nil {
+			return fmt.Errorf("load keys: %w", err)
+		}
 
-	options := jwkset.JWKOptions{
-		Metadata: jwkset.JWKMetadataOptions{
-			KID: kid,
-		},
+		retired, err = loadRetiredKeys(j.retiredKeysPath)
+		if err != nil {
+			return fmt.Errorf("load keys: %w", err)
+		}
+	} else {
+		key, err := loadKeyFile(j.keyPath)
+		if err != nil {
+			return err
+		}
+
+		keys, currentKID = []signingKey{key}, key.kid
 	}
 
-	// Create the JWK from the key and options.
-	jwk, err := jwkset.NewJWKFromKey(publicKey, options)
+	previousKID := j.keys.currentKey().kid
+
+	stillRetired, err := j.keys.update(ctx, keys, currentKID, retired, time.Now())
 	if err != nil {
-		return nil, fmt.Errorf("create jwk from key: %w", err)
+		return fmt.Errorf("update keys: %w", err)
+	}
+
+	if j.keysDir != "" && !retiredKeysEqual(retired, stillRetired) {
+		if err = saveRetiredKeys(j.retiredKeysPath, stillRetired); err != nil {
+			return fmt.Errorf("save retired keys: %w", err)
+		}
 	}
 
-	storage := jwkset.NewMemoryStorage()
-	if err = storage.KeyWrite(context.TODO(), jwk); err != nil {
-		return nil, fmt.Errorf("add key to storage: %w", err)
+	if previousKID != currentKID {
+		slog.Info("jwt signing key changed", slog.String("kid", currentKID))
 	}
 
-	return &Signer{
-		privateKey: privateKey,
-		jwtKID:     kid,
-		jwks:       storage,
+	return nil
+}
 
-		accessTokenDuration:  cfg.JWT.AccessTokenDuration,
-		refreshTokenDuration: cfg.JWT.RefreshTokenDuration,
-	}, nil
+func (j Signer) watchKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.Reload(ctx); err != nil {
+				// keep signing with the previous keys until the directory is fixed
+				slog.Error("reload jwt keys", logger.Error(err))
+			}
+		}
+	}
 }
 
 func (j Signer) Validator() (*authjwt.Validator, error) {
 	kf, err := keyfunc.New(keyfunc.Options{
-		Storage: j.jwks,
+		Storage: j.keys.jwks,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create keyfunc: %w", err)
@@ -80,5 +147,5 @@ func (j Signer) Validator() (*authjwt.Validator, error) {
 }
 
 func (j Signer) PublicUsersJWKS() (json.RawMessage, error) {
-	return j.jwks.JSONPublic(context.TODO())
+	return j.keys.jwks.JSONPublic(context.TODO())
 }
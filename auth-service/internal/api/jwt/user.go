@@ -24,14 +24,16 @@ func (j Signer) BuildUserAccessToken(user models.User, sessionID uuid.UUID) (tok
 			ExpiresAt: jwt.NewNumericDate(accessExpiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
-		TokenUse:  authjwt.AccessToken,
-		SessionID: sessionID,
-		IsAdmin:   user.IsAdmin,
+		TokenUse:       authjwt.AccessToken,
+		SessionID:      sessionID,
+		IsAdmin:        user.IsAdmin,
+		TokensRevision: user.TokensRevision,
 	})
 
-	accessToken.Header["kid"] = j.jwtKID
+	key := j.keys.currentKey()
+	accessToken.Header["kid"] = key.kid
 
-	signedAccessToken, err := accessToken.SignedString(j.privateKey)
+	signedAccessToken, err := accessToken.SignedString(key.privateKey)
 	if err != nil {
 		return "", accessExpiresAt, errors.Errorf("sign access token: %w", err)
 	}
@@ -57,9 +59,10 @@ func (j Signer) BuildUserRefreshToken(userID, sessionID uuid.UUID, sessionToken
 		SessionID:    sessionID,
 		SessionToken: sessionToken,
 	})
-	refreshToken.Header["kid"] = j.jwtKID
+	key := j.keys.currentKey()
+	refreshToken.Header["kid"] = key.kid
 
-	signedRefreshToken, err := refreshToken.SignedString(j.privateKey)
+	signedRefreshToken, err := refreshToken.SignedString(key.privateKey)
 	if err != nil {
 		return "", refreshExpiresAt, errors.Errorf("failed to sign refresh token: %w", err)
 	}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+func (a UserHandler) ChangePassword(ctx context.Context, c *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	token := authmiddleware.GetUserInfo(ctx)
+
+	err := a.userService.ChangePassword(ctx, token.UserID, dto.ChangePasswordInput{
+		CurrentPassword:     c.Msg.CurrentPassword,
+		NewPassword:         c.Msg.NewPassword.GetValue(),
+		RevokeOtherSessions: c.Msg.RevokeOtherSessions,
+		CurrentSessionID:    token.SessionID,
+	}, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("change password: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ChangePasswordResponse{}), nil
+}
+
+func (a AuthHandler) RequestEmailChange(ctx context.Context, c *connect.Request[v1.RequestEmailChangeRequest]) (*connect.Response[v1.RequestEmailChangeResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	err := a.authService.RequestEmailChange(ctx, userID, c.Msg.NewEmail.GetValue(), c.Msg.Password)
+	if err != nil {
+		return nil, fmt.Errorf("request email change: %w", err)
+	}
+
+	return connect.NewResponse(&v1.RequestEmailChangeResponse{}), nil
+}
+
+func (a AuthHandler) ConfirmEmailChange(ctx context.Context, c *connect.Request[v1.ConfirmEmailChangeRequest]) (*connect.Response[v1.ConfirmEmailChangeResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	user, err := a.authService.ConfirmEmailChange(ctx, userID,
+		c.Msg.NewEmail.GetValue(), c.Msg.Code.GetValue(), middleware.GetClientInfo(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("confirm email change: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ConfirmEmailChangeResponse{
+		User: userPB(*user),
+	}), nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+type AccountDeletionHandler struct {
+	accountDeletionService AccountDeletionService
+}
+
+func NewAccountDeletionHandler(accountDeletionService AccountDeletionService) *AccountDeletionHandler {
+	return &AccountDeletionHandler{accountDeletionService: accountDeletionService}
+}
+
+func (h AccountDeletionHandler) RequestAccountDeletion(
+	ctx context.Context, c *connect.Request[v1.RequestAccountDeletionRequest],
+) (*connect.Response[v1.RequestAccountDeletionResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if _, err := h.accountDeletionService.RequestAccountDeletion(ctx, userID, c.Msg.Password); err != nil {
+		return nil, fmt.Errorf("request account deletion: %w", err)
+	}
+
+	// the services the deletion waits for are listed with the deletion
+	out, err := h.accountDeletionService.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get account deletion: %w", err)
+	}
+
+	return connect.NewResponse(&v1.RequestAccountDeletionResponse{
+		Deletion: accountDeletionPB(*out),
+	}), nil
+}
+
+func (h AccountDeletionHandler) CancelAccountDeletion(
+	ctx context.Context, _ *connect.Request[v1.CancelAccountDeletionRequest],
+) (*connect.Response[v1.CancelAccountDeletionResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if err := h.accountDeletionService.CancelAccountDeletion(ctx, userID); err != nil {
+		return nil, fmt.Errorf("cancel account deletion: %w", err)
+	}
+
+	return connect.NewResponse(&v1.CancelAccountDeletionResponse{}), nil
+}
+
+func (h AccountDeletionHandler) GetAccountDeletion(
+	ctx context.Context, _ *connect.Request[v1.GetAccountDeletionRequest],
+) (*connect.Response[v1.GetAccountDeletionResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := h.accountDeletionService.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get account deletion: %w", err)
+	}
+
+	return connect.NewResponse(&v1.GetAccountDeletionResponse{
+		Deletion: accountDeletionPB(*out),
+	}), nil
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+type AdminHandler struct {
+	adminService AdminService
+}
+
+func NewAdminHandler(adminService AdminService) *AdminHandler {
+	return &AdminHandler{adminService: adminService}
+}
+
+func (a AdminHandler) SearchUsers(ctx context.Context, c *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	params := dto.GetUsersParams{
+		UsernamePattern: c.Msg.UsernamePattern,
+		EmailPattern:    c.Msg.EmailPattern,
+		IDPattern:       c.Msg.IdPattern,
+		IsAdmin:         c.Msg.IsAdmin,
+		Offset:          int(c.Msg.Offset),
+		Limit:           int(c.Msg.Limit),
+		OrderBy:         c.Msg.OrderBy,
+		OrderDirection:  c.Msg.OrderDirection,
+	}
+
+	if c.Msg.Status != v1.UserStatus_USER_STATUS_UNSPECIFIED {
+		status := userStatusModel(c.Msg.Status)
+		params.Status = &status
+	}
+
+	if params.OrderBy != "" && params.OrderDirection == "" {
+		params.OrderDirection = "desc"
+	}
+
+	out, err := a.adminService.SearchUsers(ctx, adminID, params)
+	if err != nil {
+		return nil, fmt.Errorf("search users: %w", err)
+	}
+
+	resp := &v1.SearchUsersResponse{
+		Users:      make([]*v1.AdminUser, 0, len(out.Users)),
+		TotalCount: int32(out.Count),
+	}
+	for _, user := range out.Users {
+		resp.Users = append(resp.Users, adminUserPB(user))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (a AdminHandler) GetUserDetails(ctx context.Context, c *connect.Request[v1.GetUserDetailsRequest]) (*connect.Response[v1.GetUserDetailsResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := a.adminService.GetUser(ctx, adminID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %s: %w", userID, err)
+	}
+
+	resp := &v1.GetUserDetailsResponse{
+		User:     adminUserPB(*out.User),
+		Status:   userStatusPB(out.Status),
+		Sessions: make([]*v1.Session, 0, len(out.Sessions)),
+	}
+
+	if out.Suspension != nil && out.Status != models.UserStatusActive {
+		resp.Suspension = userSuspensionPB(*out.Suspension)
+	}
+
+	for _, session := range out.Sessions {
+		resp.Sessions = append(resp.Sessions, sessionPB(dto.SessionOutput{Session: session}))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (a AdminHandler) SuspendUser(ctx context.Context, c *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	err = a.adminService.SuspendUser(ctx, adminID, dto.SuspendUserInput{
+		UserID: userID,
+		Reason: c.Msg.Reason,
+		Until:  c.Msg.Until.AsTime(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("suspend user %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.SuspendUserResponse{}), nil
+}
+
+func (a AdminHandler) BanUser(ctx context.Context, c *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if err = a.adminService.BanUser(ctx, adminID, userID, c.Msg.Reason); err != nil {
+		return nil, fmt.Errorf("ban user %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.BanUserResponse{}), nil
+}
+
+func (a AdminHandler) UnbanUser(ctx context.Context, c *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if err = a.adminService.UnbanUser(ctx, adminID, userID); err != nil {
+		return nil, fmt.Errorf("unban user %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.UnbanUserResponse{}), nil
+}
+
+func (a AdminHandler) SetUserAdmin(ctx context.Context, c *connect.Request[v1.SetUserAdminRequest]) (*connect.Response[v1.SetUserAdminResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if err = a.adminService.SetUserAdmin(ctx, adminID, userID, c.Msg.IsAdmin); err != nil {
+		return nil, fmt.Errorf("set user admin %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.SetUserAdminResponse{}), nil
+}
+
+func (a AdminHandler) DeleteUser(ctx context.Context, c *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if _, err = a.adminService.DeleteUser(ctx, adminID, userID); err != nil {
+		return nil, fmt.Errorf("delete user %s: %w", userID, err)
+	}
+
+	// the services the deletion waits for are listed with the deletion
+	out, err := a.adminService.GetUserDeletion(ctx, adminID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user deletion %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.DeleteUserResponse{
+		Deletion: accountDeletionPB(*out),
+	}), nil
+}
+
+func (a AdminHandler) GetUserDeletion(ctx context.Context, c *connect.Request[v1.GetUserDeletionRequest]) (*connect.Response[v1.GetUserDeletionResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := a.adminService.GetUserDeletion(ctx, adminID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user deletion %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.GetUserDeletionResponse{
+		Deletion: accountDeletionPB(*out),
+	}), nil
+}
+
+func (a AdminHandler) SearchSecurityEvents(
+	ctx context.Context, c *connect.Request[v1.SearchSecurityEventsRequest],
+) (*connect.Response[v1.SearchSecurityEventsResponse], error) {
+	filter := dto.SecurityEventsFilter{
+		Types:  make([]models.SecurityEventType, 0, len(c.Msg.Types)),
+		Limit:  int(c.Msg.Limit),
+		Offset: int(c.Msg.Offset),
+	}
+
+	if c.Msg.UserId != nil {
+		userID, err := uuid.Parse(*c.Msg.UserId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+		}
+		filter.UserID = &userID
+	}
+
+	for _, eventType := range c.Msg.Types {
+		filter.Types = append(filter.Types, models.SecurityEventType(eventType))
+	}
+
+	if c.Msg.From != nil {
+		from := c.Msg.From.AsTime()
+		filter.From = &from
+	}
+
+	if c.Msg.To != nil {
+		to := c.Msg.To.AsTime()
+		filter.To = &to
+	}
+
+	adminID := authmiddleware.GetUserInfo(ctx).UserID
+
+	events, err := a.adminService.SearchSecurityEvents(ctx, adminID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("search security events: %w", err)
+	}
+
+	return connect.NewResponse(&v1.SearchSecurityEventsResponse{
+		Events: generics.Convert(events, adminSecurityEventPB),
+	}), nil
+}
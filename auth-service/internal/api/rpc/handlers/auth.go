@@ -3,11 +3,13 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/go-errors/errors"
 	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
 	"github.com/tech-inspire/backend/auth-service/internal/api/jwt"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
 	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
 	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
 	"github.com/tech-inspire/backend/auth-service/internal/models"
@@ -52,6 +54,24 @@ func (AuthHandler) loginResponse(tokens *jwt.SignOutput, u models.User) *v1.Succ
 	}
 }
 
+// successLoginResponse signs tokens for the created session.
+// If the second factor is required, the challenge is returned to the client in the error details.
+func (a AuthHandler) successLoginResponse(out *dto.LoginOutput) (*v1.SuccessLoginResponse, error) {
+	if out.MFAChallenge != nil {
+		return nil, apperrors.ErrMFARequired.WithMetadata(map[string]string{
+			"challenge_token": out.MFAChallenge.Token,
+			"expires_at":      out.MFAChallenge.ExpiresAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	tokens, err := a.jwtSigner.SignTokens(*out.User, out.Session.ID, out.Session.Token)
+	if err != nil {
+		return nil, errors.Errorf("jwt: build tokens: %w", err)
+	}
+
+	return a.loginResponse(tokens, *out.User), nil
+}
+
 func (a AuthHandler) Login(ctx context.Context, c *connect.Request[v1.LoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
 	var (
 		out *dto.LoginOutput
@@ -60,9 +80,9 @@ func (a AuthHandler) Login(ctx context.Context, c *connect.Request[v1.LoginReque
 
 	switch login := c.Msg.Login.(type) {
 	case *v1.LoginRequest_Email:
-		out, err = a.authService.LoginByEmail(ctx, login.Email.Value, c.Msg.Password)
+		out, err = a.authService.LoginByEmail(ctx, login.Email.Value, c.Msg.Password, middleware.GetClientInfo(ctx))
 	case *v1.LoginRequest_Username:
-		out, err = a.authService.LoginByUsername(ctx, login.Username.Value, c.Msg.Password)
+		out, err = a.authService.LoginByUsername(ctx, login.Username.Value, c.Msg.Password, middleware.GetClientInfo(ctx))
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument,
 			fmt.Errorf("login type %T is not supported", c.Msg.Login),
@@ -73,12 +93,12 @@ func (a AuthHandler) Login(ctx context.Context, c *connect.Request[v1.LoginReque
 		return nil, err
 	}
 
-	tokens, err := a.jwtSigner.SignTokens(*out.User, out.Session.ID, out.Session.Token)
+	resp, err := a.successLoginResponse(out)
 	if err != nil {
-		return nil, errors.Errorf("jwt: build tokens: %w", err)
+		return nil, err
 	}
 
-	return connect.NewResponse(a.loginResponse(tokens, *out.User)), nil
+	return connect.NewResponse(resp), nil
 }
 
 func (a AuthHandler) Register(ctx context.Context, c *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
@@ -93,22 +113,20 @@ func (a AuthHandler) Register(ctx context.Context, c *connect.Request[v1.Registe
 		Username: c.Msg.Username.Value,
 		Name:     c.Msg.Name.Value,
 		Password: c.Msg.Password.Value,
-	})
+	}, middleware.GetClientInfo(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("register: %w", err)
 	}
 
 	if out.LoginOutput != nil {
-		loginOutput := out.LoginOutput
-
-		tokens, err := a.jwtSigner.SignTokens(*loginOutput.User, loginOutput.Session.ID, loginOutput.Session.Token)
+		resp, err := a.successLoginResponse(out.LoginOutput)
 		if err != nil {
-			return nil, errors.Errorf("jwt: build tokens: %w", err)
+			return nil, err
 		}
 
 		return connect.NewResponse(&v1.RegisterResponse{
 			Flow: &v1.RegisterResponse_LoginResponse{
-				LoginResponse: a.loginResponse(tokens, *loginOutput.User),
+				LoginResponse: resp,
 			},
 		}), nil
 	}
@@ -130,19 +148,19 @@ func (a AuthHandler) RefreshToken(ctx context.Context, c *connect.Request[v1.Ref
 		return nil, fmt.Errorf("%w: %s", apperrors.ErrUnauthorized, err)
 	}
 
-	user, err := a.authService.RefreshSession(ctx,
-		tokenInfo.UserID, tokenInfo.SessionID, tokenInfo.SessionToken,
+	out, err := a.authService.RefreshSession(ctx,
+		tokenInfo.UserID, tokenInfo.SessionID, tokenInfo.SessionToken, middleware.GetClientInfo(ctx),
 	)
 	if err != nil {
-		return nil, errors.Errorf("auth service: get session: %w", err)
+		return nil, errors.Errorf("auth service: refresh session: %w", err)
 	}
 
-	tokens, err := a.jwtSigner.SignTokens(*user, tokenInfo.SessionID, tokenInfo.SessionToken)
+	resp, err := a.successLoginResponse(out)
 	if err != nil {
-		return nil, errors.Errorf("jwt: build tokens: %w", err)
+		return nil, err
 	}
 
-	return connect.NewResponse(a.loginResponse(tokens, *user)), nil
+	return connect.NewResponse(resp), nil
 }
 
 func (a AuthHandler) Logout(ctx context.Context, c *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
@@ -151,7 +169,7 @@ func (a AuthHandler) Logout(ctx context.Context, c *connect.Request[v1.LogoutReq
 		return nil, fmt.Errorf("%w: %s", apperrors.ErrUnauthorized, err)
 	}
 
-	err = a.authService.DeleteSession(ctx, tokenInfo.UserID, tokenInfo.SessionID)
+	err = a.authService.DeleteSession(ctx, tokenInfo.UserID, tokenInfo.SessionID, middleware.GetClientInfo(ctx))
 	if err != nil {
 		return nil, errors.Errorf("delete session: %w", err)
 	}
@@ -160,21 +178,23 @@ func (a AuthHandler) Logout(ctx context.Context, c *connect.Request[v1.LogoutReq
 }
 
 func (a AuthHandler) ConfirmEmail(ctx context.Context, c *connect.Request[v1.ConfirmEmailRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
-	out, err := a.authService.ConfirmRegistrationByCode(ctx, c.Msg.Email.Value, c.Msg.Code.Value)
+	out, err := a.authService.ConfirmRegistrationByCode(ctx,
+		c.Msg.Email.Value, c.Msg.Code.Value, middleware.GetClientInfo(ctx),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("confirm email: %w", err)
 	}
 
-	tokens, err := a.jwtSigner.SignTokens(*out.User, out.Session.ID, out.Session.Token)
+	resp, err := a.successLoginResponse(out)
 	if err != nil {
-		return nil, errors.Errorf("jwt: build tokens: %w", err)
+		return nil, err
 	}
 
-	return connect.NewResponse(a.loginResponse(tokens, *out.User)), nil
+	return connect.NewResponse(resp), nil
 }
 
 func (a AuthHandler) ResetPassword(ctx context.Context, c *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
-	err := a.authService.SendResetPasswordCode(ctx, c.Msg.Email.Value)
+	err := a.authService.SendResetPasswordCode(ctx, c.Msg.Email.Value, middleware.GetClientInfo(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("send password reset code: %w", err)
 	}
@@ -183,7 +203,9 @@ func (a AuthHandler) ResetPassword(ctx context.Context, c *connect.Request[v1.Re
 }
 
 func (a AuthHandler) ConfirmPasswordReset(ctx context.Context, c *connect.Request[v1.ConfirmPasswordResetRequest]) (*connect.Response[v1.ConfirmPasswordResetResponse], error) {
-	err := a.authService.ConfirmResetPasswordByCode(ctx, c.Msg.Email.Value, c.Msg.Code.Value, c.Msg.Password.Value)
+	err := a.authService.ConfirmResetPasswordByCode(ctx,
+		c.Msg.Email.Value, c.Msg.Code.Value, c.Msg.Password.Value, middleware.GetClientInfo(ctx),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("confirm password reset: %w", err)
 	}
@@ -192,7 +214,7 @@ func (a AuthHandler) ConfirmPasswordReset(ctx context.Context, c *connect.Reques
 }
 
 func (a AuthHandler) CheckPasswordResetCode(ctx context.Context, c *connect.Request[v1.CheckPasswordResetCodeRequest]) (*connect.Response[v1.CheckPasswordResetCodeResponse], error) {
-	err := a.authService.CheckResetPasswordCode(ctx, c.Msg.Email.Value, c.Msg.Code.Value)
+	err := a.authService.CheckResetPasswordCode(ctx, c.Msg.Email.Value, c.Msg.Code.Value, middleware.GetClientInfo(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("check password reset code: %w", err)
 	}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+type DataExportsHandler struct {
+	dataExportsService DataExportsService
+}
+
+func NewDataExportsHandler(dataExportsService DataExportsService) *DataExportsHandler {
+	return &DataExportsHandler{dataExportsService: dataExportsService}
+}
+
+func (h DataExportsHandler) RequestDataExport(
+	ctx context.Context, _ *connect.Request[v1.RequestDataExportRequest],
+) (*connect.Response[v1.RequestDataExportResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	export, err := h.dataExportsService.RequestDataExport(ctx, userID, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("request data export: %w", err)
+	}
+
+	return connect.NewResponse(&v1.RequestDataExportResponse{
+		Export: dataExportPB(dto.DataExportOutput{Export: export}),
+	}), nil
+}
+
+func (h DataExportsHandler) GetDataExport(
+	ctx context.Context, _ *connect.Request[v1.GetDataExportRequest],
+) (*connect.Response[v1.GetDataExportResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := h.dataExportsService.GetDataExport(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get data export: %w", err)
+	}
+
+	return connect.NewResponse(&v1.GetDataExportResponse{
+		Export: dataExportPB(*out),
+	}), nil
+}
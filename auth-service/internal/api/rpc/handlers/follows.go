@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FollowsHandler struct {
+	followsService FollowsService
+}
+
+func NewFollowsHandler(followsService FollowsService) *FollowsHandler {
+	return &FollowsHandler{followsService: followsService}
+}
+
+func (h FollowsHandler) Follow(ctx context.Context, c *connect.Request[v1.FollowRequest]) (*connect.Response[v1.FollowResponse], error) {
+	followeeID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if err = h.followsService.Follow(ctx, userID, followeeID); err != nil {
+		return nil, fmt.Errorf("follow %s: %w", followeeID, err)
+	}
+
+	return connect.NewResponse(&v1.FollowResponse{}), nil
+}
+
+func (h FollowsHandler) Unfollow(ctx context.Context, c *connect.Request[v1.UnfollowRequest]) (*connect.Response[v1.UnfollowResponse], error) {
+	followeeID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if err = h.followsService.Unfollow(ctx, userID, followeeID); err != nil {
+		return nil, fmt.Errorf("unfollow %s: %w", followeeID, err)
+	}
+
+	return connect.NewResponse(&v1.UnfollowResponse{}), nil
+}
+
+func (h FollowsHandler) GetFollowStatus(
+	ctx context.Context, c *connect.Request[v1.GetFollowStatusRequest],
+) (*connect.Response[v1.GetFollowStatusResponse], error) {
+	otherID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	status, err := h.followsService.GetFollowStatus(ctx, userID, otherID)
+	if err != nil {
+		return nil, fmt.Errorf("get follow status of %s: %w", otherID, err)
+	}
+
+	return connect.NewResponse(&v1.GetFollowStatusResponse{
+		Following:  status.Following,
+		FollowedBy: status.FollowedBy,
+	}), nil
+}
+
+func (h FollowsHandler) ListFollowers(
+	ctx context.Context, c *connect.Request[v1.ListFollowersRequest],
+) (*connect.Response[v1.ListFollowersResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	out, err := h.followsService.GetFollowers(ctx, userID, dto.FollowsPage{
+		Limit:  int(c.Msg.Limit),
+		Cursor: c.Msg.Cursor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get followers of %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.ListFollowersResponse{
+		Followers:  generics.Convert(out.Users, followPB),
+		NextCursor: out.NextCursor,
+	}), nil
+}
+
+func (h FollowsHandler) ListFollowing(
+	ctx context.Context, c *connect.Request[v1.ListFollowingRequest],
+) (*connect.Response[v1.ListFollowingResponse], error) {
+	userID, err := uuid.Parse(c.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse user id: %w", err))
+	}
+
+	out, err := h.followsService.GetFollowing(ctx, userID, dto.FollowsPage{
+		Limit:  int(c.Msg.Limit),
+		Cursor: c.Msg.Cursor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get following of %s: %w", userID, err)
+	}
+
+	return connect.NewResponse(&v1.ListFollowingResponse{
+		Following:  generics.Convert(out.Users, followPB),
+		NextCursor: out.NextCursor,
+	}), nil
+}
+
+func followPB(f dto.FollowOutput) *v1.Follow {
+	return &v1.Follow{
+		User:       userPB(*f.User),
+		FollowedAt: timestamppb.New(f.FollowedAt),
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
+)
+
+func (a AuthHandler) ConfirmEmailByLink(ctx context.Context, c *connect.Request[v1.ConfirmEmailByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	out, err := a.authService.ConfirmRegistrationByLink(ctx, c.Msg.Token, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("confirm email by link: %w", err)
+	}
+
+	resp, err := a.successLoginResponse(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (a AuthHandler) SendLoginLink(ctx context.Context, c *connect.Request[v1.SendLoginLinkRequest]) (*connect.Response[v1.SendLoginLinkResponse], error) {
+	if err := a.authService.SendLoginLink(ctx, c.Msg.Email.Value, middleware.GetClientInfo(ctx)); err != nil {
+		return nil, fmt.Errorf("send login link: %w", err)
+	}
+
+	return connect.NewResponse(&v1.SendLoginLinkResponse{}), nil
+}
+
+func (a AuthHandler) LoginByLink(ctx context.Context, c *connect.Request[v1.LoginByLinkRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	out, err := a.authService.LoginByLink(ctx, c.Msg.Token, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("login by link: %w", err)
+	}
+
+	resp, err := a.successLoginResponse(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (a AuthHandler) ConfirmPasswordResetByLink(
+	ctx context.Context, c *connect.Request[v1.ConfirmPasswordResetByLinkRequest],
+) (*connect.Response[v1.ConfirmPasswordResetResponse], error) {
+	err := a.authService.ConfirmResetPasswordByLink(ctx, c.Msg.Token, c.Msg.Password.Value, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("confirm password reset by link: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ConfirmPasswordResetResponse{}), nil
+}
+
+func (a AuthHandler) RevokeSessionByLink(
+	ctx context.Context, c *connect.Request[v1.RevokeSessionByLinkRequest],
+) (*connect.Response[v1.RevokeSessionByLinkResponse], error) {
+	if err := a.authService.RevokeSessionByLink(ctx, c.Msg.Token, middleware.GetClientInfo(ctx)); err != nil {
+		return nil, fmt.Errorf("revoke session by link: %w", err)
+	}
+
+	return connect.NewResponse(&v1.RevokeSessionByLinkResponse{}), nil
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+func (a AuthHandler) VerifyMFALogin(ctx context.Context, c *connect.Request[v1.VerifyMFALoginRequest]) (*connect.Response[v1.SuccessLoginResponse], error) {
+	out, err := a.authService.VerifyMFALogin(ctx, c.Msg.ChallengeToken, c.Msg.Code, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("verify mfa login: %w", err)
+	}
+
+	resp, err := a.successLoginResponse(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (a AuthHandler) BeginTOTPEnrollment(ctx context.Context, c *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := a.authService.BeginTOTPEnrollment(ctx, userID, c.Msg.Password)
+	if err != nil {
+		return nil, fmt.Errorf("begin totp enrollment: %w", err)
+	}
+
+	return connect.NewResponse(&v1.BeginTOTPEnrollmentResponse{
+		Secret:          out.Secret,
+		ProvisioningUri: out.ProvisioningURI,
+	}), nil
+}
+
+func (a AuthHandler) ConfirmTOTPEnrollment(ctx context.Context, c *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := a.authService.ConfirmTOTPEnrollment(ctx, userID, c.Msg.Code, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("confirm totp enrollment: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ConfirmTOTPEnrollmentResponse{
+		RecoveryCodes: out.Codes,
+	}), nil
+}
+
+func (a AuthHandler) DisableTOTP(ctx context.Context, c *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	err := a.authService.DisableTOTP(ctx, userID, c.Msg.Password, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("disable totp: %w", err)
+	}
+
+	return connect.NewResponse(&v1.DisableTOTPResponse{}), nil
+}
+
+func (a AuthHandler) RegenerateRecoveryCodes(ctx context.Context, c *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := a.authService.RegenerateRecoveryCodes(ctx, userID, c.Msg.Password, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("regenerate recovery codes: %w", err)
+	}
+
+	return connect.NewResponse(&v1.RegenerateRecoveryCodesResponse{
+		RecoveryCodes: out.Codes,
+	}), nil
+}
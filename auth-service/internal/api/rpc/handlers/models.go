@@ -3,18 +3,185 @@ package handlers
 import (
 	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
 	"github.com/tech-inspire/backend/auth-service/internal/models"
+	authproto "github.com/tech-inspire/backend/auth-service/internal/proto"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func userPB(u models.User) *v1.User {
-	return &v1.User{
-		Id: u.ID.String(),
-		Username: &v1.Username{
-			Value: u.Username,
-		},
-		Name: &v1.Name{
-			Value: u.Name,
-		},
-		AvatarUrl:   u.AvatarURL,
-		Description: u.Description,
+	return authproto.User(u)
+}
+
+// currentUserPB includes the settings, which are returned only to the user itself.
+func currentUserPB(u models.User) *v1.User {
+	user := userPB(u)
+	user.Locale = &u.Locale
+	user.NotifyNewDevices = &u.NotifyNewDevices
+
+	return user
+}
+
+func adminUserPB(u models.User) *v1.AdminUser {
+	return &v1.AdminUser{
+		User:      userPB(u),
+		Email:     u.Email,
+		IsAdmin:   u.IsAdmin,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+	}
+}
+
+func userStatusPB(status models.UserStatus) v1.UserStatus {
+	switch status {
+	case models.UserStatusActive:
+		return v1.UserStatus_USER_STATUS_ACTIVE
+	case models.UserStatusSuspended:
+		return v1.UserStatus_USER_STATUS_SUSPENDED
+	case models.UserStatusBanned:
+		return v1.UserStatus_USER_STATUS_BANNED
+	default:
+		return v1.UserStatus_USER_STATUS_UNSPECIFIED
+	}
+}
+
+func userStatusModel(status v1.UserStatus) models.UserStatus {
+	switch status {
+	case v1.UserStatus_USER_STATUS_SUSPENDED:
+		return models.UserStatusSuspended
+	case v1.UserStatus_USER_STATUS_BANNED:
+		return models.UserStatusBanned
+	default:
+		return models.UserStatusActive
+	}
+}
+
+func userSuspensionPB(s models.UserSuspension) *v1.UserSuspension {
+	suspension := &v1.UserSuspension{
+		Reason:    s.Reason,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+
+	if s.ExpiresAt != nil {
+		suspension.ExpiresAt = timestamppb.New(*s.ExpiresAt)
+	}
+
+	if s.SuspendedBy != nil {
+		suspendedBy := s.SuspendedBy.String()
+		suspension.SuspendedBy = &suspendedBy
+	}
+
+	return suspension
+}
+
+func accountDeletionPB(out dto.AccountDeletionOutput) *v1.AccountDeletion {
+	deletion := &v1.AccountDeletion{
+		RequestedAt:     timestamppb.New(out.Deletion.RequestedAt),
+		PurgeAfter:      timestamppb.New(out.Deletion.PurgeAfter),
+		PendingServices: out.PendingServices,
+		Completed:       out.Completed(),
+	}
+
+	if out.Deletion.DeletedAt != nil {
+		deletion.DeletedAt = timestamppb.New(*out.Deletion.DeletedAt)
+	}
+
+	return deletion
+}
+
+func identityPB(i models.UserIdentity) *v1.Identity {
+	return &v1.Identity{
+		Provider: i.Provider,
+		Subject:  i.Subject,
+		Email:    i.Email,
+		LinkedAt: timestamppb.New(i.CreatedAt),
+	}
+}
+
+func securityEventPB(e models.SecurityEvent) *v1.SecurityEvent {
+	event := &v1.SecurityEvent{
+		Id:              e.ID,
+		Type:            string(e.Type),
+		Outcome:         string(e.Outcome),
+		Ip:              e.IP,
+		UserAgent:       e.UserAgent,
+		Metadata:        e.Metadata,
+		ByAdministrator: e.ActorID != nil,
+		CreatedAt:       timestamppb.New(e.CreatedAt),
+	}
+
+	if e.SessionID != nil {
+		sessionID := e.SessionID.String()
+		event.SessionId = &sessionID
+	}
+
+	return event
+}
+
+func adminSecurityEventPB(e models.SecurityEvent) *v1.AdminSecurityEvent {
+	event := &v1.AdminSecurityEvent{
+		Event: securityEventPB(e),
+	}
+
+	if e.UserID != nil {
+		userID := e.UserID.String()
+		event.UserId = &userID
+	}
+
+	if e.ActorID != nil {
+		actorID := e.ActorID.String()
+		event.ActorId = &actorID
+	}
+
+	return event
+}
+
+func personalTokenPB(t models.PersonalToken) *v1.PersonalToken {
+	token := &v1.PersonalToken{
+		Id:        t.ID.String(),
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		ExpiresAt: timestamppb.New(t.ExpiresAt),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+
+	if t.LastUsedAt != nil {
+		token.LastUsedAt = timestamppb.New(*t.LastUsedAt)
+	}
+
+	return token
+}
+
+func dataExportPB(out dto.DataExportOutput) *v1.DataExport {
+	export := &v1.DataExport{
+		Id:              out.Export.ID.String(),
+		Status:          dataExportStatusPB(out.Export.Status),
+		RequestedAt:     timestamppb.New(out.Export.RequestedAt),
+		PendingServices: out.PendingServices,
+	}
+
+	if out.Export.CompletedAt != nil {
+		export.CompletedAt = timestamppb.New(*out.Export.CompletedAt)
+	}
+	if out.Export.ExpiresAt != nil {
+		export.ExpiresAt = timestamppb.New(*out.Export.ExpiresAt)
+	}
+	if out.DownloadURL != "" {
+		export.DownloadUrl = &out.DownloadURL
+	}
+
+	return export
+}
+
+func dataExportStatusPB(status models.DataExportStatus) v1.DataExportStatus {
+	switch status {
+	case models.DataExportPending:
+		return v1.DataExportStatus_DATA_EXPORT_STATUS_PENDING
+	case models.DataExportReady:
+		return v1.DataExportStatus_DATA_EXPORT_STATUS_READY
+	case models.DataExportFailed:
+		return v1.DataExportStatus_DATA_EXPORT_STATUS_FAILED
+	case models.DataExportExpired:
+		return v1.DataExportStatus_DATA_EXPORT_STATUS_EXPIRED
+	default:
+		return v1.DataExportStatus_DATA_EXPORT_STATUS_UNSPECIFIED
 	}
 }
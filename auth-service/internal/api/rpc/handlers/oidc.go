@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+type OIDCHandler struct {
+	oidcService OIDCService
+	// authHandler signs the tokens of the sessions started by the providers
+	authHandler *AuthHandler
+}
+
+func NewOIDCHandler(oidcService OIDCService, authHandler *AuthHandler) *OIDCHandler {
+	return &OIDCHandler{oidcService: oidcService, authHandler: authHandler}
+}
+
+func (h OIDCHandler) ListOIDCProviders(
+	_ context.Context, _ *connect.Request[v1.ListOIDCProvidersRequest],
+) (*connect.Response[v1.ListOIDCProvidersResponse], error) {
+	return connect.NewResponse(&v1.ListOIDCProvidersResponse{
+		Providers: h.oidcService.GetProviders(),
+	}), nil
+}
+
+func (h OIDCHandler) StartOIDCLogin(
+	ctx context.Context, c *connect.Request[v1.StartOIDCLoginRequest],
+) (*connect.Response[v1.StartOIDCLoginResponse], error) {
+	authorizationURL, err := h.oidcService.StartAuthorization(ctx, c.Msg.Provider, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("start authorization: %w", err)
+	}
+
+	return connect.NewResponse(&v1.StartOIDCLoginResponse{
+		AuthorizationUrl: authorizationURL,
+	}), nil
+}
+
+func (h OIDCHandler) CompleteOIDCLogin(
+	ctx context.Context, c *connect.Request[v1.CompleteOIDCLoginRequest],
+) (*connect.Response[v1.CompleteOIDCLoginResponse], error) {
+	out, err := h.oidcService.CompleteAuthorization(ctx,
+		c.Msg.Provider, c.Msg.Code, c.Msg.State, middleware.GetClientInfo(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("complete authorization: %w", err)
+	}
+
+	if out.LinkConfirmationRequired {
+		return connect.NewResponse(&v1.CompleteOIDCLoginResponse{
+			Flow: &v1.CompleteOIDCLoginResponse_LinkConfirmationRequired{
+				LinkConfirmationRequired: &v1.IdentityLinkConfirmationRequired{Email: out.Email},
+			},
+		}), nil
+	}
+
+	resp, err := h.authHandler.successLoginResponse(out.LoginOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CompleteOIDCLoginResponse{
+		Flow: &v1.CompleteOIDCLoginResponse_LoginResponse{
+			LoginResponse: resp,
+		},
+	}), nil
+}
+
+func (h OIDCHandler) ConfirmIdentityLink(
+	ctx context.Context, c *connect.Request[v1.ConfirmIdentityLinkRequest],
+) (*connect.Response[v1.SuccessLoginResponse], error) {
+	out, err := h.oidcService.ConfirmIdentityLink(ctx, c.Msg.Token, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("confirm identity link: %w", err)
+	}
+
+	resp, err := h.authHandler.successLoginResponse(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (h OIDCHandler) ListIdentities(
+	ctx context.Context, _ *connect.Request[v1.ListIdentitiesRequest],
+) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	identities, err := h.oidcService.GetUserIdentities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user identities: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ListIdentitiesResponse{
+		Identities: generics.Convert(identities, identityPB),
+	}), nil
+}
+
+func (h OIDCHandler) UnlinkIdentity(
+	ctx context.Context, c *connect.Request[v1.UnlinkIdentityRequest],
+) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	err := h.oidcService.UnlinkIdentity(ctx, userID, c.Msg.Provider, c.Msg.Subject, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unlink identity: %w", err)
+	}
+
+	return connect.NewResponse(&v1.UnlinkIdentityResponse{}), nil
+}
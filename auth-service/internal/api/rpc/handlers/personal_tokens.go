@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
+	authjwt "github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PersonalTokenValidator lets the auth middleware accept personal access tokens.
+type PersonalTokenValidator struct {
+	personalTokensService PersonalTokensService
+}
+
+func NewPersonalTokenValidator(personalTokensService PersonalTokensService) *PersonalTokenValidator {
+	return &PersonalTokenValidator{personalTokensService: personalTokensService}
+}
+
+// ValidatePersonalToken never grants the admin role, administrators have to sign in.
+func (v PersonalTokenValidator) ValidatePersonalToken(ctx context.Context, token string) (*authjwt.ValidateUserAccessTokenOutput, error) {
+	personalToken, err := v.personalTokensService.ValidatePersonalToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authjwt.ValidateUserAccessTokenOutput{
+		UserID:          personalToken.UserID,
+		IssuedAt:        personalToken.CreatedAt,
+		PersonalTokenID: &personalToken.ID,
+		Scopes:          personalToken.Scopes,
+	}, nil
+}
+
+type PersonalTokensHandler struct {
+	personalTokensService PersonalTokensService
+}
+
+func NewPersonalTokensHandler(personalTokensService PersonalTokensService) *PersonalTokensHandler {
+	return &PersonalTokensHandler{personalTokensService: personalTokensService}
+}
+
+func (h PersonalTokensHandler) CreatePersonalToken(
+	ctx context.Context, c *connect.Request[v1.CreatePersonalTokenRequest],
+) (*connect.Response[v1.CreatePersonalTokenResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	out, err := h.personalTokensService.CreatePersonalToken(ctx, userID, dto.CreatePersonalTokenInput{
+		Name:      c.Msg.Name,
+		Scopes:    c.Msg.Scopes,
+		ExpiresAt: c.Msg.ExpiresAt.AsTime(),
+	}, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("create personal token: %w", err)
+	}
+
+	return connect.NewResponse(&v1.CreatePersonalTokenResponse{
+		PersonalToken: personalTokenPB(out.PersonalToken),
+		Token:         out.Token,
+	}), nil
+}
+
+func (h PersonalTokensHandler) ListPersonalTokens(
+	ctx context.Context, _ *connect.Request[v1.ListPersonalTokensRequest],
+) (*connect.Response[v1.ListPersonalTokensResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	tokens, err := h.personalTokensService.GetPersonalTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get personal tokens: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ListPersonalTokensResponse{
+		PersonalTokens: generics.Convert(tokens, personalTokenPB),
+	}), nil
+}
+
+func (h PersonalTokensHandler) RevokePersonalToken(
+	ctx context.Context, c *connect.Request[v1.RevokePersonalTokenRequest],
+) (*connect.Response[v1.RevokePersonalTokenResponse], error) {
+	tokenID, err := uuid.Parse(c.Msg.PersonalTokenId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse personal token id: %w", err))
+	}
+
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	err = h.personalTokensService.RevokePersonalToken(ctx, userID, tokenID, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("revoke personal token %s: %w", tokenID, err)
+	}
+
+	return connect.NewResponse(&v1.RevokePersonalTokenResponse{}), nil
+}
+
+// ValidatePersonalToken lets the other services accept the personal access tokens.
+func (h PersonalTokensHandler) ValidatePersonalToken(
+	ctx context.Context, c *connect.Request[v1.ValidatePersonalTokenRequest],
+) (*connect.Response[v1.ValidatePersonalTokenResponse], error) {
+	token, err := h.personalTokensService.ValidatePersonalToken(ctx, c.Msg.Token)
+	if err != nil {
+		return nil, fmt.Errorf("validate personal token: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ValidatePersonalTokenResponse{
+		UserId:          token.UserID.String(),
+		PersonalTokenId: token.ID.String(),
+		Scopes:          token.Scopes,
+		CreatedAt:       timestamppb.New(token.CreatedAt),
+	}), nil
+}
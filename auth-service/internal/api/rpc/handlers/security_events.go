@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+type SecurityEventsHandler struct {
+	securityEventsService SecurityEventsService
+}
+
+func NewSecurityEventsHandler(securityEventsService SecurityEventsService) *SecurityEventsHandler {
+	return &SecurityEventsHandler{securityEventsService: securityEventsService}
+}
+
+func (h SecurityEventsHandler) ListSecurityEvents(
+	ctx context.Context, c *connect.Request[v1.ListSecurityEventsRequest],
+) (*connect.Response[v1.ListSecurityEventsResponse], error) {
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	events, err := h.securityEventsService.GetUserSecurityEvents(ctx, userID, int(c.Msg.Limit), int(c.Msg.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("get user security events: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ListSecurityEventsResponse{
+		Events: generics.Convert(events, securityEventPB),
+	}), nil
+}
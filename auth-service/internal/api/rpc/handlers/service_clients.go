@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/jwt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ServiceClientsHandler struct {
+	serviceClientsService ServiceClientsService
+	jwtSigner             *jwt.Signer
+}
+
+func NewServiceClientsHandler(serviceClientsService ServiceClientsService, jwtSigner *jwt.Signer) *ServiceClientsHandler {
+	return &ServiceClientsHandler{
+		serviceClientsService: serviceClientsService,
+		jwtSigner:             jwtSigner,
+	}
+}
+
+func (h ServiceClientsHandler) IssueServiceToken(
+	ctx context.Context, c *connect.Request[v1.IssueServiceTokenRequest],
+) (*connect.Response[v1.IssueServiceTokenResponse], error) {
+	client, err := h.serviceClientsService.AuthenticateServiceClient(ctx,
+		c.Msg.ClientId, c.Msg.ClientSecret, c.Msg.Scopes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("authenticate service client: %w", err)
+	}
+
+	token, expiresAt, err := h.jwtSigner.BuildServiceToken(*client)
+	if err != nil {
+		return nil, fmt.Errorf("build service token: %w", err)
+	}
+
+	return connect.NewResponse(&v1.IssueServiceTokenResponse{
+		ServiceToken: token,
+		ExpiresAt:    timestamppb.New(expiresAt),
+		Scopes:       client.Scopes,
+	}), nil
+}
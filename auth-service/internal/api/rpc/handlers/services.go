@@ -10,23 +10,44 @@ import (
 
 type AuthService interface {
 	GetSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (*models.Session, error)
-	DeleteSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error
-	Register(ctx context.Context, params dto.RegisterParams) (*dto.RegisterOutput, error)
-	ConfirmRegistrationByCode(ctx context.Context, email string, code string) (*dto.LoginOutput, error)
-	SendResetPasswordCode(ctx context.Context, email string) error
-	CheckResetPasswordCode(ctx context.Context, email string, code string) error
-	ConfirmResetPasswordByCode(ctx context.Context, email string, code string, password string) error
-	LoginByEmail(ctx context.Context, email string, password string) (*dto.LoginOutput, error)
-	LoginByUsername(ctx context.Context, username string, password string) (*dto.LoginOutput, error)
-	RefreshSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, sessionToken string) (*models.User, error)
+	DeleteSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, client models.ClientInfo) error
+	Register(ctx context.Context, params dto.RegisterParams, client models.ClientInfo) (*dto.RegisterOutput, error)
+	ConfirmRegistrationByCode(ctx context.Context, email string, code string, client models.ClientInfo) (*dto.LoginOutput, error)
+	ConfirmRegistrationByLink(ctx context.Context, token string, client models.ClientInfo) (*dto.LoginOutput, error)
+	SendResetPasswordCode(ctx context.Context, email string, client models.ClientInfo) error
+	CheckResetPasswordCode(ctx context.Context, email string, code string, client models.ClientInfo) error
+	ConfirmResetPasswordByCode(ctx context.Context, email string, code string, password string, client models.ClientInfo) error
+	ConfirmResetPasswordByLink(ctx context.Context, token string, password string, client models.ClientInfo) error
+	SendLoginLink(ctx context.Context, email string, client models.ClientInfo) error
+	LoginByLink(ctx context.Context, token string, client models.ClientInfo) (*dto.LoginOutput, error)
+	LoginByEmail(ctx context.Context, email string, password string, client models.ClientInfo) (*dto.LoginOutput, error)
+	LoginByUsername(ctx context.Context, username string, password string, client models.ClientInfo) (*dto.LoginOutput, error)
+	RefreshSession(
+		ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, sessionToken string, client models.ClientInfo,
+	) (*dto.LoginOutput, error)
+	ListSessions(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) ([]dto.SessionOutput, error)
+	RevokeSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, client models.ClientInfo) error
+	RevokeOtherSessions(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID, client models.ClientInfo) error
+	RevokeAllSessions(ctx context.Context, userID uuid.UUID, client models.ClientInfo) error
+	RenameSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, deviceName string) error
+	RevokeSessionByLink(ctx context.Context, token string, client models.ClientInfo) error
+	RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, password string) error
+	ConfirmEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, code string, client models.ClientInfo) (*models.User, error)
+
+	VerifyMFALogin(ctx context.Context, challengeToken, code string, client models.ClientInfo) (*dto.LoginOutput, error)
+	BeginTOTPEnrollment(ctx context.Context, userID uuid.UUID, password string) (*dto.TOTPEnrollmentOutput, error)
+	ConfirmTOTPEnrollment(ctx context.Context, userID uuid.UUID, code string, client models.ClientInfo) (*dto.RecoveryCodesOutput, error)
+	DisableTOTP(ctx context.Context, userID uuid.UUID, password string, client models.ClientInfo) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, password string, client models.ClientInfo) (*dto.RecoveryCodesOutput, error)
 }
 
 type UserService interface {
 	GetUserInfoByID(ctx context.Context, userID uuid.UUID) (*models.User, error)
-	DeleteUserByID(ctx context.Context, userID uuid.UUID) error
-	UpdateUser(ctx context.Context, userID uuid.UUID, params dto.UpdateUsersInput) error
+	UpdateUser(ctx context.Context, userID uuid.UUID, params dto.UpdateUsersInput) (*models.User, error)
+	ChangePassword(ctx context.Context, userID uuid.UUID, params dto.ChangePasswordInput, client models.ClientInfo) error
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.GetUserByIDOutput, error)
 	GetCurrentUserByID(ctx context.Context, userID uuid.UUID) (*dto.GetCurrentUser, error)
+	GetUserByUsername(ctx context.Context, username string) (*dto.GetUserByUsernameOutput, error)
 	GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]dto.GetUserByIDOutput, error)
 	GetUsersInfoByID(ctx context.Context, userIDs []uuid.UUID) ([]models.User, error)
 	GetUsers(ctx context.Context, params dto.GetUsersParams) (*dto.GetUsersOutput, error)
@@ -36,3 +57,60 @@ type AvatarService interface {
 	UploadUserAvatar(ctx context.Context, params dto.UploadUserAvatar) error
 	DeleteProfileAvatar(ctx context.Context, userID uuid.UUID) error
 }
+
+type AdminService interface {
+	SearchUsers(ctx context.Context, adminID uuid.UUID, params dto.GetUsersParams) (*dto.GetUsersOutput, error)
+	GetUser(ctx context.Context, adminID uuid.UUID, userID uuid.UUID) (*dto.AdminUserOutput, error)
+	SuspendUser(ctx context.Context, adminID uuid.UUID, params dto.SuspendUserInput) error
+	BanUser(ctx context.Context, adminID uuid.UUID, userID uuid.UUID, reason string) error
+	UnbanUser(ctx context.Context, adminID uuid.UUID, userID uuid.UUID) error
+	SetUserAdmin(ctx context.Context, adminID uuid.UUID, userID uuid.UUID, isAdmin bool) error
+	DeleteUser(ctx context.Context, adminID uuid.UUID, userID uuid.UUID) (*models.AccountDeletion, error)
+	GetUserDeletion(ctx context.Context, adminID uuid.UUID, userID uuid.UUID) (*dto.AccountDeletionOutput, error)
+	SearchSecurityEvents(ctx context.Context, adminID uuid.UUID, filter dto.SecurityEventsFilter) ([]models.SecurityEvent, error)
+}
+
+type AccountDeletionService interface {
+	RequestAccountDeletion(ctx context.Context, userID uuid.UUID, password string) (*models.AccountDeletion, error)
+	CancelAccountDeletion(ctx context.Context, userID uuid.UUID) error
+	GetAccountDeletion(ctx context.Context, userID uuid.UUID) (*dto.AccountDeletionOutput, error)
+}
+
+type OIDCService interface {
+	GetProviders() []string
+	StartAuthorization(ctx context.Context, provider string, client models.ClientInfo) (string, error)
+	CompleteAuthorization(ctx context.Context, provider, code, state string, client models.ClientInfo) (*dto.OIDCLoginOutput, error)
+	ConfirmIdentityLink(ctx context.Context, token string, client models.ClientInfo) (*dto.LoginOutput, error)
+	GetUserIdentities(ctx context.Context, userID uuid.UUID) ([]models.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID uuid.UUID, provider, subject string, client models.ClientInfo) error
+}
+
+type ServiceClientsService interface {
+	AuthenticateServiceClient(ctx context.Context, clientID, clientSecret string, scopes []string) (*models.ServiceClient, error)
+}
+
+type SecurityEventsService interface {
+	GetUserSecurityEvents(ctx context.Context, userID uuid.UUID, limit, offset int) ([]models.SecurityEvent, error)
+}
+
+type PersonalTokensService interface {
+	CreatePersonalToken(
+		ctx context.Context, userID uuid.UUID, params dto.CreatePersonalTokenInput, client models.ClientInfo,
+	) (*dto.CreatePersonalTokenOutput, error)
+	GetPersonalTokens(ctx context.Context, userID uuid.UUID) ([]models.PersonalToken, error)
+	RevokePersonalToken(ctx context.Context, userID, tokenID uuid.UUID, client models.ClientInfo) error
+	ValidatePersonalToken(ctx context.Context, token string) (*models.PersonalToken, error)
+}
+
+type DataExportsService interface {
+	RequestDataExport(ctx context.Context, userID uuid.UUID, client models.ClientInfo) (*models.DataExport, error)
+	GetDataExport(ctx context.Context, userID uuid.UUID) (*dto.DataExportOutput, error)
+}
+
+type FollowsService interface {
+	Follow(ctx context.Context, followerID, followeeID uuid.UUID) error
+	Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error
+	GetFollowStatus(ctx context.Context, userID, otherID uuid.UUID) (models.FollowStatus, error)
+	GetFollowers(ctx context.Context, userID uuid.UUID, page dto.FollowsPage) (*dto.FollowsOutput, error)
+	GetFollowing(ctx context.Context, userID uuid.UUID, page dto.FollowsPage) (*dto.FollowsOutput, error)
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	v1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/middleware"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (a AuthHandler) ListSessions(ctx context.Context, _ *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	token := authmiddleware.GetUserInfo(ctx)
+
+	sessions, err := a.authService.ListSessions(ctx, token.UserID, token.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+
+	resp := &v1.ListSessionsResponse{
+		Sessions: make([]*v1.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, sessionPB(session))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (a AuthHandler) RevokeSession(ctx context.Context, c *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	sessionID, err := uuid.Parse(c.Msg.SessionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse session id: %w", err))
+	}
+
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	err = a.authService.RevokeSession(ctx, userID, sessionID, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("revoke session %s: %w", sessionID, err)
+	}
+
+	return connect.NewResponse(&v1.RevokeSessionResponse{}), nil
+}
+
+func (a AuthHandler) RevokeOtherSessions(ctx context.Context, _ *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error) {
+	token := authmiddleware.GetUserInfo(ctx)
+
+	err := a.authService.RevokeOtherSessions(ctx, token.UserID, token.SessionID, middleware.GetClientInfo(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("revoke other sessions: %w", err)
+	}
+
+	return connect.NewResponse(&v1.RevokeOtherSessionsResponse{}), nil
+}
+
+func (a AuthHandler) RenameSession(ctx context.Context, c *connect.Request[v1.RenameSessionRequest]) (*connect.Response[v1.RenameSessionResponse], error) {
+	sessionID, err := uuid.Parse(c.Msg.SessionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse session id: %w", err))
+	}
+
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	err = a.authService.RenameSession(ctx, userID, sessionID, c.Msg.DeviceName)
+	if err != nil {
+		return nil, fmt.Errorf("rename session %s: %w", sessionID, err)
+	}
+
+	return connect.NewResponse(&v1.RenameSessionResponse{}), nil
+}
+
+func sessionPB(s dto.SessionOutput) *v1.Session {
+	return &v1.Session{
+		Id:          s.ID.String(),
+		DeviceName:  s.Client.DeviceName,
+		Ip:          s.Client.IP,
+		UserAgent:   s.Client.UserAgent,
+		Location:    s.Client.Location,
+		CreatedAt:   timestamppb.New(s.CreatedAt),
+		RefreshedAt: timestamppb.New(s.RefreshedAt),
+		ExpiresAt:   timestamppb.New(s.ExpiresAt),
+		Current:     s.Current,
+	}
+}
@@ -31,13 +31,56 @@ func (a UserHandler) GetMe(ctx context.Context, c *connect.Request[v1.GetMeReque
 	}
 
 	return connect.NewResponse(&v1.GetUserResponse{
-		User: userPB(*user.User),
+		User: currentUserPB(*user.User),
 	}), nil
 }
 
 func (a UserHandler) UpdateUser(ctx context.Context, c *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.User], error) {
-	// TODO implement me
-	panic("implement me")
+	userID := authmiddleware.GetUserInfo(ctx).UserID
+
+	if c.Msg.User.GetId() != userID.String() {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only current user can be updated"))
+	}
+
+	paths := c.Msg.UpdateMask.GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"name", "username", "description"}
+	}
+
+	var params dto.UpdateUsersInput
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if c.Msg.User.Name == nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+			}
+			params.Name = &c.Msg.User.Name.Value
+		case "username":
+			if c.Msg.User.Username == nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("username is required"))
+			}
+			params.Username = &c.Msg.User.Username.Value
+		case "description":
+			params.Description = &c.Msg.User.Description
+		case "locale":
+			// empty locale resets it to the default one
+			locale := c.Msg.User.GetLocale()
+			params.Locale = &locale
+		case "notify_new_devices":
+			notifyNewDevices := c.Msg.User.GetNotifyNewDevices()
+			params.NotifyNewDevices = &notifyNewDevices
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("field '%s' can not be updated", path))
+		}
+	}
+
+	user, err := a.userService.UpdateUser(ctx, userID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(currentUserPB(*user)), nil
 }
 
 func (a UserHandler) GetUser(ctx context.Context, c *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
@@ -56,6 +99,20 @@ func (a UserHandler) GetUser(ctx context.Context, c *connect.Request[v1.GetUserR
 	}), nil
 }
 
+func (a UserHandler) GetUserByUsername(
+	ctx context.Context, c *connect.Request[v1.GetUserByUsernameRequest],
+) (*connect.Response[v1.GetUserByUsernameResponse], error) {
+	out, err := a.userService.GetUserByUsername(ctx, c.Msg.Username.Value)
+	if err != nil {
+		return nil, fmt.Errorf("get user by username %s: %w", c.Msg.Username.Value, err)
+	}
+
+	return connect.NewResponse(&v1.GetUserByUsernameResponse{
+		User:    userPB(*out.User),
+		Renamed: out.Renamed,
+	}), nil
+}
+
 func (a UserHandler) UploadAvatar(ctx context.Context, c *connect.Request[v1.UploadUserAvatarRequest]) (*connect.Response[v1.UploadUserAvatarResponse], error) {
 	token := authmiddleware.GetUserInfo(ctx)
 
@@ -73,12 +130,20 @@ func (a UserHandler) UploadAvatar(ctx context.Context, c *connect.Request[v1.Upl
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("content type %s is not supported", contentType))
 	}
 
-	err := a.avatarService.UploadUserAvatar(ctx, dto.UploadUserAvatar{
-		Data:        c.Msg.Content,
-		UserID:      token.UserID,
-		ImageSize:   int64(len(c.Msg.Content)),
-		ContentType: contentType,
-	})
+	params := dto.UploadUserAvatar{
+		Data:   c.Msg.Content,
+		UserID: token.UserID,
+	}
+	if crop := c.Msg.Crop; crop != nil {
+		params.Crop = &dto.AvatarCrop{
+			X:      int(crop.X),
+			Y:      int(crop.Y),
+			Width:  int(crop.Width),
+			Height: int(crop.Height),
+		}
+	}
+
+	err := a.avatarService.UploadUserAvatar(ctx, params)
 	if err != nil {
 		return nil, err
 	}
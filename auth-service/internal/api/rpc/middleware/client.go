@@ -0,0 +1,250 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"golang.org/x/text/language"
+)
+
+const (
+	DeviceNameHeader = "X-Device-Name"
+	DeviceIDHeader   = "X-Device-ID"
+
+	maxUserAgentLength  = 512
+	maxDeviceNameLength = 64
+	maxDeviceIDLength   = 128
+	maxLocationLength   = 128
+
+	linkNonceMaxAge = 30 * 24 * time.Hour
+	deviceIDMaxAge  = 365 * 24 * time.Hour
+)
+
+type (
+	clientInfoCtxKey   struct{}
+	trustedProxyCtxKey struct{}
+)
+
+// RealIP replaces the remote address of the request with the address of the client.
+// ProxyHeader is read only when the request comes from a trusted proxy.
+func RealIP(cfg *config.Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isTrustedProxy(remoteIP(r), cfg.Server.TrustedProxyPrefixes) {
+				r = r.WithContext(context.WithValue(r.Context(), trustedProxyCtxKey{}, true))
+			}
+
+			r.RemoteAddr = clientIP(r, cfg.Server.ProxyHeader, cfg.Server.TrustedProxyPrefixes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ClientInfo stores information about the client device in the request context.
+// LocationHeader is set by the proxy too, so it is read only when RealIP trusted the peer.
+func ClientInfo(cfg *config.Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userAgent := truncate(r.UserAgent(), maxUserAgentLength)
+
+			deviceName := truncate(strings.TrimSpace(r.Header.Get(DeviceNameHeader)), maxDeviceNameLength)
+			if deviceName == "" {
+				deviceName = DeviceNameFromUserAgent(userAgent)
+			}
+
+			var location string
+			if fromTrustedProxy(r.Context()) {
+				location = truncate(r.Header.Get(cfg.Server.LocationHeader), maxLocationLength)
+			}
+
+			info := models.ClientInfo{
+				IP:         remoteIP(r),
+				UserAgent:  userAgent,
+				Location:   location,
+				DeviceName: deviceName,
+				Locale:     preferredLocale(r.Header.Get("Accept-Language")),
+				LinkNonce:  linkNonce(w, r, cfg),
+				DeviceID:   deviceID(w, r, cfg),
+			}
+
+			ctx := context.WithValue(r.Context(), clientInfoCtxKey{}, info)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func GetClientInfo(ctx context.Context) models.ClientInfo {
+	info, _ := ctx.Value(clientInfoCtxKey{}).(models.ClientInfo)
+	return info
+}
+
+func fromTrustedProxy(ctx context.Context) bool {
+	trusted, _ := ctx.Value(trustedProxyCtxKey{}).(bool)
+	return trusted
+}
+
+// linkNonce returns the nonce from the cookie. The cookie is set if the browser has not got it yet.
+func linkNonce(w http.ResponseWriter, r *http.Request, cfg *config.Config) string {
+	if cookie, err := r.Cookie(cfg.Links.NonceCookie); err == nil && cookie.Value != "" {
+		return cookie.Value
+	}
+
+	nonce := rand.Text()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cfg.Links.NonceCookie,
+		Value:    nonce,
+		Path:     "/",
+		MaxAge:   int(linkNonceMaxAge.Seconds()),
+		Secure:   strings.HasPrefix(cfg.ApplicationURL, "https://"),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return nonce
+}
+
+// deviceID returns the identifier provided by the client or taken from the cookie.
+// The cookie is set if neither of them is present.
+func deviceID(w http.ResponseWriter, r *http.Request, cfg *config.Config) string {
+	if value := strings.TrimSpace(r.Header.Get(DeviceIDHeader)); value != "" {
+		return truncate(value, maxDeviceIDLength)
+	}
+
+	if cookie, err := r.Cookie(cfg.Devices.Cookie); err == nil && cookie.Value != "" {
+		return truncate(cookie.Value, maxDeviceIDLength)
+	}
+
+	id := rand.Text()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cfg.Devices.Cookie,
+		Value:    id,
+		Path:     "/",
+		MaxAge:   int(deviceIDMaxAge.Seconds()),
+		Secure:   strings.HasPrefix(cfg.ApplicationURL, "https://"),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return id
+}
+
+// preferredLocale returns empty string if the header is missing or malformed.
+func preferredLocale(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return ""
+	}
+
+	return tags[0].String()
+}
+
+// clientIP returns the right-most address of the proxy header which is not a trusted proxy.
+// The client controls the beginning of X-Forwarded-For, so only the hops appended by
+// the trusted proxies can be relied on.
+func clientIP(r *http.Request, proxyHeader string, trusted []netip.Prefix) string {
+	ip := remoteIP(r)
+	if proxyHeader == "" || !isTrustedProxy(ip, trusted) {
+		return ip
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values(proxyHeader), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			// the chain is broken, the hops before it can not be trusted
+			return ip
+		}
+
+		ip = hop
+		if !isTrustedProxy(ip, trusted) {
+			return ip
+		}
+	}
+
+	return ip
+}
+
+func isTrustedProxy(ip string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	return slices.ContainsFunc(trusted, func(prefix netip.Prefix) bool {
+		return prefix.Contains(addr)
+	})
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// DeviceNameFromUserAgent builds human-readable device name, e.g. "Firefox on Windows".
+func DeviceNameFromUserAgent(userAgent string) string {
+	var (
+		browser = "Unknown browser"
+		system  = "unknown device"
+	)
+
+	browsers := []struct{ token, name string }{
+		// order matters: Edge and Opera user agents also contain Chrome, Chrome contains Safari
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	for _, b := range browsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+
+	systems := []struct{ token, name string }{
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	}
+	for _, s := range systems {
+		if strings.Contains(userAgent, s.token) {
+			system = s.name
+			break
+		}
+	}
+
+	return browser + " on " + system
+}
+
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+
+	s = s[:maxLength]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
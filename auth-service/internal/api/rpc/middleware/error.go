@@ -11,6 +11,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
 	"github.com/tech-inspire/backend/auth-service/internal/apperrors/codes"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
 	"github.com/tech-inspire/backend/auth-service/pkg/logger"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
@@ -22,14 +23,47 @@ func init() {
 		connect.CodeFailedPrecondition: {
 			codes.EmailUsed,
 			codes.UsernameUsed,
+			codes.UsernameReserved,
 			codes.ConfirmationCodeNotFound,
 			codes.ResetPasswordCodeNotFound,
+			codes.EmailChangeCodeNotFound,
+			codes.LinkNotFound,
 			codes.SessionExpired,
+			codes.MFARequired,
+			codes.MFAAlreadyEnabled,
+			codes.MFANotEnabled,
+			codes.TOTPEnrollmentNotFound,
+			codes.UserNotSuspended,
+			codes.AdminSelfAction,
+			codes.AccountDeletionPending,
+			codes.AccountDeletionNotFound,
+			codes.OIDCEmailNotVerified,
+			codes.IdentityAlreadyLinked,
+			codes.IdentityNotFound,
+			codes.LastLoginMethod,
+			codes.PersonalTokensLimit,
+			codes.UserNotFollowable,
 		},
 		connect.CodeUnauthenticated: {
 			codes.Unauthorized,
+			codes.RefreshTokenReused,
+			codes.MFAChallengeNotFound,
+			codes.InvalidMFACode,
+			codes.OIDCAuthorizationNotFound,
+			codes.InvalidClientCredentials,
 		},
-		connect.CodePermissionDenied: {codes.Forbidden},
+		connect.CodePermissionDenied: {codes.Forbidden, codes.UserSuspended, codes.UserBanned, codes.ScopeNotAllowed},
+		connect.CodeInvalidArgument: {
+			codes.InvalidDeviceName,
+			codes.InvalidUserField,
+			codes.InvalidAvatar,
+			codes.InvalidAvatarSize,
+			codes.InvalidAvatarCrop,
+			codes.SelfFollow,
+			codes.InvalidCursor,
+		},
+		connect.CodeNotFound:          {codes.OIDCProviderNotFound, codes.PersonalTokenNotFound, codes.DataExportNotFound},
+		connect.CodeResourceExhausted: {codes.TooManyAttempts, codes.DataExportLimit},
 	}
 
 	for k, v := range predefinedCodes {
@@ -52,12 +86,22 @@ func ErrorInterceptor(l *logger.Logger, serviceName string) connect.UnaryInterce
 				return nil, err
 			}
 
+			// denied by the policy of the procedure
+			deniedErr := new(authmiddleware.PermissionDeniedError)
+			if errors.As(err, &deniedErr) {
+				return nil, errorWithInfo(connect.CodePermissionDenied, err, &errdetails.ErrorInfo{
+					Reason:   deniedErr.Reason,
+					Domain:   serviceName,
+					Metadata: deniedErr.Metadata(),
+				})
+			}
+
 			appError := new(apperrors.Error)
 			if errors.As(err, &appError) {
 				info := &errdetails.ErrorInfo{
 					Reason:   string(appError.Code),
 					Domain:   serviceName,
-					Metadata: nil,
+					Metadata: appError.Metadata,
 				}
 
 				code, ok := errorCodes[appError.Code]
@@ -65,12 +109,7 @@ func ErrorInterceptor(l *logger.Logger, serviceName string) connect.UnaryInterce
 					code = connect.CodeAborted
 				}
 
-				connectErr = connect.NewError(code, err)
-				if detail, detailErr := connect.NewErrorDetail(info); detailErr == nil {
-					connectErr.AddDetail(detail)
-				}
-
-				return nil, connectErr
+				return nil, errorWithInfo(code, err, info)
 			}
 
 			//
@@ -100,3 +139,12 @@ func ErrorInterceptor(l *logger.Logger, serviceName string) connect.UnaryInterce
 
 	return interceptor
 }
+
+func errorWithInfo(code connect.Code, err error, info *errdetails.ErrorInfo) *connect.Error {
+	connectErr := connect.NewError(code, err)
+	if detail, detailErr := connect.NewErrorDetail(info); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+
+	return connectErr
+}
@@ -0,0 +1,101 @@
+package rpc
+
+import (
+	"github.com/tech-inspire/api-contracts/api/gen/go/auth/v1/authv1connect"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+// policy covers the auth, admin and internal services. RegisterRoutes refuses to start
+// while any of their procedures has no rule.
+var policy = authmiddleware.Policy{
+	authv1connect.AuthServiceLoginProcedure:        authmiddleware.Public(),
+	authv1connect.AuthServiceRegisterProcedure:     authmiddleware.Public(),
+	authv1connect.AuthServiceConfirmEmailProcedure: authmiddleware.Public(),
+	authv1connect.AuthServiceRefreshTokenProcedure: authmiddleware.Public(),
+	authv1connect.AuthServiceGetUserProcedure:      authmiddleware.Public(),
+
+	// the previous usernames are resolved too, like the links to the profiles
+	authv1connect.AuthServiceGetUserByUsernameProcedure: authmiddleware.Public(),
+
+	// the challenge token issued by Login authenticates the second step
+	authv1connect.AuthServiceVerifyMFALoginProcedure: authmiddleware.Public(),
+
+	// the password is reset by the users who can not sign in
+	authv1connect.AuthServiceResetPasswordProcedure:              authmiddleware.Public(),
+	authv1connect.AuthServiceConfirmPasswordResetProcedure:       authmiddleware.Public(),
+	authv1connect.AuthServiceCheckPasswordResetCodeProcedure:     authmiddleware.Public(),
+	authv1connect.AuthServiceConfirmPasswordResetByLinkProcedure: authmiddleware.Public(),
+
+	// the token of the link sent by email authenticates the request
+	authv1connect.AuthServiceConfirmEmailByLinkProcedure:  authmiddleware.Public(),
+	authv1connect.AuthServiceSendLoginLinkProcedure:       authmiddleware.Public(),
+	authv1connect.AuthServiceLoginByLinkProcedure:         authmiddleware.Public(),
+	authv1connect.AuthServiceRevokeSessionByLinkProcedure: authmiddleware.Public(),
+
+	// the sign in with a provider starts before the user has a session, the state of
+	// the authorization and the token of the link sent by email authenticate the callback
+	authv1connect.AuthServiceListOIDCProvidersProcedure:   authmiddleware.Public(),
+	authv1connect.AuthServiceStartOIDCLoginProcedure:      authmiddleware.Public(),
+	authv1connect.AuthServiceCompleteOIDCLoginProcedure:   authmiddleware.Public(),
+	authv1connect.AuthServiceConfirmIdentityLinkProcedure: authmiddleware.Public(),
+
+	// the client credentials authenticate the request
+	authv1connect.AuthServiceIssueServiceTokenProcedure: authmiddleware.Public(),
+
+	authv1connect.AuthServiceLogoutProcedure:       authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceGetMeProcedure:        authmiddleware.Roles(authmiddleware.RoleUser).WithScopes("profile:read"),
+	authv1connect.AuthServiceUpdateUserProcedure:   authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceUploadAvatarProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+
+	authv1connect.AuthServiceChangePasswordProcedure:     authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceRequestEmailChangeProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceConfirmEmailChangeProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+
+	// the users pending deletion can sign in to cancel it
+	authv1connect.AuthServiceRequestAccountDeletionProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceCancelAccountDeletionProcedure:  authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceGetAccountDeletionProcedure:     authmiddleware.Roles(authmiddleware.RoleUser),
+
+	authv1connect.AuthServiceListSessionsProcedure:        authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceRevokeSessionProcedure:       authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceRevokeOtherSessionsProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceRenameSessionProcedure:       authmiddleware.Roles(authmiddleware.RoleUser),
+
+	authv1connect.AuthServiceBeginTOTPEnrollmentProcedure:     authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceConfirmTOTPEnrollmentProcedure:   authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceDisableTOTPProcedure:             authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceRegenerateRecoveryCodesProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+
+	authv1connect.AuthServiceListIdentitiesProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceUnlinkIdentityProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+
+	authv1connect.AuthServiceListSecurityEventsProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+
+	// the personal tokens can not manage the personal tokens
+	authv1connect.AuthServiceCreatePersonalTokenProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceListPersonalTokensProcedure:  authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceRevokePersonalTokenProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+
+	authv1connect.AuthServiceRequestDataExportProcedure: authmiddleware.Roles(authmiddleware.RoleUser),
+	authv1connect.AuthServiceGetDataExportProcedure:     authmiddleware.Roles(authmiddleware.RoleUser),
+
+	authv1connect.AuthServiceFollowProcedure:          authmiddleware.Roles(authmiddleware.RoleUser).WithScopes("follows:write"),
+	authv1connect.AuthServiceUnfollowProcedure:        authmiddleware.Roles(authmiddleware.RoleUser).WithScopes("follows:write"),
+	authv1connect.AuthServiceGetFollowStatusProcedure: authmiddleware.Roles(authmiddleware.RoleUser).WithScopes("follows:read"),
+	authv1connect.AuthServiceListFollowersProcedure:   authmiddleware.Public(),
+	authv1connect.AuthServiceListFollowingProcedure:   authmiddleware.Public(),
+
+	authv1connect.AdminServiceSearchUsersProcedure:     authmiddleware.Roles(authmiddleware.RoleAdmin),
+	authv1connect.AdminServiceGetUserDetailsProcedure:  authmiddleware.Roles(authmiddleware.RoleAdmin),
+	authv1connect.AdminServiceSuspendUserProcedure:     authmiddleware.Roles(authmiddleware.RoleAdmin),
+	authv1connect.AdminServiceBanUserProcedure:         authmiddleware.Roles(authmiddleware.RoleAdmin),
+	authv1connect.AdminServiceUnbanUserProcedure:       authmiddleware.Roles(authmiddleware.RoleAdmin),
+	authv1connect.AdminServiceSetUserAdminProcedure:    authmiddleware.Roles(authmiddleware.RoleAdmin),
+	authv1connect.AdminServiceDeleteUserProcedure:      authmiddleware.Roles(authmiddleware.RoleAdmin),
+	authv1connect.AdminServiceGetUserDeletionProcedure: authmiddleware.Roles(authmiddleware.RoleAdmin),
+
+	authv1connect.AdminServiceSearchSecurityEventsProcedure: authmiddleware.Roles(authmiddleware.RoleAdmin),
+
+	// the internal procedures are called by the other services only, with the service tokens
+	authv1connect.AuthInternalServiceValidatePersonalTokenProcedure: authmiddleware.Service("personal_tokens:validate"),
+}
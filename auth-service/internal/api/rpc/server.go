@@ -16,6 +16,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/cors"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
 	"github.com/tech-inspire/api-contracts/api/gen/go/auth/v1/authv1connect"
 	"github.com/tech-inspire/backend/auth-service/internal/api/jwt"
 	"github.com/tech-inspire/backend/auth-service/internal/api/metrics"
@@ -24,22 +25,26 @@ import (
 	"github.com/tech-inspire/backend/auth-service/internal/config"
 	authjwt "github.com/tech-inspire/backend/auth-service/pkg/jwt"
 	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
 	"github.com/tech-inspire/backend/auth-service/pkg/logger"
 	"go.uber.org/fx"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func CORSMiddleware(cfg *config.Config) func(http.Handler) http.Handler {
 	allowedHeaders := connectcors.AllowedHeaders()
-	allowedHeaders = append(allowedHeaders, "Authorization")
+	allowedHeaders = append(allowedHeaders, "Authorization", middleware.DeviceNameHeader, middleware.DeviceIDHeader)
 
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins: cfg.Server.CORSAllowedOrigins,
 		AllowedMethods: connectcors.AllowedMethods(),
 		AllowedHeaders: allowedHeaders,
 		ExposedHeaders: connectcors.ExposedHeaders(),
-		Debug:          cfg.Server.DebugCORS,
+		// the nonce cookie binds the links sent by email to the browser
+		AllowCredentials: true,
+		Debug:            cfg.Server.DebugCORS,
 	})
 	return corsMiddleware.Handler
 }
@@ -51,9 +56,22 @@ type Params struct {
 
 	JwtSigner    *jwt.Signer
 	JwtValidator *authjwt.Validator
+	Revocations  *revocation.List
 
-	AuthHandler *handlers.AuthHandler
-	UserHandler *handlers.UserHandler
+	AuthHandler  *handlers.AuthHandler
+	UserHandler  *handlers.UserHandler
+	OIDCHandler  *handlers.OIDCHandler
+	AdminHandler *handlers.AdminHandler
+
+	ServiceClientsHandler *handlers.ServiceClientsHandler
+	SecurityEventsHandler *handlers.SecurityEventsHandler
+	PersonalTokensHandler *handlers.PersonalTokensHandler
+	DataExportsHandler    *handlers.DataExportsHandler
+	FollowsHandler        *handlers.FollowsHandler
+
+	AccountDeletionHandler *handlers.AccountDeletionHandler
+
+	PersonalTokenValidator *handlers.PersonalTokenValidator
 }
 
 func RegisterRoutes(params Params, r *chi.Mux) error {
@@ -62,39 +80,75 @@ func RegisterRoutes(params Params, r *chi.Mux) error {
 		return fmt.Errorf("protovalidate: create interceptor: %w", err)
 	}
 
+	for _, file := range []protoreflect.FileDescriptor{
+		authv1.File_auth_v1_auth_proto,
+		authv1.File_auth_v1_admin_proto,
+		authv1.File_auth_v1_internal_proto,
+	} {
+		if err = policy.CheckServiceDescriptor(file); err != nil {
+			return fmt.Errorf("policy: %w", err)
+		}
+	}
+
 	type authService struct {
 		*handlers.AuthHandler
 		*handlers.UserHandler
+		*handlers.OIDCHandler
+		*handlers.ServiceClientsHandler
+		*handlers.SecurityEventsHandler
+		*handlers.PersonalTokensHandler
+		*handlers.DataExportsHandler
+		*handlers.FollowsHandler
+		*handlers.AccountDeletionHandler
 	}
 
 	authServicePath, authServiceHandler := authv1connect.NewAuthServiceHandler(
 		authService{
-			params.AuthHandler, params.UserHandler,
+			params.AuthHandler, params.UserHandler, params.OIDCHandler,
+			params.ServiceClientsHandler, params.SecurityEventsHandler, params.PersonalTokensHandler,
+			params.DataExportsHandler, params.FollowsHandler, params.AccountDeletionHandler,
 		},
 		connect.WithInterceptors(
 			middleware.ErrorInterceptor(params.Logger, authv1connect.AuthServiceName),
+			policy.Interceptor(),
 			validateInterceptor,
 		),
 	)
 
-	// without auth
-	noAuthenticationProcedures := []string{
-		authv1connect.AuthServiceLoginProcedure,
-		authv1connect.AuthServiceRegisterProcedure,
-		authv1connect.AuthServiceConfirmEmailProcedure,
-		authv1connect.AuthServiceGetUserProcedure,
-		authv1connect.AuthServiceRefreshTokenProcedure,
-	}
+	adminServicePath, adminServiceHandler := authv1connect.NewAdminServiceHandler(
+		params.AdminHandler,
+		connect.WithInterceptors(
+			middleware.ErrorInterceptor(params.Logger, authv1connect.AdminServiceName),
+			policy.Interceptor(),
+			validateInterceptor,
+		),
+	)
+
+	// the internal service is served on its own path, so that it can be kept off the public gateway
+	internalServicePath, internalServiceHandler := authv1connect.NewAuthInternalServiceHandler(
+		params.PersonalTokensHandler,
+		connect.WithInterceptors(
+			middleware.ErrorInterceptor(params.Logger, authv1connect.AuthInternalServiceName),
+			policy.Interceptor(),
+			validateInterceptor,
+		),
+	)
 
 	authMiddleware := authn.NewMiddleware(
-		authmiddleware.New(params.JwtValidator, noAuthenticationProcedures),
+		authmiddleware.New(params.JwtValidator, nil,
+			authmiddleware.WithPolicy(policy),
+			authmiddleware.WithRevocationList(params.Revocations),
+			authmiddleware.WithPersonalTokens(params.PersonalTokenValidator),
+		),
 	)
 
-	reflector := grpcreflect.NewStaticReflector(authv1connect.AuthServiceName)
+	reflector := grpcreflect.NewStaticReflector(authv1connect.AuthServiceName, authv1connect.AdminServiceName)
 	r.Mount(grpcreflect.NewHandlerV1(reflector))
 	r.Mount(grpcreflect.NewHandlerV1Alpha(reflector))
 
 	r.Mount(authServicePath, authMiddleware.Wrap(authServiceHandler))
+	r.Mount(adminServicePath, authMiddleware.Wrap(adminServiceHandler))
+	r.Mount(internalServicePath, authMiddleware.Wrap(internalServiceHandler))
 
 	r.HandleFunc("/auth/.well-known/jwks.json", func(writer http.ResponseWriter, request *http.Request) {
 		data, err := params.JwtSigner.PublicUsersJWKS()
@@ -114,10 +168,11 @@ func NewServer(lc fx.Lifecycle, cfg *config.Config) (*chi.Mux, error) {
 
 	r.Use(metrics.RecordMiddleware)
 	r.Use(chimiddleware.RequestID)
-	r.Use(chimiddleware.RealIP)
+	r.Use(middleware.RealIP(cfg))
 	r.Use(chimiddleware.Logger)
 	r.Use(chimiddleware.Recoverer)
 	r.Use(CORSMiddleware(cfg))
+	r.Use(middleware.ClientInfo(cfg))
 
 	srv := &http.Server{
 		Handler: h2c.NewHandler(r, new(http2.Server)),
@@ -14,16 +14,23 @@ import (
 	"github.com/tech-inspire/backend/auth-service/internal/api/rpc/handlers"
 	"github.com/tech-inspire/backend/auth-service/internal/clients"
 	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/oidc"
 	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/consumer"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/nats"
 	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres"
 	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
 	"github.com/tech-inspire/backend/auth-service/internal/repository/redis"
 	avatarstorage "github.com/tech-inspire/backend/auth-service/internal/repository/s3"
 	"github.com/tech-inspire/backend/auth-service/internal/service"
+	"github.com/tech-inspire/backend/auth-service/internal/worker"
 	"github.com/tech-inspire/backend/auth-service/migrations"
 	"github.com/tech-inspire/backend/auth-service/pkg/generator"
 	authjwt "github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
+	"github.com/tech-inspire/backend/auth-service/pkg/linktoken"
 	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"github.com/tech-inspire/backend/auth-service/pkg/password"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
 )
@@ -50,27 +57,86 @@ func Run() {
 		),
 
 		fx.Provide(
-			fx.Annotate(mail.NewClient, fx.As(new(service.MailClient))),
+			fx.Annotate(mail.NewTransport, fx.As(new(service.MailTransport))),
+			func(cfg *config.Config) (*mail.Renderer, error) {
+				return mail.NewRenderer(cfg.Mail.DefaultLocale)
+			},
 		),
 
 		fx.Provide(
 			fx.Annotate(postgres.NewUserRepository, fx.As(new(service.UserRepository))),
+			fx.Annotate(postgres.NewMFARepository, fx.As(new(service.MFARepository))),
+			fx.Annotate(postgres.NewSuspensionsRepository, fx.As(new(service.SuspensionsRepository))),
+			fx.Annotate(postgres.NewAccountDeletionsRepository, fx.As(new(service.AccountDeletionsRepository))),
+			fx.Annotate(postgres.NewOutboxRepository, fx.As(new(service.UserEventsOutbox))),
+			fx.Annotate(postgres.NewMailQueueRepository, fx.As(new(service.MailQueueRepository))),
+			fx.Annotate(postgres.NewIdentitiesRepository, fx.As(new(service.IdentitiesRepository))),
+			fx.Annotate(postgres.NewSecurityEventsRepository, fx.As(new(service.SecurityEventsRepository))),
+			fx.Annotate(postgres.NewDevicesRepository, fx.As(new(service.DevicesRepository))),
+			fx.Annotate(postgres.NewPersonalTokensRepository, fx.As(new(service.PersonalTokensRepository))),
+			fx.Annotate(postgres.NewDataExportsRepository, fx.As(new(service.DataExportsRepository))),
+			fx.Annotate(postgres.NewFollowsRepository, fx.As(new(service.FollowsRepository))),
 
 			fx.Annotate(redis.NewSessionRepository, fx.As(new(service.SessionRepository))),
 			fx.Annotate(redis.NewCodesRepository, fx.As(new(service.ConfirmationCodesRepository))),
 			fx.Annotate(redis.NewResetCodesRepository, fx.As(new(service.ResetPasswordCodesRepository))),
+			fx.Annotate(redis.NewEmailChangeCodesRepository, fx.As(new(service.EmailChangeCodesRepository))),
+			fx.Annotate(redis.NewMFAChallengesRepository, fx.As(new(service.MFAChallengesRepository))),
+			fx.Annotate(redis.NewAttemptsRepository, fx.As(new(service.AttemptsRepository))),
+			fx.Annotate(redis.NewLinksRepository, fx.As(new(service.LinksRepository))),
+			fx.Annotate(redis.NewOIDCAuthorizationsRepository, fx.As(new(service.OIDCAuthorizationsRepository))),
+		),
+
+		fx.Provide(
+			clients.NewNatsJetstreamClient,
+			fx.Annotate(nats.NewRevocationsPublisher, fx.As(new(service.TokenRevoker))),
+			fx.Annotate(nats.NewUserEventsPublisher, fx.As(new(service.UserEventsPublisher))),
+		),
+
+		fx.Provide(
+			fx.Annotate(oidc.NewClient, fx.As(new(service.OIDCClient))),
 		),
 
 		fx.Provide(
 			clients.NewS3Client,
 			fx.Annotate(avatarstorage.New, fx.As(new(service.AvatarStorage))),
+			fx.Annotate(avatarstorage.NewExportStorage, fx.As(new(service.DataExportStorage))),
 		),
 
 		fx.Provide(
 			fx.Annotate(service.NewAuthService, fx.As(new(handlers.AuthService))),
 			fx.Annotate(service.NewAuthService),
-			fx.Annotate(service.NewUserService, fx.As(new(handlers.UserService))),
+			fx.Annotate(service.NewUserService,
+				fx.As(new(handlers.UserService)),
+				fx.As(new(worker.UsernameHistoryPruner)),
+			),
 			fx.Annotate(service.NewAvatarService, fx.As(new(handlers.AvatarService))),
+			fx.Annotate(service.NewAdminService, fx.As(new(handlers.AdminService))),
+			fx.Annotate(service.NewOIDCService, fx.As(new(handlers.OIDCService))),
+			fx.Annotate(service.NewServiceClientsService, fx.As(new(handlers.ServiceClientsService))),
+			fx.Annotate(service.NewAccountDeletionService,
+				fx.As(fx.Self()),
+				fx.As(new(handlers.AccountDeletionService)),
+				fx.As(new(consumer.DeletionCompletedProcessor)),
+				fx.As(new(consumer.UserDeletedProcessor)),
+				fx.As(new(worker.AccountsPurger)),
+			),
+			fx.Annotate(service.NewPersonalTokensService, fx.As(new(handlers.PersonalTokensService))),
+			fx.Annotate(service.NewFollowsService, fx.As(new(handlers.FollowsService))),
+			fx.Annotate(service.NewDataExportsService,
+				fx.As(new(handlers.DataExportsService)),
+				fx.As(new(consumer.DataExportPartsProcessor)),
+				fx.As(new(worker.DataExportsProcessor)),
+			),
+			fx.Annotate(service.NewUserEventsRelay, fx.As(new(worker.UserEventsRelay))),
+			fx.Annotate(service.NewSecurityEventsService,
+				fx.As(new(handlers.SecurityEventsService)),
+				fx.As(new(worker.SecurityEventsPruner)),
+			),
+			fx.Annotate(service.NewMailService,
+				fx.As(new(service.MailClient)),
+				fx.As(new(worker.MailDeliverer)),
+			),
 		),
 
 		//
@@ -78,6 +144,15 @@ func Run() {
 		fx.Provide(
 			handlers.NewAuthHandler,
 			handlers.NewUserHandler,
+			handlers.NewOIDCHandler,
+			handlers.NewServiceClientsHandler,
+			handlers.NewSecurityEventsHandler,
+			handlers.NewPersonalTokensHandler,
+			handlers.NewDataExportsHandler,
+			handlers.NewFollowsHandler,
+			handlers.NewAccountDeletionHandler,
+			handlers.NewAdminHandler,
+			handlers.NewPersonalTokenValidator,
 		),
 
 		//
@@ -86,9 +161,32 @@ func Run() {
 		fx.Provide(func(signer *jwt.Signer) (*authjwt.Validator, error) {
 			return signer.Validator()
 		}),
+		fx.Provide(revocation.NewList),
+		fx.Invoke(consumer.StartRevocationsConsumer),
+		fx.Invoke(consumer.StartDeletionCompletedConsumer),
+		fx.Invoke(consumer.StartUserDeletedConsumer),
+		fx.Invoke(consumer.StartDataExportPartsConsumer),
+		fx.Invoke(worker.StartAccountDeletionsWorker),
+		fx.Invoke(worker.StartUserEventsRelay),
+		fx.Invoke(worker.StartMailDeliveryWorker),
+		fx.Invoke(worker.StartSecurityEventsPruner),
+		fx.Invoke(worker.StartDataExportsWorker),
+		fx.Invoke(worker.StartUsernameHistoryPruner),
 
 		fx.Provide(
 			fx.Annotate(generator.New, fx.As(new(service.Generator))),
+			fx.Annotate(func(cfg *config.Config) *password.Hasher {
+				return password.New(password.Params{
+					Memory:      cfg.Password.Argon2Memory,
+					Time:        cfg.Password.Argon2Time,
+					Parallelism: cfg.Password.Argon2Parallelism,
+					SaltLength:  16,
+					KeyLength:   32,
+				}, cfg.Password.Pepper)
+			}, fx.As(new(service.PasswordHasher))),
+			fx.Annotate(func(cfg *config.Config) *linktoken.Signer {
+				return linktoken.New(cfg.Links.SigningKey)
+			}, fx.As(new(service.LinkSigner))),
 		),
 
 		fx.Invoke(metrics.NewServer),
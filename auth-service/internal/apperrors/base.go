@@ -2,6 +2,7 @@ package apperrors
 
 import (
 	"errors"
+	"maps"
 
 	"github.com/tech-inspire/backend/auth-service/internal/apperrors/codes"
 )
@@ -11,12 +12,39 @@ var _ error = &Error{}
 type Error struct {
 	Code codes.Code
 	Err  error
+
+	// Metadata is passed to the client as error details.
+	Metadata map[string]string
 }
 
 func (e *Error) Error() string {
 	return e.Err.Error()
 }
 
+// Is reports errors with the same code as equal, so that copies created by WithMetadata
+// still match the predefined errors.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	return t.Code == e.Code
+}
+
+// WithMetadata returns a copy of the error with the additional metadata.
+func (e *Error) WithMetadata(metadata map[string]string) *Error {
+	merged := make(map[string]string, len(e.Metadata)+len(metadata))
+	maps.Copy(merged, e.Metadata)
+	maps.Copy(merged, metadata)
+
+	return &Error{
+		Code:     e.Code,
+		Err:      e.Err,
+		Metadata: merged,
+	}
+}
+
 func newError(code codes.Code, err string) *Error {
 	return &Error{
 		Code: code,
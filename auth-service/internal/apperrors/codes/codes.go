@@ -9,10 +9,58 @@ const (
 	SessionExpired  Code = "SESSION_EXPIRED"
 	SessionNotFound Code = "SESSION_NOT_FOUND"
 
-	UserNotFound Code = "USER_NOT_FOUND"
-	EmailUsed    Code = "EMAIL_USED"
-	UsernameUsed Code = "USERNAME_USED"
+	RefreshTokenReused Code = "REFRESH_TOKEN_REUSED"
+	InvalidDeviceName  Code = "INVALID_DEVICE_NAME"
+	TooManyAttempts    Code = "TOO_MANY_ATTEMPTS"
+
+	UserNotFound     Code = "USER_NOT_FOUND"
+	EmailUsed        Code = "EMAIL_USED"
+	UsernameUsed     Code = "USERNAME_USED"
+	UsernameReserved Code = "USERNAME_RESERVED"
+
+	UserSuspended    Code = "USER_SUSPENDED"
+	UserBanned       Code = "USER_BANNED"
+	UserNotSuspended Code = "USER_NOT_SUSPENDED"
+	AdminSelfAction  Code = "ADMIN_SELF_ACTION"
+
+	AccountDeletionPending  Code = "ACCOUNT_DELETION_PENDING"
+	AccountDeletionNotFound Code = "ACCOUNT_DELETION_NOT_FOUND"
 
 	ConfirmationCodeNotFound  = "CONFIRMATION_CODE_NOT_FOUND"
 	ResetPasswordCodeNotFound = "RESET_CODE_NOT_FOUND"
+
+	EmailChangeCodeNotFound Code = "EMAIL_CHANGE_CODE_NOT_FOUND"
+	LinkNotFound            Code = "LINK_NOT_FOUND"
+	InvalidUserField        Code = "INVALID_USER_FIELD"
+
+	MFARequired            Code = "MFA_REQUIRED"
+	MFAChallengeNotFound   Code = "MFA_CHALLENGE_NOT_FOUND"
+	InvalidMFACode         Code = "INVALID_MFA_CODE"
+	MFAAlreadyEnabled      Code = "MFA_ALREADY_ENABLED"
+	MFANotEnabled          Code = "MFA_NOT_ENABLED"
+	TOTPEnrollmentNotFound Code = "TOTP_ENROLLMENT_NOT_FOUND"
+
+	OIDCProviderNotFound      Code = "OIDC_PROVIDER_NOT_FOUND"
+	OIDCAuthorizationNotFound Code = "OIDC_AUTHORIZATION_NOT_FOUND"
+	OIDCEmailNotVerified      Code = "OIDC_EMAIL_NOT_VERIFIED"
+	IdentityAlreadyLinked     Code = "IDENTITY_ALREADY_LINKED"
+	IdentityNotFound          Code = "IDENTITY_NOT_FOUND"
+	LastLoginMethod           Code = "LAST_LOGIN_METHOD"
+
+	InvalidClientCredentials Code = "INVALID_CLIENT_CREDENTIALS"
+	ScopeNotAllowed          Code = "SCOPE_NOT_ALLOWED"
+
+	PersonalTokenNotFound Code = "PERSONAL_TOKEN_NOT_FOUND"
+	PersonalTokensLimit   Code = "PERSONAL_TOKENS_LIMIT"
+
+	DataExportNotFound Code = "DATA_EXPORT_NOT_FOUND"
+	DataExportLimit    Code = "DATA_EXPORT_LIMIT"
+
+	InvalidAvatar     Code = "INVALID_AVATAR"
+	InvalidAvatarSize Code = "INVALID_AVATAR_SIZE"
+	InvalidAvatarCrop Code = "INVALID_AVATAR_CROP"
+
+	SelfFollow        Code = "SELF_FOLLOW"
+	InvalidCursor     Code = "INVALID_CURSOR"
+	UserNotFollowable Code = "USER_NOT_FOLLOWABLE"
 )
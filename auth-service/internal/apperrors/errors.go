@@ -8,14 +8,61 @@ var (
 	ErrUnauthorized = newError(codes.Unauthorized, "unauthorized")
 	ErrForbidden    = newError(codes.Forbidden, "forbidden")
 
-	ErrSessionExpired = newError(codes.SessionExpired, "session expired")
+	ErrSessionExpired     = newError(codes.SessionExpired, "session expired")
+	ErrRefreshTokenReused = newError(codes.RefreshTokenReused, "refresh token was already used")
+	ErrInvalidDeviceName  = newError(codes.InvalidDeviceName, "device name must be from 1 to 64 characters long")
+	ErrTooManyAttempts    = newError(codes.TooManyAttempts, "too many failed attempts, try again later")
 
 	ErrUserNotFound    = newError(codes.UserNotFound, "user not found")
 	ErrSessionNotFound = newError(codes.SessionNotFound, "session not found")
 
-	ErrEmailUsed    = newError(codes.EmailUsed, "email already used")
-	ErrUsernameUsed = newError(codes.UsernameUsed, "username already used")
+	ErrEmailUsed        = newError(codes.EmailUsed, "email already used")
+	ErrUsernameUsed     = newError(codes.UsernameUsed, "username already used")
+	ErrUsernameReserved = newError(codes.UsernameReserved, "username is reserved")
+
+	ErrUserSuspended    = newError(codes.UserSuspended, "user is suspended")
+	ErrUserBanned       = newError(codes.UserBanned, "user is banned")
+	ErrUserNotSuspended = newError(codes.UserNotSuspended, "user is not suspended")
+	ErrAdminSelfAction  = newError(codes.AdminSelfAction, "administrators can not change their own status or role")
+
+	ErrAccountDeletionPending  = newError(codes.AccountDeletionPending, "account deletion already requested")
+	ErrAccountDeletionNotFound = newError(codes.AccountDeletionNotFound, "account deletion not requested")
 
 	ErrConfirmationCodeNotFound  = newError(codes.ConfirmationCodeNotFound, "confirmation code not found")
 	ErrResetPasswordCodeNotFound = newError(codes.ResetPasswordCodeNotFound, "reset password code not found")
+	ErrEmailChangeCodeNotFound   = newError(codes.EmailChangeCodeNotFound, "email change code not found")
+	ErrLinkNotFound              = newError(codes.LinkNotFound, "link is invalid, expired or opened in another browser")
+
+	ErrInvalidUserField = newError(codes.InvalidUserField, "invalid user field")
+
+	ErrMFARequired            = newError(codes.MFARequired, "multi-factor authentication required")
+	ErrMFAChallengeNotFound   = newError(codes.MFAChallengeNotFound, "mfa challenge not found or expired")
+	ErrInvalidMFACode         = newError(codes.InvalidMFACode, "invalid mfa code")
+	ErrMFAAlreadyEnabled      = newError(codes.MFAAlreadyEnabled, "mfa already enabled")
+	ErrMFANotEnabled          = newError(codes.MFANotEnabled, "mfa not enabled")
+	ErrTOTPEnrollmentNotFound = newError(codes.TOTPEnrollmentNotFound, "totp enrollment not started")
+
+	ErrOIDCProviderNotFound      = newError(codes.OIDCProviderNotFound, "identity provider not found")
+	ErrOIDCAuthorizationNotFound = newError(codes.OIDCAuthorizationNotFound, "authorization is invalid, expired or started in another browser")
+	ErrOIDCEmailNotVerified      = newError(codes.OIDCEmailNotVerified, "email is not verified by the identity provider")
+	ErrIdentityAlreadyLinked     = newError(codes.IdentityAlreadyLinked, "identity is already linked to an account")
+	ErrIdentityNotFound          = newError(codes.IdentityNotFound, "identity not found")
+	ErrLastLoginMethod           = newError(codes.LastLoginMethod, "set a password or link another identity before unlinking the last one")
+
+	ErrInvalidClientCredentials = newError(codes.InvalidClientCredentials, "invalid client credentials")
+	ErrScopeNotAllowed          = newError(codes.ScopeNotAllowed, "scope is not allowed for the client")
+
+	ErrPersonalTokenNotFound = newError(codes.PersonalTokenNotFound, "personal access token not found")
+	ErrPersonalTokensLimit   = newError(codes.PersonalTokensLimit, "personal access tokens limit reached")
+
+	ErrDataExportNotFound = newError(codes.DataExportNotFound, "data export not found")
+	ErrDataExportLimit    = newError(codes.DataExportLimit, "data export has already been requested recently")
+
+	ErrInvalidAvatar     = newError(codes.InvalidAvatar, "avatar is not a valid jpeg, png or webp image")
+	ErrInvalidAvatarSize = newError(codes.InvalidAvatarSize, "avatar is too small or too large")
+	ErrInvalidAvatarCrop = newError(codes.InvalidAvatarCrop, "avatar crop must be a non-empty rectangle inside the image")
+
+	ErrSelfFollow        = newError(codes.SelfFollow, "users cannot follow themselves")
+	ErrInvalidCursor     = newError(codes.InvalidCursor, "invalid page cursor")
+	ErrUserNotFollowable = newError(codes.UserNotFollowable, "suspended users and users pending deletion cannot be followed")
 )
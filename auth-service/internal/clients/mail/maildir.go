@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-errors/errors"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// MaildirTransport writes messages to a maildir, so that codes sent during local development
+// can be read with any mail client or just opened as files in <path>/new.
+type MaildirTransport struct {
+	path string
+	from string
+}
+
+func NewMaildirTransport(path, from string) (*MaildirTransport, error) {
+	for _, dir := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(path, dir), 0o755); err != nil {
+			return nil, errors.Errorf("create maildir: %w", err)
+		}
+	}
+
+	if from == "" {
+		from = "no-reply@localhost"
+	}
+
+	return &MaildirTransport{path: path, from: from}, nil
+}
+
+// Send writes the message to tmp and moves it to new, so readers never see partially written files.
+func (t *MaildirTransport) Send(_ context.Context, to string, message Message) error {
+	name := fmt.Sprintf("%d.%s.inspire", time.Now().UnixNano(), gonanoid.Must(8))
+
+	msg, err := Format(t.from, to, message)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := filepath.Join(t.path, "tmp", name)
+	if err = os.WriteFile(tmpPath, msg, 0o644); err != nil {
+		return errors.Errorf("write message: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, filepath.Join(t.path, "new", name)); err != nil {
+		return errors.Errorf("deliver message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package mail
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMaildirTransportSend(t *testing.T) {
+	dir := t.TempDir()
+
+	transport, err := NewMaildirTransport(dir, "no-reply@example.com")
+	if err != nil {
+		t.Fatalf("create transport: %v", err)
+	}
+
+	renderer, err := NewRenderer("en")
+	if err != nil {
+		t.Fatalf("create renderer: %v", err)
+	}
+
+	message, err := renderer.Render("en", ConfirmEmail("161803", "https://example.com/confirm"))
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	if err = transport.Send(context.Background(), "user@example.com", message); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	files, err := os.ReadDir(filepath.Join(dir, "new"))
+	if err != nil {
+		t.Fatalf("read maildir: %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("got %d messages, want 1", len(files))
+	}
+
+	tmp, err := os.ReadDir(filepath.Join(dir, "tmp"))
+	if err != nil {
+		t.Fatalf("read maildir: %v", err)
+	}
+	if len(tmp) != 0 {
+		t.Errorf("got %d files left in tmp, want 0", len(tmp))
+	}
+
+	f, err := os.Open(filepath.Join(dir, "new", files[0].Name()))
+	if err != nil {
+		t.Fatalf("open message: %v", err)
+	}
+	defer f.Close()
+
+	msg, err := mail.ReadMessage(f)
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+
+	if to := msg.Header.Get("To"); to != "user@example.com" {
+		t.Errorf("got recipient %q, want %q", to, "user@example.com")
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("decode subject: %v", err)
+	}
+	if subject != message.Subject {
+		t.Errorf("got subject %q, want %q", subject, message.Subject)
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("parse content type: %v", err)
+	}
+
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := parts.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+
+		content, err := io.ReadAll(quotedprintable.NewReader(part))
+		if err != nil {
+			t.Fatalf("decode part: %v", err)
+		}
+		if !strings.Contains(string(content), "161803") {
+			t.Errorf("%s part does not contain the code", part.Header.Get("Content-Type"))
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package mail
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryTransport keeps sent messages, so that tests can read the codes sent to users.
+type MemoryTransport struct {
+	mu       sync.Mutex
+	messages map[string][]Message
+}
+
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{messages: make(map[string][]Message)}
+}
+
+func (t *MemoryTransport) Send(_ context.Context, to string, message Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.messages[to] = append(t.messages[to], message)
+
+	return nil
+}
+
+// Messages returns the messages sent to the address, from the oldest to the newest.
+func (t *MemoryTransport) Messages(to string) []Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]Message(nil), t.messages[to]...)
+}
+
+func (t *MemoryTransport) LastMessage(to string) (Message, bool) {
+	messages := t.Messages(to)
+	if len(messages) == 0 {
+		return Message{}, false
+	}
+
+	return messages[len(messages)-1], true
+}
@@ -0,0 +1,161 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/go-errors/errors"
+	"golang.org/x/text/language"
+)
+
+//go:embed templates
+var templatesFS embed.FS
+
+const (
+	htmlLayout = "templates/layout.html.tmpl"
+	textLayout = "templates/layout.txt.tmpl"
+)
+
+// Message is a rendered mail.
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// localizedTemplate defines "subject" and "text" blocks rendered as plain text,
+// and "content" block rendered into the html layout.
+type localizedTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// Renderer renders templates in the locale of the recipient. Locales are the directories in templates,
+// the closest one is selected, e.g. "en" for "en-GB", and the default one is used if none matches.
+type Renderer struct {
+	locales   []language.Tag
+	matcher   language.Matcher
+	templates map[language.Tag]map[string]localizedTemplate
+}
+
+func NewRenderer(defaultLocale string) (*Renderer, error) {
+	defaultTag, err := language.Parse(defaultLocale)
+	if err != nil {
+		return nil, errors.Errorf("parse default locale: %w", err)
+	}
+
+	dirs, err := fs.ReadDir(templatesFS, "templates")
+	if err != nil {
+		return nil, errors.Errorf("read templates: %w", err)
+	}
+
+	r := &Renderer{
+		locales:   []language.Tag{defaultTag},
+		templates: make(map[language.Tag]map[string]localizedTemplate),
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		tag, err := language.Parse(dir.Name())
+		if err != nil {
+			return nil, errors.Errorf("parse locale of templates/%s: %w", dir.Name(), err)
+		}
+
+		if r.templates[tag], err = parseLocale(dir.Name()); err != nil {
+			return nil, err
+		}
+
+		if tag != defaultTag {
+			r.locales = append(r.locales, tag)
+		}
+	}
+
+	if _, ok := r.templates[defaultTag]; !ok {
+		return nil, errors.Errorf("no templates for default locale '%s'", defaultLocale)
+	}
+
+	// the first tag is used when nothing matches
+	r.matcher = language.NewMatcher(r.locales)
+
+	return r, nil
+}
+
+func parseLocale(dir string) (map[string]localizedTemplate, error) {
+	files, err := fs.Glob(templatesFS, path.Join("templates", dir, "*.tmpl"))
+	if err != nil {
+		return nil, errors.Errorf("list templates: %w", err)
+	}
+
+	templates := make(map[string]localizedTemplate, len(files))
+	for _, file := range files {
+		text, err := texttemplate.ParseFS(templatesFS, textLayout, file)
+		if err != nil {
+			return nil, errors.Errorf("parse text template %s: %w", file, err)
+		}
+
+		html, err := htmltemplate.ParseFS(templatesFS, htmlLayout, file)
+		if err != nil {
+			return nil, errors.Errorf("parse html template %s: %w", file, err)
+		}
+
+		templates[strings.TrimSuffix(path.Base(file), ".tmpl")] = localizedTemplate{text: text, html: html}
+	}
+
+	return templates, nil
+}
+
+// Locales returns supported locales, the default one is the first.
+func (r *Renderer) Locales() []string {
+	locales := make([]string, len(r.locales))
+	for i, tag := range r.locales {
+		locales[i] = tag.String()
+	}
+
+	return locales
+}
+
+// Render falls back to the default locale if the template is not translated to the locale of the recipient.
+func (r *Renderer) Render(locale string, t Template) (Message, error) {
+	tmpl, ok := r.templates[r.match(locale)][t.Name]
+	if !ok {
+		tmpl, ok = r.templates[r.locales[0]][t.Name]
+	}
+	if !ok {
+		return Message{}, errors.Errorf("unknown mail template '%s'", t.Name)
+	}
+
+	var subject, text, html bytes.Buffer
+
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", t.Data); err != nil {
+		return Message{}, errors.Errorf("render subject of %s: %w", t.Name, err)
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, path.Base(textLayout), t.Data); err != nil {
+		return Message{}, errors.Errorf("render text of %s: %w", t.Name, err)
+	}
+	if err := tmpl.html.ExecuteTemplate(&html, path.Base(htmlLayout), t.Data); err != nil {
+		return Message{}, errors.Errorf("render html of %s: %w", t.Name, err)
+	}
+
+	return Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()),
+		HTML:    html.String(),
+	}, nil
+}
+
+func (r *Renderer) match(locale string) language.Tag {
+	if locale == "" {
+		return r.locales[0]
+	}
+
+	_, index, _ := r.matcher.Match(language.Make(locale))
+	return r.locales[index]
+}
@@ -0,0 +1,47 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+)
+
+type SMTPTransport struct {
+	auth smtp.Auth
+	from string
+	addr string
+}
+
+func NewSMTPTransport(cfg *config.Config) (*SMTPTransport, error) {
+	if cfg.SMTP.Host == "" || cfg.SMTP.Port == "" || cfg.SMTP.From == "" {
+		return nil, errors.New("smtp transport requires EMAILS_SMTP_HOST, EMAILS_SMTP_PORT and EMAILS_SMTP_FROM")
+	}
+
+	auth := smtp.PlainAuth("", cfg.SMTP.From, cfg.SMTP.Password, cfg.SMTP.Host)
+
+	addr := fmt.Sprintf("%s:%s", cfg.SMTP.Host, cfg.SMTP.Port)
+
+	return &SMTPTransport{
+		auth: auth,
+		from: cfg.SMTP.From,
+		addr: addr,
+	}, nil
+}
+
+// Send does not support cancellation, the delivery is bounded by the timeouts of the smtp server.
+func (t *SMTPTransport) Send(_ context.Context, to string, message Message) error {
+	msg, err := Format(t.from, to, message)
+	if err != nil {
+		return err
+	}
+
+	err = smtp.SendMail(t.addr, t.auth, t.from, []string{to}, msg)
+	if err != nil {
+		return fmt.Errorf("smtp.SendMail: %w", err)
+	}
+
+	return nil
+}
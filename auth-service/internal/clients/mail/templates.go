@@ -1,24 +1,107 @@
 package mail
 
-import "fmt"
+import (
+	"time"
+)
 
-type Message struct {
-	Title   string
-	Message string
+// Template is a message rendered in the locale of the recipient before it is queued.
+// Name is the file name of the template in templates/<locale>, without the extension.
+type Template struct {
+	Name string
+	Data any
 }
 
-func ConfirmEmail(code string) Message {
-	return Message{
-		Title:   "Inspire: Confirm your email",
-		Message: fmt.Sprintf("Your confirmation code is %s", code),
+// ConfirmEmail contains both the code and the link, the user can confirm the email either way.
+func ConfirmEmail(code, link string) Template {
+	return Template{
+		Name: "confirm_email",
+		Data: struct{ Code, Link string }{Code: code, Link: link},
 	}
 }
 
-func ResetPassword(code string) Message {
-	const subject = "Inspire: Reset Password"
+func ResetPassword(code, link string) Template {
+	return Template{
+		Name: "reset_password",
+		Data: struct{ Code, Link string }{Code: code, Link: link},
+	}
+}
+
+func LoginLink(link string, expiresIn time.Duration) Template {
+	return Template{
+		Name: "login_link",
+		Data: struct {
+			Link      string
+			ExpiresIn int
+		}{Link: link, ExpiresIn: int(expiresIn.Minutes())},
+	}
+}
+
+// LinkIdentity asks the user to confirm linking of the external account with the same email.
+func LinkIdentity(provider, link string) Template {
+	return Template{
+		Name: "link_identity",
+		Data: struct{ Provider, Link string }{Provider: provider, Link: link},
+	}
+}
+
+func ChangeEmail(code string) Template {
+	return Template{
+		Name: "change_email",
+		Data: struct{ Code string }{Code: code},
+	}
+}
+
+func EmailChangeRequested(newEmail string) Template {
+	return Template{
+		Name: "email_change_requested",
+		Data: struct{ NewEmail string }{NewEmail: newEmail},
+	}
+}
+
+func AccountDeletionScheduled(purgeAfter time.Time) Template {
+	return Template{
+		Name: "account_deletion_scheduled",
+		Data: struct{ PurgeAfter time.Time }{PurgeAfter: purgeAfter.UTC()},
+	}
+}
+
+// NewDevice notifies the user about the sign in from the device or location not used before.
+// Location is empty if it could not be resolved.
+func NewDevice(device, location string, signedInAt time.Time, revokeLink string) Template {
+	return Template{
+		Name: "new_device",
+		Data: struct {
+			Device, Location string
+			SignedInAt       time.Time
+			RevokeLink       string
+		}{Device: device, Location: location, SignedInAt: signedInAt.UTC(), RevokeLink: revokeLink},
+	}
+}
+
+// DataExportReady sends the link to download the archive with the user data.
+func DataExportReady(link string, expiresAt time.Time) Template {
+	return Template{
+		Name: "data_export_ready",
+		Data: struct {
+			Link      string
+			ExpiresAt time.Time
+		}{Link: link, ExpiresAt: expiresAt.UTC()},
+	}
+}
 
-	return Message{
-		Title:   "Inspire: Reset Password",
-		Message: fmt.Sprintf("Your password reset code is %s", code),
+// PreviewTemplates returns every template with sample data. It must be updated with every new template.
+func PreviewTemplates() []Template {
+	return []Template{
+		ConfirmEmail("482913", "https://example.com/auth/confirm-email?token=preview"),
+		ResetPassword("105736", "https://example.com/auth/reset-password?token=preview"),
+		LoginLink("https://example.com/auth/login-link?token=preview", 15*time.Minute),
+		LinkIdentity("google", "https://example.com/auth/link-identity?token=preview"),
+		ChangeEmail("660421"),
+		EmailChangeRequested("new.address@example.com"),
+		AccountDeletionScheduled(time.Date(2026, time.November, 17, 12, 30, 0, 0, time.UTC)),
+		NewDevice("Firefox on Windows", "Berlin, Germany", time.Date(2026, time.October, 18, 9, 15, 0, 0, time.UTC),
+			"https://example.com/auth/revoke-session?token=preview"),
+		DataExportReady("https://exports.example.com/exports/preview/export.zip?X-Amz-Signature=preview",
+			time.Date(2026, time.October, 21, 9, 15, 0, 0, time.UTC)),
 	}
 }
@@ -0,0 +1,96 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+)
+
+const (
+	TransportSMTP    = "smtp"
+	TransportMaildir = "maildir"
+	TransportMemory  = "memory"
+)
+
+// Transport delivers a single message. Messages are queued before, so the transport is never called
+// while handling a request.
+type Transport interface {
+	Send(ctx context.Context, to string, message Message) error
+}
+
+// NewTransport creates the transport selected by MAIL_TRANSPORT.
+// Maildir and memory transports are meant for local development and tests.
+func NewTransport(cfg *config.Config) (Transport, error) {
+	switch cfg.Mail.Transport {
+	case TransportSMTP:
+		return NewSMTPTransport(cfg)
+	case TransportMaildir:
+		return NewMaildirTransport(cfg.Mail.MaildirPath, cfg.SMTP.From)
+	case TransportMemory:
+		return NewMemoryTransport(), nil
+	default:
+		return nil, errors.Errorf("unknown mail transport '%s'", cfg.Mail.Transport)
+	}
+}
+
+// Format builds multipart/alternative message with text and html parts.
+// Non-ASCII subjects are encoded, as headers can contain only ASCII characters.
+func Format(from, to string, message Message) ([]byte, error) {
+	var body bytes.Buffer
+
+	parts := multipart.NewWriter(&body)
+
+	alternatives := []struct{ contentType, content string }{
+		// the last supported alternative is preferred by mail clients
+		{"text/plain; charset=utf-8", message.Text},
+		{"text/html; charset=utf-8", message.HTML},
+	}
+	for _, alternative := range alternatives {
+		part, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {alternative.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Errorf("create part: %w", err)
+		}
+
+		encoder := quotedprintable.NewWriter(part)
+		if _, err = encoder.Write([]byte(alternative.content)); err != nil {
+			return nil, errors.Errorf("write part: %w", err)
+		}
+		if err = encoder.Close(); err != nil {
+			return nil, errors.Errorf("close part: %w", err)
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, errors.Errorf("close multipart: %w", err)
+	}
+
+	var msg bytes.Buffer
+
+	headers := []struct{ key, value string }{
+		{"From", from},
+		{"To", to},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": parts.Boundary()})},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&msg, "%s: %s\r\n", header.key, header.value)
+	}
+
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,35 @@
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"go.uber.org/fx"
+)
+
+func NewNatsJetstreamClient(lc fx.Lifecycle, cfg *config.Config) (nats.JetStreamContext, error) {
+	nc, err := nats.Connect(cfg.Nats.URL,
+		nats.Name("auth-service"),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(5*time.Second),
+	)
+	if err != nil {
+		return nil, errors.Errorf("connect to nats: %w", err)
+	}
+
+	js, err := nc.JetStream()
+	if err != nil {
+		return nil, errors.Errorf("get jetstream context: %w", err)
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			return nc.Drain()
+		},
+	})
+
+	return js, nil
+}
@@ -0,0 +1,265 @@
+// Package oidc signs users in with OpenID Connect providers using authorization code flow with PKCE.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/go-errors/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"go.uber.org/fx"
+	"golang.org/x/oauth2"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	// clockSkew is tolerated in exp, iat and nbf claims.
+	clockSkew = time.Minute
+)
+
+// signingMethods are accepted for id tokens, "none" and symmetric algorithms are never accepted.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+type Client struct {
+	providers map[string]*provider
+	names     []string
+}
+
+// NewClient does not contact the providers, their endpoints are discovered on the first use,
+// so that unavailable provider does not prevent the service from starting.
+func NewClient(lc fx.Lifecycle, cfg *config.Config) *Client {
+	// stops refreshing of the providers keys
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.StopHook(cancel))
+
+	client := &Client{
+		providers: make(map[string]*provider, len(cfg.OIDC.ProvidersConfig)),
+	}
+
+	applicationURL := strings.TrimSuffix(cfg.ApplicationURL, "/")
+
+	for _, providerCfg := range cfg.OIDC.ProvidersConfig {
+		client.names = append(client.names, providerCfg.Name)
+		client.providers[providerCfg.Name] = &provider{
+			ctx:        ctx,
+			cfg:        providerCfg,
+			httpClient: &http.Client{Timeout: requestTimeout},
+			// the page of the application sends the code and the state back to the api
+			redirectURL: applicationURL + "/auth/oidc/" + url.PathEscape(providerCfg.Name) + "/callback",
+		}
+	}
+
+	return client
+}
+
+// Providers returns the names of the configured providers.
+func (c *Client) Providers() []string {
+	return slices.Clone(c.names)
+}
+
+// AuthCodeURL returns the url of the provider, where the user is redirected to sign in.
+func (c *Client) AuthCodeURL(ctx context.Context, authorization models.OIDCAuthorization) (string, error) {
+	p, err := c.provider(authorization.Provider)
+	if err != nil {
+		return "", err
+	}
+
+	oauth, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return oauth.AuthCodeURL(authorization.State,
+		oauth2.S256ChallengeOption(authorization.CodeVerifier),
+		oauth2.SetAuthURLParam("nonce", authorization.Nonce),
+	), nil
+}
+
+// Exchange redeems the authorization code and validates the returned id token.
+func (c *Client) Exchange(ctx context.Context, authorization models.OIDCAuthorization, code string) (*models.OIDCClaims, error) {
+	p, err := c.provider(authorization.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	oauth, keyFunc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauth.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.httpClient), code,
+		oauth2.VerifierOption(authorization.CodeVerifier),
+	)
+	if err != nil {
+		return nil, errors.Errorf("exchange code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	var claims idTokenClaims
+
+	_, err = jwt.ParseWithClaims(rawIDToken, &claims, keyFunc,
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, errors.Errorf("validate id token: %w", err)
+	}
+
+	// the token could be issued for another authorization, e.g. replayed by an attacker
+	if claims.Nonce != authorization.Nonce {
+		return nil, errors.New("id token nonce mismatch")
+	}
+
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.cfg.ClientID {
+		return nil, errors.New("id token is authorized for another party")
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("id token has no subject")
+	}
+
+	return &models.OIDCClaims{
+		Provider:          p.cfg.Name,
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     bool(claims.EmailVerified),
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+	}, nil
+}
+
+func (c *Client) provider(name string) (*provider, error) {
+	p, ok := c.providers[name]
+	if !ok {
+		return nil, apperrors.ErrOIDCProviderNotFound
+	}
+
+	return p, nil
+}
+
+type provider struct {
+	ctx         context.Context
+	cfg         config.OIDCProvider
+	httpClient  *http.Client
+	redirectURL string
+
+	mu      sync.Mutex
+	issuer  string
+	oauth   *oauth2.Config
+	keyFunc jwt.Keyfunc
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// discover fetches the endpoints of the provider once, failed discovery is retried on the next call.
+func (p *provider) discover(ctx context.Context) (*oauth2.Config, jwt.Keyfunc, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth != nil {
+		return p.oauth, p.keyFunc, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, nil, errors.Errorf("create discovery request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, errors.Errorf("discover provider '%s': %w", p.cfg.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, errors.Errorf("discover provider '%s': unexpected status %d", p.cfg.Name, resp.StatusCode)
+	}
+
+	var doc discoveryDocument
+	if err = json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, nil, errors.Errorf("decode discovery document: %w", err)
+	}
+
+	// the document could be served by a compromised host, see OpenID Connect Discovery 1.0, section 4.3
+	if strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(p.cfg.Issuer, "/") {
+		return nil, nil, errors.Errorf("provider '%s' issuer mismatch: %s", p.cfg.Name, doc.Issuer)
+	}
+
+	kf, err := keyfunc.NewDefaultCtx(p.ctx, []string{doc.JWKSURI})
+	if err != nil {
+		return nil, nil, errors.Errorf("create keyfunc of provider '%s': %w", p.cfg.Name, err)
+	}
+
+	p.issuer = doc.Issuer
+	p.keyFunc = kf.Keyfunc
+	p.oauth = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  doc.AuthorizationEndpoint,
+			TokenURL: doc.TokenEndpoint,
+		},
+		RedirectURL: p.redirectURL,
+		Scopes:      p.cfg.Scopes,
+	}
+
+	return p.oauth, p.keyFunc, nil
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+
+	Nonce           string `json:"nonce"`
+	AuthorizedParty string `json:"azp"`
+
+	Email             string       `json:"email"`
+	EmailVerified     flexibleBool `json:"email_verified"`
+	Name              string       `json:"name"`
+	PreferredUsername string       `json:"preferred_username"`
+}
+
+// flexibleBool accepts strings, as some providers send email_verified as "true".
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		*b = flexibleBool(v)
+	case string:
+		*b = flexibleBool(v == "true")
+	default:
+		*b = false
+	}
+
+	return nil
+}
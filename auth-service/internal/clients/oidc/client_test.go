@@ -0,0 +1,187 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/oidc/oidctest"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"go.uber.org/fx/fxtest"
+)
+
+const (
+	testProvider     = "test"
+	testClientID     = "inspire"
+	testClientSecret = "secret"
+)
+
+var testUser = oidctest.User{
+	Subject:           "248289761001",
+	Email:             "jane@example.com",
+	EmailVerified:     true,
+	Name:              "Jane Doe",
+	PreferredUsername: "jane",
+}
+
+func newTestClient(t *testing.T, clientSecret string) *Client {
+	t.Helper()
+
+	var provider *oidctest.Provider
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provider.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := oidctest.New(server.URL, testClientID, testClientSecret, testUser)
+	if err != nil {
+		t.Fatalf("create provider: %v", err)
+	}
+
+	var cfg config.Config
+	cfg.ApplicationURL = "https://app.example.com"
+	cfg.OIDC.ProvidersConfig = []config.OIDCProvider{{
+		Name:         testProvider,
+		Issuer:       server.URL,
+		ClientID:     testClientID,
+		ClientSecret: clientSecret,
+		Scopes:       []string{"openid", "email", "profile"},
+	}}
+
+	lc := fxtest.NewLifecycle(t)
+	client := NewClient(lc, &cfg)
+	lc.RequireStart()
+	t.Cleanup(lc.RequireStop)
+
+	return client
+}
+
+func newTestAuthorization(provider string) models.OIDCAuthorization {
+	return models.OIDCAuthorization{
+		State:        "state-" + provider,
+		Provider:     provider,
+		Nonce:        "nonce-" + provider,
+		CodeVerifier: "verifier-0123456789-0123456789-0123456789-0123456789",
+	}
+}
+
+// authorize follows the auth code url the way the browser does and returns the code of the redirect.
+func authorize(t *testing.T, client *Client, authorization models.OIDCAuthorization) string {
+	t.Helper()
+
+	authURL, err := client.AuthCodeURL(context.Background(), authorization)
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+
+	httpClient := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	resp, err := httpClient.Get(authURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: got status %d, want %d", resp.StatusCode, http.StatusFound)
+	}
+
+	redirect, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("parse redirect: %v", err)
+	}
+
+	if got := redirect.Scheme + "://" + redirect.Host + redirect.Path; got != "https://app.example.com/auth/oidc/test/callback" {
+		t.Errorf("got redirect %s", got)
+	}
+	if state := redirect.Query().Get("state"); state != authorization.State {
+		t.Errorf("got state %q, want %q", state, authorization.State)
+	}
+
+	return redirect.Query().Get("code")
+}
+
+func TestClientExchange(t *testing.T) {
+	client := newTestClient(t, testClientSecret)
+
+	authorization := newTestAuthorization(testProvider)
+	code := authorize(t, client, authorization)
+
+	claims, err := client.Exchange(context.Background(), authorization, code)
+	if err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+
+	want := models.OIDCClaims{
+		Provider:          testProvider,
+		Subject:           testUser.Subject,
+		Email:             testUser.Email,
+		EmailVerified:     testUser.EmailVerified,
+		Name:              testUser.Name,
+		PreferredUsername: testUser.PreferredUsername,
+	}
+	if *claims != want {
+		t.Errorf("got claims %+v, want %+v", *claims, want)
+	}
+
+	// the code is redeemed only once
+	if _, err = client.Exchange(context.Background(), authorization, code); err == nil {
+		t.Error("code was exchanged twice")
+	}
+}
+
+func TestClientExchangeRejects(t *testing.T) {
+	tests := []struct {
+		name         string
+		clientSecret string
+		change       func(authorization *models.OIDCAuthorization)
+	}{
+		{
+			name:         "nonce of another authorization",
+			clientSecret: testClientSecret,
+			change:       func(a *models.OIDCAuthorization) { a.Nonce = "nonce-of-attacker" },
+		},
+		{
+			name:         "wrong code verifier",
+			clientSecret: testClientSecret,
+			change:       func(a *models.OIDCAuthorization) { a.CodeVerifier += "0" },
+		},
+		{
+			name:         "wrong client secret",
+			clientSecret: "wrong",
+			change:       func(*models.OIDCAuthorization) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.clientSecret)
+
+			authorization := newTestAuthorization(testProvider)
+			code := authorize(t, client, authorization)
+
+			tt.change(&authorization)
+
+			if _, err := client.Exchange(context.Background(), authorization, code); err == nil {
+				t.Fatal("exchange succeeded")
+			}
+		})
+	}
+}
+
+func TestClientUnknownProvider(t *testing.T) {
+	client := newTestClient(t, testClientSecret)
+
+	_, err := client.AuthCodeURL(context.Background(), newTestAuthorization("unknown"))
+	if !errors.Is(err, apperrors.ErrOIDCProviderNotFound) {
+		t.Fatalf("got error %v, want %v", err, apperrors.ErrOIDCProviderNotFound)
+	}
+}
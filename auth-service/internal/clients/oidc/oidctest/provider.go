@@ -0,0 +1,263 @@
+// Package oidctest is an in-process OpenID Connect provider, which signs in the configured user
+// without asking anything. It is used to run the sign in flow locally and in tests.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	keyID        = "oidctest"
+	codeTTL      = time.Minute
+	idTokenTTL   = time.Hour
+	rsaKeyLength = 2048
+)
+
+// User is the account of the provider, its fields are sent as id token claims.
+type User struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+type authorizationCode struct {
+	user          User
+	nonce         string
+	codeChallenge string
+	redirectURI   string
+	expiresAt     time.Time
+}
+
+// Provider serves discovery, jwks, authorization and token endpoints.
+type Provider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	key          *rsa.PrivateKey
+
+	mu    sync.Mutex
+	user  User
+	codes map[string]authorizationCode
+}
+
+// New creates the provider, which must be served at the issuer url.
+func New(issuer, clientID, clientSecret string, user User) (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		key:          key,
+		user:         user,
+		codes:        make(map[string]authorizationCode),
+	}, nil
+}
+
+// SetUser changes the user signed in by the next authorizations.
+func (p *Provider) SetUser(user User) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.user = user
+}
+
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/.well-known/openid-configuration":
+		p.discovery(w)
+	case r.Method == http.MethodGet && r.URL.Path == "/jwks":
+		p.jwks(w)
+	case r.Method == http.MethodGet && r.URL.Path == "/authorize":
+		p.authorize(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == "/token":
+		p.token(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (p *Provider) discovery(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *Provider) jwks(w http.ResponseWriter) {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": keyID,
+			"n":   encode(p.key.N.Bytes()),
+			"e":   encode(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+// authorize approves every valid request and redirects back with the code.
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case query.Get("client_id") != p.clientID:
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	case query.Get("response_type") != "code":
+		http.Error(w, "unsupported response_type", http.StatusBadRequest)
+		return
+	case query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "":
+		http.Error(w, "S256 code challenge is required", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+
+	p.mu.Lock()
+	p.codes[code] = authorizationCode{
+		user:          p.user,
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+		redirectURI:   redirectURI.String(),
+		expiresAt:     time.Now().Add(codeTTL),
+	}
+	p.mu.Unlock()
+
+	params := redirectURI.Query()
+	params.Set("code", code)
+	params.Set("state", query.Get("state"))
+	redirectURI.RawQuery = params.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request")
+		return
+	}
+
+	if !p.authenticateClient(r) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	// the code is deleted on the first use, as required by RFC 6749, section 4.1.2
+	p.mu.Lock()
+	code, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	if !ok || time.Now().After(code.expiresAt) || r.PostForm.Get("redirect_uri") != code.redirectURI {
+		tokenError(w, "invalid_grant")
+		return
+	}
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(challenge[:]) != code.codeChallenge {
+		tokenError(w, "invalid_grant")
+		return
+	}
+
+	idToken, err := p.signIDToken(code)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   int(idTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+// authenticateClient accepts both client_secret_basic and client_secret_post.
+func (p *Provider) authenticateClient(r *http.Request) bool {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		// credentials in the header are form-urlencoded, see RFC 6749, section 2.3.1
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	return clientID == p.clientID && subtle.ConstantTimeCompare([]byte(clientSecret), []byte(p.clientSecret)) == 1
+}
+
+func (p *Provider) signIDToken(code authorizationCode) (string, error) {
+	if code.user.Subject == "" {
+		return "", errors.New("user has no subject")
+	}
+
+	now := time.Now()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":                p.issuer,
+		"sub":                code.user.Subject,
+		"aud":                p.clientID,
+		"exp":                now.Add(idTokenTTL).Unix(),
+		"iat":                now.Unix(),
+		"nonce":              code.nonce,
+		"email":              code.user.Email,
+		"email_verified":     code.user.EmailVerified,
+		"name":               code.user.Name,
+		"preferred_username": code.user.PreferredUsername,
+	})
+	token.Header["kid"] = keyID
+
+	return token.SignedString(p.key)
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
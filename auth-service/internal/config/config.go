@@ -1,25 +1,43 @@
 package config
 
 import (
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v10"
 	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
 )
 
 type Config struct {
 	Server struct {
-		Address            string   `env:"SERVER_ADDRESS,required"`
-		MetricsAddress     string   `env:"SERVER_METRICS_ADDRESS,required"`
-		ProxyHeader        string   `env:"SERVER_PROXY_HEADER,required"`
-		CORSAllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS" envDefault:"*"`
+		Address        string `env:"SERVER_ADDRESS,required"`
+		MetricsAddress string `env:"SERVER_METRICS_ADDRESS,required"`
+		ProxyHeader    string `env:"SERVER_PROXY_HEADER,required"`
+		// TrustedProxies are the networks of the proxies allowed to set ProxyHeader, the header
+		// of other peers is ignored.
+		TrustedProxies []string `env:"SERVER_TRUSTED_PROXIES" envDefault:"127.0.0.0/8,::1/128,10.0.0.0/8,172.16.0.0/12,192.168.0.0/16,fc00::/7"`
+		// LocationHeader is read only from the trusted proxies as well.
+		LocationHeader string `env:"SERVER_LOCATION_HEADER" envDefault:"CF-IPCountry"`
+		// CORSAllowedOrigins default to the origin of ApplicationURL. Any origin ("*") is not allowed,
+		// as the credentials are allowed for the link nonce cookie.
+		CORSAllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS"`
 		DebugCORS          bool     `env:"CORS_DEBUG" envDefault:"false"`
+
+		TrustedProxyPrefixes []netip.Prefix `env:"-"`
 	}
 
 	S3 struct {
 		Endpoint         string `env:"S3_ENDPOINT,required"`
 		BucketName       string `env:"S3_BUCKET_NAME,required"`
 		MinioResolveMode bool   `env:"S3_MINIO_RESOLVE_MODE" envDefault:"false"`
+		// ExportsBucketName is private and shared with the services uploading their parts of the data exports.
+		ExportsBucketName string `env:"S3_EXPORTS_BUCKET_NAME" envDefault:"exports"`
 	}
 
 	ApplicationURL string `env:"APPLICATION_URL,required"`
@@ -27,13 +45,153 @@ type Config struct {
 	DisableStackTrace bool `env:"DISABLE_STACK_TRACE"`
 
 	JWT struct {
-		UserJWKPath          string        `env:"JWT_USER_KEY_PATH,required"`
+		// UserJWKPath is a single signing key, used when UserKeysDir is not set.
+		UserJWKPath string `env:"JWT_USER_KEY_PATH"`
+		// UserKeysDir contains *.pem signing keys and 'current' file with the name of the key used for signing.
+		UserKeysDir string `env:"JWT_USER_KEYS_DIR"`
+		// RetiredKeysPath is written by the service when a key is removed from UserKeysDir,
+		// so the keys directory itself can be mounted read-only. Defaults to 'retired.json' in UserKeysDir.
+		RetiredKeysPath      string        `env:"JWT_RETIRED_KEYS_PATH"`
+		KeysReloadInterval   time.Duration `env:"JWT_KEYS_RELOAD_INTERVAL" envDefault:"1m"`
 		AccessTokenDuration  time.Duration `env:"JWT_ACCESS_TOKEN_DURATION,required"`
 		RefreshTokenDuration time.Duration `env:"JWT_REFRESH_TOKEN_DURATION,required"`
+		ServiceTokenDuration time.Duration `env:"JWT_SERVICE_TOKEN_DURATION" envDefault:"5m"`
+	}
+
+	ServiceClients struct {
+		// Names are the ids of the services allowed to get service tokens, each of them is configured
+		// with SERVICE_CLIENT_<NAME>_* variables, see ServiceClient.
+		Names []string `env:"SERVICE_CLIENTS"`
+
+		Clients []ServiceClient `env:"-"`
+	}
+
+	Nats struct {
+		URL string `env:"NATS_URL,required"`
+		// RevocationsStreamName should keep messages for at least the access token duration.
+		RevocationsStreamName string `env:"NATS_REVOCATIONS_STREAM_NAME" envDefault:"AUTH_REVOCATIONS"`
+		// UsersStreamName receives users.> events: changes of the users and deletion reports of other services.
+		UsersStreamName string `env:"NATS_USERS_STREAM_NAME" envDefault:"USERS"`
 	}
 
 	Session struct {
-		MaxAllowedSessionsPerUser int `env:"MAX_ALLOWED_SESSIONS_PER_USER,required"`
+		MaxAllowedSessionsPerUser int                          `env:"MAX_ALLOWED_SESSIONS_PER_USER,required"`
+		RevokeOnPasswordReset     bool                         `env:"SESSION_REVOKE_ON_PASSWORD_RESET" envDefault:"true"`
+		EvictionPolicy            models.SessionEvictionPolicy `env:"SESSION_EVICTION_POLICY" envDefault:"least_recently_refreshed"`
+	}
+
+	Password struct {
+		// Argon2Memory is in KiB. Defaults are the minimal OWASP recommendation for argon2id.
+		Argon2Memory      uint32 `env:"PASSWORD_ARGON2_MEMORY" envDefault:"19456"`
+		Argon2Time        uint32 `env:"PASSWORD_ARGON2_TIME" envDefault:"2"`
+		Argon2Parallelism uint8  `env:"PASSWORD_ARGON2_PARALLELISM" envDefault:"1"`
+		// Pepper is optional secret mixed into new hashes, it can not be changed without resetting passwords.
+		Pepper string `env:"PASSWORD_PEPPER"`
+	}
+
+	BruteForce struct {
+		Window       time.Duration `env:"BRUTE_FORCE_WINDOW" envDefault:"15m"`
+		FreeAttempts int64         `env:"BRUTE_FORCE_FREE_ATTEMPTS" envDefault:"3"`
+		// BaseDelay is doubled with every failed attempt after the free ones, up to MaxDelay.
+		BaseDelay       time.Duration `env:"BRUTE_FORCE_BASE_DELAY" envDefault:"1s"`
+		MaxDelay        time.Duration `env:"BRUTE_FORCE_MAX_DELAY" envDefault:"30s"`
+		LockoutDuration time.Duration `env:"BRUTE_FORCE_LOCKOUT_DURATION" envDefault:"15m"`
+
+		MaxAccountAttempts int64 `env:"BRUTE_FORCE_MAX_ACCOUNT_ATTEMPTS" envDefault:"10"`
+		MaxIPAttempts      int64 `env:"BRUTE_FORCE_MAX_IP_ATTEMPTS" envDefault:"100"`
+		MaxCodeAttempts    int64 `env:"BRUTE_FORCE_MAX_CODE_ATTEMPTS" envDefault:"5"`
+	}
+
+	Outbox struct {
+		RelayInterval  time.Duration `env:"OUTBOX_RELAY_INTERVAL" envDefault:"1s"`
+		RelayBatchSize int           `env:"OUTBOX_RELAY_BATCH_SIZE" envDefault:"100"`
+		// RelayLease is how long the claimed events are reserved for the replica publishing them.
+		RelayLease time.Duration `env:"OUTBOX_RELAY_LEASE" envDefault:"30s"`
+	}
+
+	AccountDeletion struct {
+		GracePeriod   time.Duration `env:"ACCOUNT_DELETION_GRACE_PERIOD" envDefault:"720h"`
+		PurgeInterval time.Duration `env:"ACCOUNT_DELETION_PURGE_INTERVAL" envDefault:"1m"`
+		// Services must report the cleanup of the user data before the deletion is completed.
+		Services []string `env:"ACCOUNT_DELETION_SERVICES" envDefault:"posts-service,search-service"`
+	}
+
+	SecurityEvents struct {
+		// Retention is how long the events are kept in the audit log.
+		Retention      time.Duration `env:"SECURITY_EVENTS_RETENTION" envDefault:"2160h"`
+		PruneInterval  time.Duration `env:"SECURITY_EVENTS_PRUNE_INTERVAL" envDefault:"1h"`
+		PruneBatchSize int           `env:"SECURITY_EVENTS_PRUNE_BATCH_SIZE" envDefault:"1000"`
+	}
+
+	DataExports struct {
+		// Services must send their parts before the archive is built.
+		Services []string `env:"DATA_EXPORTS_SERVICES" envDefault:"posts-service,search-service"`
+		// Interval limits how often the user can request the export.
+		Interval time.Duration `env:"DATA_EXPORTS_INTERVAL" envDefault:"24h"`
+		// Timeout is how long to wait for the parts, the export fails after it.
+		Timeout time.Duration `env:"DATA_EXPORTS_TIMEOUT" envDefault:"1h"`
+		// TTL is how long the archive is kept, presigned links can not live longer than 7 days.
+		TTL             time.Duration `env:"DATA_EXPORTS_TTL" envDefault:"72h"`
+		ProcessInterval time.Duration `env:"DATA_EXPORTS_PROCESS_INTERVAL" envDefault:"30s"`
+	}
+
+	Usernames struct {
+		// Reserved can not be taken, they are compared ignoring the case, dots and underscores.
+		Reserved []string `env:"USERNAMES_RESERVED" envDefault:"admin,administrator,root,system,support,help,security,moderator,staff,official,inspire,api,auth,www,mail,me,settings,login,logout,signup,register,null,undefined"`
+		// ReservedPath is an optional file with more reserved names, like offensive words, one per line.
+		ReservedPath string `env:"USERNAMES_RESERVED_PATH"`
+		// Cooldown is how long the previous username resolves to the user and can not be taken by others.
+		Cooldown      time.Duration `env:"USERNAMES_COOLDOWN" envDefault:"720h"`
+		PruneInterval time.Duration `env:"USERNAMES_PRUNE_INTERVAL" envDefault:"1h"`
+	}
+
+	Avatars struct {
+		// Sizes are the square variants stored for every avatar, the smallest one is also the minimal upload size.
+		Sizes       []int `env:"AVATARS_SIZES" envDefault:"40,128,512"`
+		JPEGQuality int   `env:"AVATARS_JPEG_QUALITY" envDefault:"85"`
+		// MaxPixels is checked before the image is decoded, so huge images can not exhaust the memory.
+		MaxPixels int `env:"AVATARS_MAX_PIXELS" envDefault:"40000000"`
+	}
+
+	Links struct {
+		// SigningKey signs the links sent by email, changing it invalidates the issued links.
+		SigningKey string        `env:"LINKS_SIGNING_KEY,required"`
+		TTL        time.Duration `env:"LINKS_TTL" envDefault:"15m"`
+		// NonceCookie binds the links to the browser which requested them.
+		NonceCookie string `env:"LINKS_NONCE_COOKIE" envDefault:"link_nonce"`
+	}
+
+	Devices struct {
+		// Cookie identifies the browser, clients without cookies send X-Device-ID header instead.
+		Cookie string `env:"DEVICES_COOKIE" envDefault:"device_id"`
+		// RevokeLinkTTL is how long the link from the new device notification can sign the device out.
+		RevokeLinkTTL time.Duration `env:"DEVICES_REVOKE_LINK_TTL" envDefault:"72h"`
+	}
+
+	PersonalTokens struct {
+		// Scopes can be granted to the personal access tokens, the policies of the procedures use the same names.
+		Scopes     []string      `env:"PERSONAL_TOKENS_SCOPES" envDefault:"profile:read,posts:read,posts:write,search:read,follows:read,follows:write"`
+		MaxPerUser int           `env:"PERSONAL_TOKENS_MAX_PER_USER" envDefault:"20"`
+		MaxTTL     time.Duration `env:"PERSONAL_TOKENS_MAX_TTL" envDefault:"8760h"`
+		// LastUsedInterval limits how often the last usage time is written for a token.
+		LastUsedInterval time.Duration `env:"PERSONAL_TOKENS_LAST_USED_INTERVAL" envDefault:"1m"`
+	}
+
+	OIDC struct {
+		// Providers are the names of OpenID Connect providers, each one is configured
+		// with OIDC_<NAME>_* variables, see OIDCProvider.
+		Providers []string `env:"OIDC_PROVIDERS"`
+		// AuthorizationTTL limits the time the user has to sign in at the provider.
+		AuthorizationTTL time.Duration `env:"OIDC_AUTHORIZATION_TTL" envDefault:"10m"`
+
+		ProvidersConfig []OIDCProvider `env:"-"`
+	}
+
+	MFA struct {
+		TOTPIssuer           string        `env:"MFA_TOTP_ISSUER" envDefault:"Inspire"`
+		ChallengeDuration    time.Duration `env:"MFA_CHALLENGE_DURATION" envDefault:"5m"`
+		MaxChallengeAttempts int64         `env:"MFA_MAX_CHALLENGE_ATTEMPTS" envDefault:"5"`
+		RecoveryCodesCount   int           `env:"MFA_RECOVERY_CODES_COUNT" envDefault:"10"`
 	}
 
 	DB struct {
@@ -41,16 +199,52 @@ type Config struct {
 		RedisDSN    string `env:"REDIS_DSN,required"`
 	}
 
-	TestMode bool `env:"TEST_MODE"`
+	Mail struct {
+		// Transport is smtp, maildir or memory.
+		Transport   string `env:"MAIL_TRANSPORT" envDefault:"smtp"`
+		MaildirPath string `env:"MAIL_MAILDIR_PATH" envDefault:"./mailbox"`
+		// DefaultLocale is used for users without locale and for locales without translations.
+		DefaultLocale string `env:"MAIL_DEFAULT_LOCALE" envDefault:"en"`
+
+		DeliveryInterval  time.Duration `env:"MAIL_DELIVERY_INTERVAL" envDefault:"1s"`
+		DeliveryBatchSize int           `env:"MAIL_DELIVERY_BATCH_SIZE" envDefault:"20"`
+		// SendTimeout also bounds how long a mail stays claimed by the replica sending it.
+		SendTimeout    time.Duration `env:"MAIL_SEND_TIMEOUT" envDefault:"1m"`
+		MaxAttempts    int           `env:"MAIL_MAX_ATTEMPTS" envDefault:"8"`
+		RetryBaseDelay time.Duration `env:"MAIL_RETRY_BASE_DELAY" envDefault:"30s"`
+		RetryMaxDelay  time.Duration `env:"MAIL_RETRY_MAX_DELAY" envDefault:"1h"`
+	}
 
+	// SMTP is required when MAIL_TRANSPORT is smtp.
 	SMTP struct {
-		From     string `env:"EMAILS_SMTP_FROM,required"`
-		Password string `env:"EMAILS_SMTP_PASSWORD,required"`
-		Host     string `env:"EMAILS_SMTP_HOST,required"`
-		Port     string `env:"EMAILS_SMTP_PORT,required"`
+		From     string `env:"EMAILS_SMTP_FROM"`
+		Password string `env:"EMAILS_SMTP_PASSWORD"`
+		Host     string `env:"EMAILS_SMTP_HOST"`
+		Port     string `env:"EMAILS_SMTP_PORT"`
 	}
 }
 
+// maxPresignTTL is the longest lifetime of S3 presigned urls.
+const maxPresignTTL = 7 * 24 * time.Hour
+
+type OIDCProvider struct {
+	Name string `env:"-"`
+
+	// Issuer is used to discover the endpoints of the provider.
+	Issuer       string   `env:"ISSUER,required"`
+	ClientID     string   `env:"CLIENT_ID,required"`
+	ClientSecret string   `env:"CLIENT_SECRET"`
+	Scopes       []string `env:"SCOPES" envDefault:"openid,email,profile"`
+}
+
+type ServiceClient struct {
+	ID string `env:"-"`
+
+	Secret string `env:"SECRET,required"`
+	// Scopes are allowed to be requested by the client.
+	Scopes []string `env:"SCOPES,required"`
+}
+
 func New() (*Config, error) {
 	var cfg Config
 	err := env.Parse(&cfg)
@@ -58,5 +252,101 @@ func New() (*Config, error) {
 		return nil, errors.Errorf("parse env: %w", err)
 	}
 
+	for _, proxy := range cfg.Server.TrustedProxies {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(proxy))
+		if err != nil {
+			return nil, errors.Errorf("parse SERVER_TRUSTED_PROXIES: %w", err)
+		}
+		cfg.Server.TrustedProxyPrefixes = append(cfg.Server.TrustedProxyPrefixes, prefix)
+	}
+
+	if len(cfg.Server.CORSAllowedOrigins) == 0 {
+		applicationURL, err := url.Parse(cfg.ApplicationURL)
+		if err != nil || applicationURL.Scheme == "" || applicationURL.Host == "" {
+			return nil, errors.Errorf("APPLICATION_URL '%s' is not an absolute url", cfg.ApplicationURL)
+		}
+		cfg.Server.CORSAllowedOrigins = []string{applicationURL.Scheme + "://" + applicationURL.Host}
+	}
+	if slices.Contains(cfg.Server.CORSAllowedOrigins, "*") {
+		return nil, errors.New("CORS_ALLOWED_ORIGINS must list the origins explicitly, '*' is not allowed with credentials")
+	}
+
+	if cfg.JWT.UserJWKPath == "" && cfg.JWT.UserKeysDir == "" {
+		return nil, errors.New("either JWT_USER_KEY_PATH or JWT_USER_KEYS_DIR is required")
+	}
+	if cfg.JWT.UserKeysDir != "" && cfg.JWT.RetiredKeysPath == "" {
+		cfg.JWT.RetiredKeysPath = filepath.Join(cfg.JWT.UserKeysDir, "retired.json")
+	}
+
+	if cfg.Session.MaxAllowedSessionsPerUser < 1 {
+		return nil, errors.New("MAX_ALLOWED_SESSIONS_PER_USER must be at least 1")
+	}
+
+	if cfg.Outbox.RelayLease <= 0 {
+		return nil, errors.New("OUTBOX_RELAY_LEASE must be positive")
+	}
+
+	if !cfg.Session.EvictionPolicy.Valid() {
+		return nil, errors.Errorf("invalid session eviction policy '%s'", cfg.Session.EvictionPolicy)
+	}
+
+	if cfg.DataExports.TTL > maxPresignTTL {
+		return nil, errors.Errorf("DATA_EXPORTS_TTL must not exceed %s", maxPresignTTL)
+	}
+
+	if cfg.Usernames.ReservedPath != "" {
+		reserved, err := readReservedUsernames(cfg.Usernames.ReservedPath)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Usernames.Reserved = append(cfg.Usernames.Reserved, reserved...)
+	}
+
+	if len(cfg.Avatars.Sizes) == 0 || slices.Min(cfg.Avatars.Sizes) <= 0 {
+		return nil, errors.New("AVATARS_SIZES must be positive numbers")
+	}
+	slices.Sort(cfg.Avatars.Sizes)
+
+	for _, name := range cfg.OIDC.Providers {
+		provider := OIDCProvider{Name: name}
+
+		err = env.ParseWithOptions(&provider, env.Options{Prefix: "OIDC_" + strings.ToUpper(name) + "_"})
+		if err != nil {
+			return nil, errors.Errorf("parse oidc provider '%s': %w", name, err)
+		}
+
+		cfg.OIDC.ProvidersConfig = append(cfg.OIDC.ProvidersConfig, provider)
+	}
+
+	for _, id := range cfg.ServiceClients.Names {
+		client := ServiceClient{ID: id}
+
+		prefix := "SERVICE_CLIENT_" + strings.ToUpper(strings.ReplaceAll(id, "-", "_")) + "_"
+
+		if err = env.ParseWithOptions(&client, env.Options{Prefix: prefix}); err != nil {
+			return nil, errors.Errorf("parse service client '%s': %w", id, err)
+		}
+
+		cfg.ServiceClients.Clients = append(cfg.ServiceClients.Clients, client)
+	}
+
 	return &cfg, nil
 }
+
+// readReservedUsernames reads the names from the file, empty lines and lines starting with # are skipped.
+func readReservedUsernames(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Errorf("read USERNAMES_RESERVED_PATH: %w", err)
+	}
+
+	var names []string
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			names = append(names, line)
+		}
+	}
+
+	return names, nil
+}
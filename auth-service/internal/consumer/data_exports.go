@@ -0,0 +1,122 @@
+package consumer
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+)
+
+type DataExportPartsProcessor interface {
+	CompleteDataExportPart(ctx context.Context, event models.DataExportPartCompletedEvent) error
+}
+
+// StartDataExportPartsConsumer collects the parts of the data exports sent by other services.
+func StartDataExportPartsConsumer(
+	js nats.JetStreamContext, lc fx.Lifecycle, processor DataExportPartsProcessor,
+) error {
+	process := func(msg *nats.Msg) error {
+		var payload authv1.UserExportPartCompletedEvent
+		if err := proto.Unmarshal(msg.Data, &payload); err != nil {
+			// the message can not be processed anyway
+			_ = msg.Term()
+			return errors.Errorf("unmarshal export part completed event: %w", err)
+		}
+
+		event, err := exportPartCompletedEventFromPB(&payload)
+		if err != nil {
+			_ = msg.Term()
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		if err = processor.CompleteDataExportPart(ctx, event); err != nil {
+			return errors.Errorf("handle export part completed event: %w", err)
+		}
+
+		if err = msg.Ack(); err != nil {
+			return errors.Errorf("ack event: %w", err)
+		}
+
+		slog.Info("processed export part completed event",
+			slog.String("sub", msg.Subject),
+			slog.String("service", event.Service),
+		)
+
+		return nil
+	}
+
+	shutDownCtx, cancel := context.WithCancel(context.Background())
+
+	sub, err := js.QueueSubscribe(
+		"users.*.export_part_completed",
+		"auth-service-users-workers",
+		func(msg *nats.Msg) {
+			if err := process(msg); err != nil {
+				slog.Error("failed to process export part completed event",
+					slog.String("subject", msg.Subject),
+					logger.Error(err),
+				)
+			}
+		},
+		nats.Durable("auth-service-consumer-users-export-part-completed"),
+		nats.ManualAck(),
+		nats.Context(shutDownCtx),
+	)
+	if err != nil {
+		cancel()
+		return errors.Errorf("subscribe: %w", err)
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			cancel()
+
+			if err := sub.Drain(); err != nil {
+				return errors.Errorf("drain subscription: %w", err)
+			}
+
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func exportPartCompletedEventFromPB(payload *authv1.UserExportPartCompletedEvent) (models.DataExportPartCompletedEvent, error) {
+	exportID, err := uuid.Parse(payload.ExportId)
+	if err != nil {
+		return models.DataExportPartCompletedEvent{}, errors.Errorf("parse export id: %w", err)
+	}
+
+	userID, err := uuid.Parse(payload.UserId)
+	if err != nil {
+		return models.DataExportPartCompletedEvent{}, errors.Errorf("parse user id: %w", err)
+	}
+
+	event := models.DataExportPartCompletedEvent{
+		ExportID:    exportID,
+		UserID:      userID,
+		Service:     payload.Service,
+		CompletedAt: payload.CompletedAt.AsTime(),
+	}
+
+	switch part := payload.Part.(type) {
+	case *authv1.UserExportPartCompletedEvent_ArchiveKey:
+		event.ArchiveKey = &part.ArchiveKey
+	case *authv1.UserExportPartCompletedEvent_Data:
+		event.Data = part.Data
+	}
+
+	return event, nil
+}
@@ -0,0 +1,181 @@
+package consumer
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+)
+
+type DeletionCompletedProcessor interface {
+	CompleteServiceDeletion(ctx context.Context, event models.UserDeletionCompletedEvent) error
+}
+
+// StartDeletionCompletedConsumer tracks which services have deleted the data of the deleted users.
+func StartDeletionCompletedConsumer(
+	js nats.JetStreamContext, lc fx.Lifecycle, processor DeletionCompletedProcessor,
+) error {
+	process := func(msg *nats.Msg) error {
+		var payload authv1.UserDeletionCompletedEvent
+		if err := proto.Unmarshal(msg.Data, &payload); err != nil {
+			// the message can not be processed anyway
+			_ = msg.Term()
+			return errors.Errorf("unmarshal user deletion completed event: %w", err)
+		}
+
+		event, err := deletionCompletedEventFromPB(&payload)
+		if err != nil {
+			_ = msg.Term()
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		if err = processor.CompleteServiceDeletion(ctx, event); err != nil {
+			return errors.Errorf("handle user deletion completed event: %w", err)
+		}
+
+		if err = msg.Ack(); err != nil {
+			return errors.Errorf("ack event: %w", err)
+		}
+
+		slog.Info("processed user deletion completed event",
+			slog.String("sub", msg.Subject),
+			slog.String("service", event.Service),
+		)
+
+		return nil
+	}
+
+	shutDownCtx, cancel := context.WithCancel(context.Background())
+
+	sub, err := js.QueueSubscribe(
+		"users.*.deletion_completed",
+		"auth-service-users-workers",
+		func(msg *nats.Msg) {
+			if err := process(msg); err != nil {
+				slog.Error("failed to process user deletion completed event",
+					slog.String("subject", msg.Subject),
+					logger.Error(err),
+				)
+			}
+		},
+		nats.Durable("auth-service-consumer-users-deletion-completed"),
+		nats.ManualAck(),
+		nats.Context(shutDownCtx),
+	)
+	if err != nil {
+		cancel()
+		return errors.Errorf("subscribe: %w", err)
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			cancel()
+
+			if err := sub.Drain(); err != nil {
+				return errors.Errorf("drain subscription: %w", err)
+			}
+
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func deletionCompletedEventFromPB(payload *authv1.UserDeletionCompletedEvent) (models.UserDeletionCompletedEvent, error) {
+	userID, err := uuid.Parse(payload.UserId)
+	if err != nil {
+		return models.UserDeletionCompletedEvent{}, errors.Errorf("parse user id: %w", err)
+	}
+
+	return models.UserDeletionCompletedEvent{
+		UserID:      userID,
+		Service:     payload.Service,
+		CompletedAt: payload.CompletedAt.AsTime(),
+	}, nil
+}
+
+type UserDeletedProcessor interface {
+	PurgeDeletedUser(ctx context.Context, userID uuid.UUID) error
+}
+
+// StartUserDeletedConsumer removes the data of the deleted users stored outside of postgres,
+// the same way other services do.
+func StartUserDeletedConsumer(js nats.JetStreamContext, lc fx.Lifecycle, processor UserDeletedProcessor) error {
+	process := func(msg *nats.Msg) error {
+		// only the id is set in the payload of the deleted user
+		var user authv1.User
+		if err := proto.Unmarshal(msg.Data, &user); err != nil {
+			_ = msg.Term()
+			return errors.Errorf("unmarshal user deleted event: %w", err)
+		}
+
+		userID, err := uuid.Parse(user.Id)
+		if err != nil {
+			_ = msg.Term()
+			return errors.Errorf("parse user id: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if err = processor.PurgeDeletedUser(ctx, userID); err != nil {
+			return errors.Errorf("handle user deleted event: %w", err)
+		}
+
+		if err = msg.Ack(); err != nil {
+			return errors.Errorf("ack event: %w", err)
+		}
+
+		slog.Info("processed user deleted event", slog.String("sub", msg.Subject))
+
+		return nil
+	}
+
+	shutDownCtx, cancel := context.WithCancel(context.Background())
+
+	sub, err := js.QueueSubscribe(
+		"users.*.deleted",
+		"auth-service-users-workers",
+		func(msg *nats.Msg) {
+			if err := process(msg); err != nil {
+				slog.Error("failed to process user deleted event",
+					slog.String("subject", msg.Subject),
+					logger.Error(err),
+				)
+			}
+		},
+		nats.Durable("auth-service-consumer-users-deleted"),
+		nats.ManualAck(),
+		nats.Context(shutDownCtx),
+	)
+	if err != nil {
+		cancel()
+		return errors.Errorf("subscribe: %w", err)
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			cancel()
+
+			if err := sub.Drain(); err != nil {
+				return errors.Errorf("drain subscription: %w", err)
+			}
+
+			return nil
+		},
+	})
+
+	return nil
+}
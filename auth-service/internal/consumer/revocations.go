@@ -0,0 +1,31 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
+	"go.uber.org/fx"
+)
+
+// StartRevocationsConsumer keeps the revocation list, checked by the auth middleware, up to date.
+func StartRevocationsConsumer(js nats.JetStreamContext, lc fx.Lifecycle, list *revocation.List) {
+	var stop func() error
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			var err error
+
+			stop, err = revocation.Subscribe(js, list)
+			if err != nil {
+				return errors.Errorf("subscribe to revocations: %w", err)
+			}
+
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			return stop()
+		},
+	})
+}
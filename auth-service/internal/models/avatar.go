@@ -0,0 +1,8 @@
+package models
+
+// AvatarVariant is the avatar resized to a square of Size pixels.
+type AvatarVariant struct {
+	Size int `json:"size"`
+	// URL is the path of the object in the avatars bucket, like the avatar url of the user.
+	URL string `json:"url"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// ClientInfo describes the device the request was made from.
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	// Location is approximate location (country or city) resolved by the proxy.
+	Location string
+	// DeviceName is provided by the client or derived from the user agent.
+	DeviceName string
+	// Locale is the most preferred language from Accept-Language header.
+	Locale string
+	// LinkNonce identifies the browser, the links sent by email can be used only from the browser requested them.
+	LinkNonce string
+	// DeviceID is provided by the client or taken from the cookie, it is used to recognize known devices.
+	DeviceID string
+}
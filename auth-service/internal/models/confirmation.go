@@ -13,12 +13,20 @@ type ConfirmationUserData struct {
 	Username     string
 	Name         string
 	PasswordHash string
+	Locale       string
 	ExpiresAt    time.Time // To track expiration time for the confirmation code
+
+	// LinkID is the link sent with the code, it is deleted when the code is used.
+	LinkID string
 }
 
+// ResetPasswordData is the code sent to the email of the user. It is also used to confirm the new email.
 type ResetPasswordData struct {
 	UserID    uuid.UUID
 	Code      string
 	Email     string
 	ExpiresAt time.Time
+
+	// LinkID is the link sent with the code, it is deleted when the code is used.
+	LinkID string
 }
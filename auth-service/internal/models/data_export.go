@@ -0,0 +1,50 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataExportStatus string
+
+const (
+	DataExportPending DataExportStatus = "pending"
+	DataExportReady   DataExportStatus = "ready"
+	DataExportFailed  DataExportStatus = "failed"
+	// DataExportExpired exports have their archive deleted.
+	DataExportExpired DataExportStatus = "expired"
+)
+
+// DataExport collects the data of the user from every service into a single archive.
+type DataExport struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Status DataExportStatus
+	// ArchiveKey is set only for ready exports.
+	ArchiveKey *string
+
+	RequestedAt time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+}
+
+// DataExportPart is the data sent by another service. Services with media upload an archive
+// to the exports bucket, the others send the data in the event.
+type DataExportPart struct {
+	Service     string
+	ArchiveKey  *string
+	Data        json.RawMessage
+	CompletedAt time.Time
+}
+
+// DataExportPartCompletedEvent is published by the services which have collected the user data.
+type DataExportPartCompletedEvent struct {
+	ExportID    uuid.UUID
+	UserID      uuid.UUID
+	Service     string
+	ArchiveKey  *string
+	Data        json.RawMessage
+	CompletedAt time.Time
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthServiceName is used to record the cleanup done by this service.
+const AuthServiceName = "auth-service"
+
+// AccountDeletion is performed after the grace period, until then it can be cancelled by the user.
+type AccountDeletion struct {
+	UserID      uuid.UUID
+	RequestedAt time.Time
+	PurgeAfter  time.Time
+
+	// DeletedAt is set when the user is deleted and other services are notified.
+	DeletedAt *time.Time
+	// CompletedServices contains the time every service has finished the cleanup of the user data.
+	CompletedServices map[string]time.Time
+}
+
+func (d AccountDeletion) Pending() bool {
+	return d.DeletedAt == nil
+}
+
+// UserDeletionCompletedEvent is published by the services which have deleted the user data.
+type UserDeletionCompletedEvent struct {
+	UserID      uuid.UUID
+	Service     string
+	CompletedAt time.Time
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserEventType is the last token of the subject the event is published to: users.<user_id>.<type>.
+type UserEventType string
+
+const (
+	UserEventCreated       UserEventType = "created"
+	UserEventUpdated       UserEventType = "updated"
+	UserEventAvatarChanged UserEventType = "avatar_changed"
+	UserEventDeleted       UserEventType = "deleted"
+	UserEventSuspended     UserEventType = "suspended"
+	UserEventUnsuspended   UserEventType = "unsuspended"
+	// UserEventExportRequested asks other services to collect the data of the user.
+	UserEventExportRequested UserEventType = "export_requested"
+	// UserEventFollowed and UserEventUnfollowed are published to the subject of the follower.
+	UserEventFollowed   UserEventType = "followed"
+	UserEventUnfollowed UserEventType = "unfollowed"
+)
+
+// UserEvent is written to the outbox together with the change of the user and published afterward.
+type UserEvent struct {
+	// ID is used by the stream to deduplicate the event.
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Type   UserEventType
+	// Payload is auth.v1.User encoded with protobuf, only the id is set for deleted users.
+	// Export requests carry auth.v1.UserExportRequestedEvent and follow changes carry auth.v1.UserFollowEvent.
+	Payload    []byte
+	OccurredAt time.Time
+}
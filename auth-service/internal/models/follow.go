@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Follow is the edge of the follow graph, the follower sees the posts of the followee in the feed.
+type Follow struct {
+	FollowerID uuid.UUID
+	FolloweeID uuid.UUID
+	CreatedAt  time.Time
+}
+
+// FollowStatus describes the follows between the user and other user.
+type FollowStatus struct {
+	// Following is true if the user follows the other user.
+	Following bool
+	// FollowedBy is true if the other user follows the user.
+	FollowedBy bool
+}
+
+func (s FollowStatus) Mutual() bool {
+	return s.Following && s.FollowedBy
+}
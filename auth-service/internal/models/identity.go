@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity is an account of the OpenID Connect provider linked to the user.
+type UserIdentity struct {
+	Provider string
+	// Subject is unique only within the provider.
+	Subject string
+	UserID  uuid.UUID
+	// Email is the email of the provider account when it was linked, it is shown to the user.
+	Email     string
+	CreatedAt time.Time
+}
+
+// OIDCClaims are taken from the validated id token.
+type OIDCClaims struct {
+	Provider          string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// OIDCAuthorization is stored from the redirect to the provider until the callback.
+// State is sent to the provider and returned back, it is the key of the authorization.
+type OIDCAuthorization struct {
+	State        string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	// BrowserNonceHash binds the callback to the browser started the authorization.
+	BrowserNonceHash []byte
+	ExpiresAt        time.Time
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LinkPurpose string
+
+const (
+	LinkPurposeConfirmEmail  LinkPurpose = "confirm_email"
+	LinkPurposeResetPassword LinkPurpose = "reset_password"
+	LinkPurposeLogin         LinkPurpose = "login"
+	LinkPurposeLinkIdentity  LinkPurpose = "link_identity"
+	LinkPurposeRevokeSession LinkPurpose = "revoke_session"
+)
+
+// BoundToBrowser reports whether the link can be used only from the browser which requested it.
+// Revoke session link is sent because of the sign in from another device, so it is opened elsewhere.
+func (p LinkPurpose) BoundToBrowser() bool {
+	return p != LinkPurposeRevokeSession
+}
+
+// Link is a single-use link sent by email. Unless the purpose says otherwise, it can be used only
+// from the browser which requested it: the hash of the nonce from the cookie of that browser is stored with the link.
+type Link struct {
+	ID        string
+	Purpose   LinkPurpose
+	Email     string
+	NonceHash []byte
+	ExpiresAt time.Time
+
+	// UserID is empty for LinkPurposeConfirmEmail, as the user is not created yet.
+	UserID uuid.UUID
+	// Registration is set only for LinkPurposeConfirmEmail.
+	Registration *ConfirmationUserData
+	// Identity is set only for LinkPurposeLinkIdentity.
+	Identity *UserIdentity
+	// SessionID is set only for LinkPurposeRevokeSession.
+	SessionID *uuid.UUID
+}
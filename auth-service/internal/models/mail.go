@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type QueuedMail struct {
+	ID      int64
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+
+	// Attempts is the number of failed attempts to deliver the mail.
+	Attempts  int
+	CreatedAt time.Time
+	// ClaimedUntil is the lease of the replica that claimed the mail for delivery.
+	ClaimedUntil time.Time
+}
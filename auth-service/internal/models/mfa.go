@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserTOTP struct {
+	UserID uuid.UUID
+	Secret []byte
+
+	ConfirmedAt     *time.Time
+	LastUsedCounter int64
+
+	CreatedAt time.Time
+}
+
+// Enabled reports whether the enrollment was confirmed by the user.
+func (t UserTOTP) Enabled() bool {
+	return t.ConfirmedAt != nil
+}
+
+// MFAChallenge is issued after successful password check for users with enabled MFA.
+// Session is created only after the challenge is solved.
+type MFAChallenge struct {
+	Token     string
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+}
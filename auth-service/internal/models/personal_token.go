@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PersonalToken is a long-lived credential of the user for scripts and integrations.
+// Only the hash of the token is stored, the token is shown once when it is created.
+type PersonalToken struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Name   string
+	Scopes []string
+
+	ExpiresAt time.Time
+	// LastUsedAt is nil if the token has never been used, it is not updated on every request.
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (t PersonalToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SecurityEventType string
+
+const (
+	SecurityEventLogin                    SecurityEventType = "login"
+	SecurityEventLogout                   SecurityEventType = "logout"
+	SecurityEventPasswordReset            SecurityEventType = "password_reset"
+	SecurityEventPasswordChange           SecurityEventType = "password_change"
+	SecurityEventEmailChange              SecurityEventType = "email_change"
+	SecurityEventSessionRevoked           SecurityEventType = "session_revoked"
+	SecurityEventMFAEnabled               SecurityEventType = "mfa_enabled"
+	SecurityEventMFADisabled              SecurityEventType = "mfa_disabled"
+	SecurityEventRecoveryCodesRegenerated SecurityEventType = "recovery_codes_regenerated"
+	SecurityEventIdentityLinked           SecurityEventType = "identity_linked"
+	SecurityEventIdentityUnlinked         SecurityEventType = "identity_unlinked"
+	SecurityEventPersonalTokenCreated     SecurityEventType = "personal_token_created"
+	SecurityEventPersonalTokenRevoked     SecurityEventType = "personal_token_revoked"
+	SecurityEventDataExportRequested      SecurityEventType = "data_export_requested"
+
+	// the events below are made by administrators
+
+	SecurityEventUserSuspended         SecurityEventType = "user_suspended"
+	SecurityEventUserBanned            SecurityEventType = "user_banned"
+	SecurityEventUserUnbanned          SecurityEventType = "user_unbanned"
+	SecurityEventAdminRightsChanged    SecurityEventType = "admin_rights_changed"
+	SecurityEventUserDeletionScheduled SecurityEventType = "user_deletion_scheduled"
+)
+
+type SecurityEventOutcome string
+
+const (
+	SecurityEventSuccess SecurityEventOutcome = "success"
+	SecurityEventFailure SecurityEventOutcome = "failure"
+)
+
+// SecurityEvent is the record of the audit log. Records are never changed, only deleted
+// when the retention period is over.
+type SecurityEvent struct {
+	ID int64
+	// UserID is nil if the event could not be attributed to a user, e.g. sign in with unknown email.
+	UserID *uuid.UUID
+	// ActorID is the administrator, if the action was made on behalf of the user.
+	ActorID *uuid.UUID
+
+	Type    SecurityEventType
+	Outcome SecurityEventOutcome
+
+	IP        string
+	UserAgent string
+	SessionID *uuid.UUID
+	// Metadata describes the details of the event, e.g. sign in method or failure reason.
+	Metadata map[string]string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,8 @@
+package models
+
+// ServiceClient is another service of the backend authenticated with the client credentials.
+type ServiceClient struct {
+	ID string
+	// Scopes are granted to the issued token.
+	Scopes []string
+}
@@ -9,8 +9,23 @@ import (
 type Session struct {
 	ID uuid.UUID
 
-	UserID    uuid.UUID
-	Token     string
-	CreatedAt time.Time
-	ExpiresAt time.Time
+	UserID      uuid.UUID
+	Token       string
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+	ExpiresAt   time.Time
+
+	Client ClientInfo
+}
+
+// SessionEvictionPolicy defines which sessions are ended when the user exceeds the sessions limit.
+type SessionEvictionPolicy string
+
+const (
+	EvictOldestCreated          SessionEvictionPolicy = "oldest_created"
+	EvictLeastRecentlyRefreshed SessionEvictionPolicy = "least_recently_refreshed"
+)
+
+func (p SessionEvictionPolicy) Valid() bool {
+	return p == EvictOldestCreated || p == EvictLeastRecentlyRefreshed
 }
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusBanned    UserStatus = "banned"
+)
+
+func (s UserStatus) Valid() bool {
+	return s == UserStatusActive || s == UserStatusSuspended || s == UserStatusBanned
+}
+
+// UserSuspension prevents the user from signing in until it expires.
+type UserSuspension struct {
+	UserID uuid.UUID
+	Reason string
+
+	// ExpiresAt is nil for permanent bans.
+	ExpiresAt   *time.Time
+	SuspendedBy *uuid.UUID
+
+	CreatedAt time.Time
+}
+
+func (s UserSuspension) Status(now time.Time) UserStatus {
+	switch {
+	case s.ExpiresAt == nil:
+		return UserStatusBanned
+	case now.Before(*s.ExpiresAt):
+		return UserStatusSuspended
+	default:
+		return UserStatusActive
+	}
+}
@@ -20,4 +20,29 @@ type User struct {
 	CreatedAt time.Time
 
 	AvatarURL *string
+	// AvatarVariants are the square sizes of the avatar ordered by size, empty for the avatars
+	// uploaded before the processing.
+	AvatarVariants []AvatarVariant
+
+	// FollowersCount and FollowingCount are updated with the follows.
+	FollowersCount int64
+	FollowingCount int64
+
+	// Locale of the mails, empty if the default one should be used.
+	Locale string
+
+	// NotifyNewDevices is false if the user has opted out of the new device notifications.
+	NotifyNewDevices bool
+
+	// TokensRevision is put into the access tokens, the tokens of older revisions are revoked.
+	TokensRevision int64
+}
+
+// PreviousUsername is kept after the username is changed: it resolves to the user and can not be
+// taken by others until ReleasedAt.
+type PreviousUsername struct {
+	Username   string
+	UserID     uuid.UUID
+	ChangedAt  time.Time
+	ReleasedAt time.Time
 }
@@ -0,0 +1,31 @@
+package proto
+
+import (
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
+)
+
+func User(u models.User) *authv1.User {
+	return &authv1.User{
+		Id: u.ID.String(),
+		Username: &authv1.Username{
+			Value: u.Username,
+		},
+		Name: &authv1.Name{
+			Value: u.Name,
+		},
+		AvatarUrl:      u.AvatarURL,
+		Description:    u.Description,
+		AvatarVariants: generics.Convert(u.AvatarVariants, avatarVariant),
+		FollowersCount: u.FollowersCount,
+		FollowingCount: u.FollowingCount,
+	}
+}
+
+func avatarVariant(v models.AvatarVariant) *authv1.AvatarVariant {
+	return &authv1.AvatarVariant{
+		Size: int32(v.Size),
+		Url:  v.URL,
+	}
+}
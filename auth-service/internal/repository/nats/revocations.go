@@ -0,0 +1,57 @@
+package nats
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
+)
+
+// RevocationsPublisher notifies services validating access tokens that the tokens were revoked.
+type RevocationsPublisher struct {
+	js         nats.JetStreamContext
+	streamName string
+
+	accessTokenDuration time.Duration
+}
+
+func NewRevocationsPublisher(js nats.JetStreamContext, cfg *config.Config) *RevocationsPublisher {
+	return &RevocationsPublisher{
+		js:                  js,
+		streamName:          cfg.Nats.RevocationsStreamName,
+		accessTokenDuration: cfg.JWT.AccessTokenDuration,
+	}
+}
+
+func (p RevocationsPublisher) RevokeSessionTokens(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	expiresAt := time.Now().Add(p.accessTokenDuration)
+
+	for _, sessionID := range sessionIDs {
+		err := revocation.Publish(ctx, p.js, p.streamName, revocation.Event{
+			SessionID: sessionID,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return errors.Errorf("revoke session '%s': %w", sessionID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p RevocationsPublisher) RevokeUserTokens(ctx context.Context, userID uuid.UUID, revision int64) error {
+	err := revocation.Publish(ctx, p.js, p.streamName, revocation.Event{
+		UserID:         userID,
+		TokensRevision: revision,
+		ExpiresAt:      time.Now().Add(p.accessTokenDuration),
+	})
+	if err != nil {
+		return errors.Errorf("revoke user '%s' tokens: %w", userID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+// occurredAtHeader keeps the time of the change, as the event can be published much later.
+const occurredAtHeader = "Occurred-At"
+
+// UserEventsPublisher notifies other services about changes of the users.
+type UserEventsPublisher struct {
+	js         nats.JetStreamContext
+	streamName string
+}
+
+func NewUserEventsPublisher(js nats.JetStreamContext, cfg *config.Config) *UserEventsPublisher {
+	return &UserEventsPublisher{
+		js:         js,
+		streamName: cfg.Nats.UsersStreamName,
+	}
+}
+
+// PublishUserEvent publishes the event to users.<user_id>.<type>. The event id is used as Nats-Msg-Id,
+// so events published again after a failure are dropped by the stream.
+func (p UserEventsPublisher) PublishUserEvent(ctx context.Context, event models.UserEvent) error {
+	subject := fmt.Sprintf("users.%s.%s", event.UserID, event.Type)
+
+	msg := nats.NewMsg(subject)
+	msg.Data = event.Payload
+	msg.Header.Set(occurredAtHeader, event.OccurredAt.UTC().Format(time.RFC3339Nano))
+
+	pubOpts := []nats.PubOpt{
+		nats.Context(ctx),
+		nats.ExpectStream(p.streamName),
+		nats.MsgId(event.ID.String()),
+	}
+	if _, err := p.js.PublishMsg(msg, pubOpts...); err != nil {
+		return errors.Errorf("publish %s: %w", subject, err)
+	}
+
+	return nil
+}
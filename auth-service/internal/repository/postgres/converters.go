@@ -1,11 +1,23 @@
 package postgres
 
 import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-errors/errors"
 	"github.com/tech-inspire/backend/auth-service/internal/models"
 	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
 )
 
-func userToModel(user sqlc.User) *models.User {
+func userToModel(user sqlc.User) (*models.User, error) {
+	var avatarVariants []models.AvatarVariant
+	if user.AvatarVariants != nil {
+		if err := json.Unmarshal(user.AvatarVariants, &avatarVariants); err != nil {
+			return nil, errors.Errorf("unmarshal avatar variants of user %s: %w", user.UserID, err)
+		}
+	}
+
 	return &models.User{
 		ID:          user.UserID,
 		Name:        user.Name,
@@ -15,5 +27,142 @@ func userToModel(user sqlc.User) *models.User {
 		IsAdmin:     user.IsAdmin,
 		CreatedAt:   user.CreatedAt,
 		AvatarURL:   user.AvatarUrl,
+		Locale:      generics.OrDefault(user.Locale, ""),
+
+		AvatarVariants:   avatarVariants,
+		FollowersCount:   user.FollowersCount,
+		FollowingCount:   user.FollowingCount,
+		NotifyNewDevices: user.NotifyNewDevices,
+		TokensRevision:   user.TokensRevision,
+	}, nil
+}
+
+func previousUsernameToModel(history sqlc.UsernameHistory) *models.PreviousUsername {
+	return &models.PreviousUsername{
+		Username:   history.Username,
+		UserID:     history.UserID,
+		ChangedAt:  history.ChangedAt,
+		ReleasedAt: history.ReleasedAt,
+	}
+}
+
+func userTOTPToModel(totp sqlc.UserTotp) *models.UserTOTP {
+	return &models.UserTOTP{
+		UserID:          totp.UserID,
+		Secret:          totp.Secret,
+		ConfirmedAt:     totp.ConfirmedAt,
+		LastUsedCounter: totp.LastUsedCounter,
+		CreatedAt:       totp.CreatedAt,
+	}
+}
+
+func userIdentityToModel(identity sqlc.UserIdentity) models.UserIdentity {
+	return models.UserIdentity{
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		UserID:    identity.UserID,
+		Email:     identity.Email,
+		CreatedAt: identity.CreatedAt,
+	}
+}
+
+func userSuspensionToModel(suspension sqlc.UserSuspension) *models.UserSuspension {
+	return &models.UserSuspension{
+		UserID:      suspension.UserID,
+		Reason:      suspension.Reason,
+		ExpiresAt:   suspension.ExpiresAt,
+		SuspendedBy: suspension.SuspendedBy,
+		CreatedAt:   suspension.CreatedAt,
+	}
+}
+
+func userDeletionToModel(deletion sqlc.UserDeletion, services []sqlc.UserDeletionService) *models.AccountDeletion {
+	completed := make(map[string]time.Time, len(services))
+	for _, service := range services {
+		completed[service.Service] = service.CompletedAt
+	}
+
+	return &models.AccountDeletion{
+		UserID:            deletion.UserID,
+		RequestedAt:       deletion.RequestedAt,
+		PurgeAfter:        deletion.PurgeAfter,
+		DeletedAt:         deletion.DeletedAt,
+		CompletedServices: completed,
+	}
+}
+
+func userEventToModel(event sqlc.UserEventsOutbox) models.UserEvent {
+	return models.UserEvent{
+		ID:         event.EventID,
+		UserID:     event.UserID,
+		Type:       models.UserEventType(event.EventType),
+		Payload:    event.Payload,
+		OccurredAt: event.OccurredAt,
+	}
+}
+
+func queuedMailToModel(mail sqlc.MailQueue) models.QueuedMail {
+	return models.QueuedMail{
+		ID:           mail.ID,
+		To:           mail.Recipient,
+		Subject:      mail.Subject,
+		Text:         mail.Body,
+		HTML:         mail.HtmlBody,
+		Attempts:     int(mail.Attempts),
+		CreatedAt:    mail.CreatedAt,
+		ClaimedUntil: mail.NextAttemptAt,
+	}
+}
+
+func securityEventToModel(event sqlc.SecurityEvent) (models.SecurityEvent, error) {
+	var metadata map[string]string
+	if err := json.Unmarshal(event.Metadata, &metadata); err != nil {
+		return models.SecurityEvent{}, errors.Errorf("unmarshal metadata of security event %d: %w", event.ID, err)
+	}
+
+	return models.SecurityEvent{
+		ID:        event.ID,
+		UserID:    event.UserID,
+		ActorID:   event.ActorID,
+		Type:      models.SecurityEventType(event.EventType),
+		Outcome:   models.SecurityEventOutcome(event.Outcome),
+		IP:        event.Ip,
+		UserAgent: event.UserAgent,
+		SessionID: event.SessionID,
+		Metadata:  metadata,
+		CreatedAt: event.CreatedAt,
+	}, nil
+}
+
+func personalTokenToModel(token sqlc.PersonalAccessToken) models.PersonalToken {
+	return models.PersonalToken{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+func dataExportToModel(export sqlc.DataExport) *models.DataExport {
+	return &models.DataExport{
+		ID:          export.ID,
+		UserID:      export.UserID,
+		Status:      models.DataExportStatus(export.Status),
+		ArchiveKey:  export.ArchiveKey,
+		RequestedAt: export.RequestedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+	}
+}
+
+func dataExportPartToModel(part sqlc.DataExportPart) models.DataExportPart {
+	return models.DataExportPart{
+		Service:     part.Service,
+		ArchiveKey:  part.ArchiveKey,
+		Data:        part.Data,
+		CompletedAt: part.CompletedAt,
 	}
 }
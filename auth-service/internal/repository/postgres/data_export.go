@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type DataExportsRepository struct {
+	repo *sqlc.Queries
+	pool *pgxpool.Pool
+}
+
+func NewDataExportsRepository(repo *sqlc.Queries, pool *pgxpool.Pool) *DataExportsRepository {
+	return &DataExportsRepository{repo: repo, pool: pool}
+}
+
+// CreateDataExport enqueues users.<id>.export_requested event with the export. Returns
+// apperrors.ErrDataExportLimit if the user has requested another export after requestedAfter,
+// failed exports are not counted.
+func (r *DataExportsRepository) CreateDataExport(ctx context.Context, export models.DataExport, requestedAfter time.Time) error {
+	payload, err := proto.Marshal(&authv1.UserExportRequestedEvent{
+		ExportId:    export.ID.String(),
+		UserId:      export.UserID.String(),
+		RequestedAt: timestamppb.New(export.RequestedAt),
+	})
+	if err != nil {
+		return errors.Errorf("marshal export requested event: %w", err)
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		// without the lock concurrent requests do not see the exports of each other and pass the limit
+		if err := q.LockUserDataExports(ctx, export.UserID); err != nil {
+			return errors.Errorf("sqlc: LockUserDataExports: %w", err)
+		}
+
+		affected, err := q.CreateDataExport(ctx, sqlc.CreateDataExportParams{
+			ID:             export.ID,
+			UserID:         export.UserID,
+			RequestedAfter: requestedAfter,
+		})
+		if err != nil {
+			return errors.Errorf("sqlc: CreateDataExport: %w", err)
+		}
+		if affected == 0 {
+			return apperrors.ErrDataExportLimit
+		}
+
+		return enqueueEvent(ctx, q, export.UserID, models.UserEventExportRequested, payload)
+	})
+}
+
+func (r *DataExportsRepository) GetLatestDataExport(ctx context.Context, userID uuid.UUID) (*models.DataExport, error) {
+	export, err := r.repo.GetLatestDataExport(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrDataExportNotFound
+		}
+		return nil, errors.Errorf("sqlc: GetLatestDataExport: %w", err)
+	}
+
+	return dataExportToModel(export), nil
+}
+
+func (r *DataExportsRepository) GetDataExportParts(ctx context.Context, exportID uuid.UUID) ([]models.DataExportPart, error) {
+	return getDataExportParts(ctx, r.repo, exportID)
+}
+
+// CreateDataExportPart ignores the parts sent again.
+func (r *DataExportsRepository) CreateDataExportPart(ctx context.Context, exportID uuid.UUID, part models.DataExportPart) error {
+	err := r.repo.CreateDataExportPart(ctx, sqlc.CreateDataExportPartParams{
+		ExportID:    exportID,
+		Service:     part.Service,
+		ArchiveKey:  part.ArchiveKey,
+		Data:        part.Data,
+		CompletedAt: part.CompletedAt,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: CreateDataExportPart: %w", err)
+	}
+
+	return nil
+}
+
+// ProcessNextDueDataExport locks the pending export which has every part of the services or
+// has been waiting for them since timedOutBefore. The export is finished with the result of the
+// process function. Exports locked by other replicas are skipped. Returns false if there are no due exports.
+func (r *DataExportsRepository) ProcessNextDueDataExport(
+	ctx context.Context,
+	timedOutBefore time.Time,
+	services []string,
+	process func(ctx context.Context, export models.DataExport, parts []models.DataExportPart) (*models.DataExport, error),
+) (bool, error) {
+	var found bool
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		export, err := q.LockNextDueDataExport(ctx, timedOutBefore, services)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return errors.Errorf("sqlc: LockNextDueDataExport: %w", err)
+		}
+		found = true
+
+		parts, err := getDataExportParts(ctx, q, export.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err := process(ctx, *dataExportToModel(export), parts)
+		if err != nil {
+			return err
+		}
+
+		err = q.FinishDataExport(ctx, sqlc.FinishDataExportParams{
+			Status:     string(result.Status),
+			ArchiveKey: result.ArchiveKey,
+			ExpiresAt:  result.ExpiresAt,
+			ID:         export.ID,
+		})
+		if err != nil {
+			return errors.Errorf("sqlc: FinishDataExport: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// ExpireNextDataExport locks the ready export expired before now and marks it expired
+// if the expire function succeeds. Returns false if there are no expired exports.
+func (r *DataExportsRepository) ExpireNextDataExport(
+	ctx context.Context, now time.Time, expire func(ctx context.Context, export models.DataExport) error,
+) (bool, error) {
+	var found bool
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		export, err := q.LockNextExpiredDataExport(ctx, now)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return errors.Errorf("sqlc: LockNextExpiredDataExport: %w", err)
+		}
+		found = true
+
+		if err = expire(ctx, *dataExportToModel(export)); err != nil {
+			return err
+		}
+
+		if err = q.ExpireDataExport(ctx, export.ID); err != nil {
+			return errors.Errorf("sqlc: ExpireDataExport: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func getDataExportParts(ctx context.Context, q *sqlc.Queries, exportID uuid.UUID) ([]models.DataExportPart, error) {
+	parts, err := q.GetDataExportParts(ctx, exportID)
+	if err != nil {
+		return nil, errors.Errorf("sqlc: GetDataExportParts: %w", err)
+	}
+
+	result := make([]models.DataExportPart, len(parts))
+	for i, part := range parts {
+		result[i] = dataExportPartToModel(part)
+	}
+
+	return result, nil
+}
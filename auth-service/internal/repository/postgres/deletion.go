@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+)
+
+type AccountDeletionsRepository struct {
+	repo *sqlc.Queries
+	pool *pgxpool.Pool
+}
+
+func NewAccountDeletionsRepository(repo *sqlc.Queries, pool *pgxpool.Pool) *AccountDeletionsRepository {
+	return &AccountDeletionsRepository{repo: repo, pool: pool}
+}
+
+func (r *AccountDeletionsRepository) CreateAccountDeletion(ctx context.Context, userID uuid.UUID, purgeAfter time.Time) error {
+	affected, err := r.repo.CreateUserDeletion(ctx, userID, purgeAfter)
+	if err != nil {
+		return errors.Errorf("sqlc: CreateUserDeletion: %w", err)
+	}
+	if affected == 0 {
+		return apperrors.ErrAccountDeletionPending
+	}
+
+	return nil
+}
+
+// CancelAccountDeletion cancels the deletion only during the grace period.
+func (r *AccountDeletionsRepository) CancelAccountDeletion(ctx context.Context, userID uuid.UUID) error {
+	affected, err := r.repo.CancelUserDeletion(ctx, userID)
+	if err != nil {
+		return errors.Errorf("sqlc: CancelUserDeletion: %w", err)
+	}
+	if affected == 0 {
+		return apperrors.ErrAccountDeletionNotFound
+	}
+
+	return nil
+}
+
+func (r *AccountDeletionsRepository) GetAccountDeletion(ctx context.Context, userID uuid.UUID) (*models.AccountDeletion, error) {
+	deletion, err := r.repo.GetUserDeletion(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrAccountDeletionNotFound
+		}
+		return nil, errors.Errorf("sqlc: GetUserDeletion: %w", err)
+	}
+
+	services, err := r.repo.GetUserDeletionServices(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("sqlc: GetUserDeletionServices: %w", err)
+	}
+
+	return userDeletionToModel(deletion, services), nil
+}
+
+// PurgeNextDueAccount deletes the user whose grace period is over and enqueues the deleted event.
+// The data stored outside of postgres is removed by the consumer of the event, so that the transaction
+// does not wait for other storages. Deletions locked by other replicas are skipped.
+// Returns false if there are no due deletions.
+func (r *AccountDeletionsRepository) PurgeNextDueAccount(ctx context.Context, now time.Time) (bool, error) {
+	var found bool
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		userID, err := q.LockNextDueUserDeletion(ctx, now)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return errors.Errorf("sqlc: LockNextDueUserDeletion: %w", err)
+		}
+		found = true
+
+		// the unfollowed events of the user are published before the deleted event
+		if err = deleteUserFollows(ctx, q, userID, now); err != nil {
+			return err
+		}
+
+		err = enqueueUserEvent(ctx, q, userID, models.UserEventDeleted, &authv1.User{Id: userID.String()})
+		if err != nil {
+			return err
+		}
+
+		if err = q.DeleteUserByID(ctx, userID); err != nil {
+			return errors.Errorf("sqlc: DeleteUserByID: %w", err)
+		}
+
+		if err = q.MarkUserDeleted(ctx, userID); err != nil {
+			return errors.Errorf("sqlc: MarkUserDeleted: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func (r *AccountDeletionsRepository) CompleteServiceDeletion(
+	ctx context.Context, userID uuid.UUID, service string, completedAt time.Time,
+) error {
+	err := r.repo.CompleteUserDeletionService(ctx, sqlc.CompleteUserDeletionServiceParams{
+		UserID:      userID,
+		Service:     service,
+		CompletedAt: completedAt,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: CompleteUserDeletionService: %w", err)
+	}
+
+	return nil
+}
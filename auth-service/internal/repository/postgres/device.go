@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+)
+
+// DevicesRepository remembers the devices and locations the users have signed in from.
+type DevicesRepository struct {
+	repo *sqlc.Queries
+}
+
+func NewDevicesRepository(repo *sqlc.Queries) *DevicesRepository {
+	return &DevicesRepository{repo: repo}
+}
+
+func (r *DevicesRepository) HasUserDevices(ctx context.Context, userID uuid.UUID) (bool, error) {
+	exists, err := r.repo.HasUserDevices(ctx, userID)
+	if err != nil {
+		return false, errors.Errorf("sqlc: HasUserDevices: %w", err)
+	}
+
+	return exists, nil
+}
+
+// RememberUserDevice returns true if the device has not been used from the location before.
+func (r *DevicesRepository) RememberUserDevice(
+	ctx context.Context, userID uuid.UUID, deviceHash []byte, location string,
+) (bool, error) {
+	inserted, err := r.repo.UpsertUserDevice(ctx, sqlc.UpsertUserDeviceParams{
+		UserID:     userID,
+		DeviceHash: deviceHash,
+		Location:   location,
+	})
+	if err != nil {
+		return false, errors.Errorf("sqlc: UpsertUserDevice: %w", err)
+	}
+
+	return inserted, nil
+}
@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FollowsRepository struct {
+	repo *sqlc.Queries
+	pool *pgxpool.Pool
+}
+
+func NewFollowsRepository(repo *sqlc.Queries, pool *pgxpool.Pool) *FollowsRepository {
+	return &FollowsRepository{repo: repo, pool: pool}
+}
+
+// CreateFollow stores the follow with the counters and enqueues users.<follower_id>.followed event.
+// Returns false if the user already follows the followee.
+func (r *FollowsRepository) CreateFollow(ctx context.Context, followerID, followeeID uuid.UUID, now time.Time) (bool, error) {
+	var created bool
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		affected, err := q.CreateFollow(ctx, followerID, followeeID)
+		if err != nil {
+			return errors.Errorf("sqlc: CreateFollow: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+		created = true
+
+		if err = updateFollowCounts(ctx, q, followerID, followeeID, 1); err != nil {
+			return err
+		}
+
+		return enqueueFollowEvent(ctx, q, models.UserEventFollowed, followerID, followeeID, now)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return created, nil
+}
+
+// DeleteFollow removes the follow with the counters and enqueues users.<follower_id>.unfollowed event.
+// Returns false if the user does not follow the followee.
+func (r *FollowsRepository) DeleteFollow(ctx context.Context, followerID, followeeID uuid.UUID, now time.Time) (bool, error) {
+	var deleted bool
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		affected, err := q.DeleteFollow(ctx, followerID, followeeID)
+		if err != nil {
+			return errors.Errorf("sqlc: DeleteFollow: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+		deleted = true
+
+		if err = updateFollowCounts(ctx, q, followerID, followeeID, -1); err != nil {
+			return err
+		}
+
+		return enqueueFollowEvent(ctx, q, models.UserEventUnfollowed, followerID, followeeID, now)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// updateFollowCounts changes the counters in the order of the user ids, so that the users
+// following each other at the same time do not deadlock.
+func updateFollowCounts(ctx context.Context, q *sqlc.Queries, followerID, followeeID uuid.UUID, delta int64) error {
+	updateFollowing := func() error {
+		if err := q.AddFollowingCount(ctx, delta, followerID); err != nil {
+			return errors.Errorf("sqlc: AddFollowingCount: %w", err)
+		}
+		return nil
+	}
+	updateFollowers := func() error {
+		if err := q.AddFollowersCount(ctx, delta, followeeID); err != nil {
+			return errors.Errorf("sqlc: AddFollowersCount: %w", err)
+		}
+		return nil
+	}
+
+	first, second := updateFollowing, updateFollowers
+	if bytes.Compare(followeeID[:], followerID[:]) < 0 {
+		first, second = second, first
+	}
+
+	if err := first(); err != nil {
+		return err
+	}
+
+	return second()
+}
+
+func enqueueFollowEvent(
+	ctx context.Context, q *sqlc.Queries, eventType models.UserEventType, followerID, followeeID uuid.UUID, now time.Time,
+) error {
+	payload, err := proto.Marshal(&authv1.UserFollowEvent{
+		FollowerId: followerID.String(),
+		FolloweeId: followeeID.String(),
+		OccurredAt: timestamppb.New(now),
+	})
+	if err != nil {
+		return errors.Errorf("marshal follow event: %w", err)
+	}
+
+	return enqueueEvent(ctx, q, followerID, eventType, payload)
+}
+
+// deleteUserFollows removes the follows of the purged user and enqueues users.<follower_id>.unfollowed
+// event for every follow, so that the consumers of the follow events do not have to handle the deleted users.
+func deleteUserFollows(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, now time.Time) error {
+	removed, err := q.DeleteUserFollows(ctx, userID)
+	if err != nil {
+		return errors.Errorf("sqlc: DeleteUserFollows: %w", err)
+	}
+
+	for _, follow := range removed {
+		err = enqueueFollowEvent(ctx, q, models.UserEventUnfollowed, follow.FollowerID, follow.FolloweeID, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *FollowsRepository) GetFollowStatus(ctx context.Context, userID, otherID uuid.UUID) (models.FollowStatus, error) {
+	status, err := r.repo.GetFollowStatus(ctx, userID, otherID)
+	if err != nil {
+		return models.FollowStatus{}, errors.Errorf("sqlc: GetFollowStatus: %w", err)
+	}
+
+	return models.FollowStatus{
+		Following:  status.Following,
+		FollowedBy: status.FollowedBy,
+	}, nil
+}
+
+// GetFollowers returns the follows of the user from the newest, the ones before the cursor if it is set.
+func (r *FollowsRepository) GetFollowers(
+	ctx context.Context, userID uuid.UUID, cursor *dto.FollowsCursor, limit int,
+) ([]models.Follow, error) {
+	params := sqlc.GetFollowersParams{
+		UserID:   userID,
+		MaxCount: int32(limit),
+	}
+	if cursor != nil {
+		params.BeforeCreatedAt = &cursor.CreatedAt
+		params.BeforeUserID = &cursor.UserID
+	}
+
+	rows, err := r.repo.GetFollowers(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("sqlc: GetFollowers: %w", err)
+	}
+
+	follows := make([]models.Follow, len(rows))
+	for i, row := range rows {
+		follows[i] = models.Follow{
+			FollowerID: row.FollowerID,
+			FolloweeID: userID,
+			CreatedAt:  row.CreatedAt,
+		}
+	}
+
+	return follows, nil
+}
+
+// GetFollowing returns the follows by the user from the newest, the ones before the cursor if it is set.
+func (r *FollowsRepository) GetFollowing(
+	ctx context.Context, userID uuid.UUID, cursor *dto.FollowsCursor, limit int,
+) ([]models.Follow, error) {
+	params := sqlc.GetFollowingParams{
+		UserID:   userID,
+		MaxCount: int32(limit),
+	}
+	if cursor != nil {
+		params.BeforeCreatedAt = &cursor.CreatedAt
+		params.BeforeUserID = &cursor.UserID
+	}
+
+	rows, err := r.repo.GetFollowing(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("sqlc: GetFollowing: %w", err)
+	}
+
+	follows := make([]models.Follow, len(rows))
+	for i, row := range rows {
+		follows[i] = models.Follow{
+			FollowerID: userID,
+			FolloweeID: row.FolloweeID,
+			CreatedAt:  row.CreatedAt,
+		}
+	}
+
+	return follows, nil
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+)
+
+type IdentitiesRepository struct {
+	repo *sqlc.Queries
+}
+
+func NewIdentitiesRepository(repo *sqlc.Queries) *IdentitiesRepository {
+	return &IdentitiesRepository{repo: repo}
+}
+
+func (r *IdentitiesRepository) GetUserIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	identity, err := r.repo.GetUserIdentity(ctx, provider, subject)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrIdentityNotFound
+		}
+		return nil, errors.Errorf("sqlc: GetUserIdentity: %w", err)
+	}
+
+	model := userIdentityToModel(identity)
+	return &model, nil
+}
+
+func (r *IdentitiesRepository) GetUserIdentities(ctx context.Context, userID uuid.UUID) ([]models.UserIdentity, error) {
+	identities, err := r.repo.GetUserIdentities(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("sqlc: GetUserIdentities: %w", err)
+	}
+
+	result := make([]models.UserIdentity, len(identities))
+	for i, identity := range identities {
+		result[i] = userIdentityToModel(identity)
+	}
+
+	return result, nil
+}
+
+// CreateUserIdentity returns apperrors.ErrIdentityAlreadyLinked if the identity is linked to any user.
+func (r *IdentitiesRepository) CreateUserIdentity(ctx context.Context, identity models.UserIdentity) error {
+	return createUserIdentity(ctx, r.repo, identity)
+}
+
+func (r *IdentitiesRepository) DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider, subject string) error {
+	affected, err := r.repo.DeleteUserIdentity(ctx, sqlc.DeleteUserIdentityParams{
+		UserID:   userID,
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: DeleteUserIdentity: %w", err)
+	}
+	if affected == 0 {
+		return apperrors.ErrIdentityNotFound
+	}
+
+	return nil
+}
+
+func createUserIdentity(ctx context.Context, q *sqlc.Queries, identity models.UserIdentity) error {
+	affected, err := q.CreateUserIdentity(ctx, sqlc.CreateUserIdentityParams{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		UserID:   identity.UserID,
+		Email:    identity.Email,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: CreateUserIdentity: %w", err)
+	}
+	if affected == 0 {
+		return apperrors.ErrIdentityAlreadyLinked
+	}
+
+	return nil
+}
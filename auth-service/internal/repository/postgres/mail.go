@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+	"github.com/tech-inspire/backend/auth-service/pkg/generics"
+)
+
+// MailQueueRepository persists mails until they are delivered.
+type MailQueueRepository struct {
+	repo *sqlc.Queries
+}
+
+func NewMailQueueRepository(repo *sqlc.Queries) *MailQueueRepository {
+	return &MailQueueRepository{repo: repo}
+}
+
+// EnqueueMail ignores the id and attempts of the mail.
+func (r *MailQueueRepository) EnqueueMail(ctx context.Context, mail models.QueuedMail) error {
+	err := r.repo.EnqueueMail(ctx, sqlc.EnqueueMailParams{
+		Recipient: mail.To,
+		Subject:   mail.Subject,
+		Body:      mail.Text,
+		HtmlBody:  mail.HTML,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: EnqueueMail: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDueMails postpones the next attempt of the returned mails until leaseUntil, so other replicas
+// do not send them at the same time. If the mail is neither deleted nor retried, it is sent again after that.
+// The lease of every mail must be renewed with RenewMailLease right before sending it.
+func (r *MailQueueRepository) ClaimDueMails(
+	ctx context.Context, now, leaseUntil time.Time, limit int,
+) ([]models.QueuedMail, error) {
+	mails, err := r.repo.ClaimDueMails(ctx, sqlc.ClaimDueMailsParams{
+		LeaseUntil: leaseUntil,
+		Now:        now,
+		MaxCount:   int32(limit),
+	})
+	if err != nil {
+		return nil, errors.Errorf("sqlc: ClaimDueMails: %w", err)
+	}
+
+	return generics.Convert(mails, queuedMailToModel), nil
+}
+
+// RenewMailLease extends the lease of the claimed mail. Returns false if the lease has expired and
+// the mail was claimed by another replica.
+func (r *MailQueueRepository) RenewMailLease(ctx context.Context, mail models.QueuedMail, leaseUntil time.Time) (bool, error) {
+	renewed, err := r.repo.RenewMailLease(ctx, sqlc.RenewMailLeaseParams{
+		LeaseUntil:   leaseUntil,
+		ID:           mail.ID,
+		ClaimedUntil: mail.ClaimedUntil,
+	})
+	if err != nil {
+		return false, errors.Errorf("sqlc: RenewMailLease: %w", err)
+	}
+
+	return renewed > 0, nil
+}
+
+func (r *MailQueueRepository) DeleteMail(ctx context.Context, id int64) error {
+	if err := r.repo.DeleteMail(ctx, id); err != nil {
+		return errors.Errorf("sqlc: DeleteMail: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MailQueueRepository) RetryMail(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	err := r.repo.RetryMail(ctx, sqlc.RetryMailParams{
+		NextAttemptAt: nextAttemptAt,
+		LastError:     &lastError,
+		ID:            id,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: RetryMail: %w", err)
+	}
+
+	return nil
+}
+
+// DeadLetterMail stops delivery attempts, the mail is kept in the queue for inspection without the body.
+func (r *MailQueueRepository) DeadLetterMail(ctx context.Context, id int64, lastError string) error {
+	if err := r.repo.DeadLetterMail(ctx, &lastError, id); err != nil {
+		return errors.Errorf("sqlc: DeadLetterMail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+)
+
+type MFARepository struct {
+	repo *sqlc.Queries
+	pool *pgxpool.Pool
+}
+
+func NewMFARepository(repo *sqlc.Queries, pool *pgxpool.Pool) *MFARepository {
+	return &MFARepository{repo: repo, pool: pool}
+}
+
+func (r *MFARepository) GetUserTOTP(ctx context.Context, userID uuid.UUID) (*models.UserTOTP, error) {
+	totp, err := r.repo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrMFANotEnabled
+		}
+		return nil, errors.Errorf("sqlc: GetUserTOTP: %w", err)
+	}
+
+	return userTOTPToModel(totp), nil
+}
+
+func (r *MFARepository) CreateUserTOTP(ctx context.Context, userID uuid.UUID, secret []byte) error {
+	err := r.repo.UpsertUserTOTP(ctx, userID, secret)
+	if err != nil {
+		return errors.Errorf("sqlc: UpsertUserTOTP: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MFARepository) EnableUserTOTP(ctx context.Context, userID uuid.UUID, counter int64, recoveryCodeHashes [][]byte) error {
+	return r.inTx(ctx, func(q *sqlc.Queries) error {
+		affected, err := q.ConfirmUserTOTP(ctx, counter, userID)
+		if err != nil {
+			return errors.Errorf("sqlc: ConfirmUserTOTP: %w", err)
+		}
+		if affected == 0 {
+			return apperrors.ErrTOTPEnrollmentNotFound
+		}
+
+		return r.replaceRecoveryCodes(ctx, q, userID, recoveryCodeHashes)
+	})
+}
+
+func (r *MFARepository) UseTOTPCounter(ctx context.Context, userID uuid.UUID, counter int64) (bool, error) {
+	affected, err := r.repo.UseUserTOTPCounter(ctx, counter, userID)
+	if err != nil {
+		return false, errors.Errorf("sqlc: UseUserTOTPCounter: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *MFARepository) DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	return r.inTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+			return errors.Errorf("sqlc: DeleteUserRecoveryCodes: %w", err)
+		}
+
+		if err := q.DeleteUserTOTP(ctx, userID); err != nil {
+			return errors.Errorf("sqlc: DeleteUserTOTP: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *MFARepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes [][]byte) error {
+	return r.inTx(ctx, func(q *sqlc.Queries) error {
+		return r.replaceRecoveryCodes(ctx, q, userID, codeHashes)
+	})
+}
+
+func (r *MFARepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) (bool, error) {
+	affected, err := r.repo.UseUserRecoveryCode(ctx, userID, codeHash)
+	if err != nil {
+		return false, errors.Errorf("sqlc: UseUserRecoveryCode: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (*MFARepository) replaceRecoveryCodes(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, codeHashes [][]byte) error {
+	if err := q.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return errors.Errorf("sqlc: DeleteUserRecoveryCodes: %w", err)
+	}
+
+	if err := q.CreateUserRecoveryCodes(ctx, userID, codeHashes); err != nil {
+		return errors.Errorf("sqlc: CreateUserRecoveryCodes: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MFARepository) inTx(ctx context.Context, fn func(q *sqlc.Queries) error) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return fn(r.repo.WithTx(tx))
+	})
+}
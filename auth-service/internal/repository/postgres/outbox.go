@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	authproto "github.com/tech-inspire/backend/auth-service/internal/proto"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+	"google.golang.org/protobuf/proto"
+)
+
+// OutboxRepository stores user events until they are published. Events are written by other
+// repositories within the transaction that changes the user, so a committed change is never lost.
+type OutboxRepository struct {
+	repo *sqlc.Queries
+	pool *pgxpool.Pool
+}
+
+func NewOutboxRepository(repo *sqlc.Queries, pool *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{repo: repo, pool: pool}
+}
+
+// RelayUserEvents claims the oldest events for the lease duration, publishes them in order outside
+// of the transaction and deletes them. Events are claimed only if no other replica holds a lease, so
+// that events of the same user are not reordered. If publishing fails, the events published before
+// are deleted and the rest is released for the next run.
+func (r *OutboxRepository) RelayUserEvents(
+	ctx context.Context, limit int, lease time.Duration, publish func(ctx context.Context, event models.UserEvent) error,
+) (relayed int, err error) {
+	claimID, err := uuid.NewV7()
+	if err != nil {
+		return 0, errors.Errorf("generate claim id: %w", err)
+	}
+
+	events, err := r.claimUserEvents(ctx, claimID, limit, lease)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	// stop before the lease expires, otherwise other replica may publish the same events
+	publishCtx, cancel := context.WithTimeout(ctx, lease)
+	defer cancel()
+
+	var publishErr error
+
+	published := make([]int64, 0, len(events))
+	for _, event := range events {
+		if publishErr = publish(publishCtx, userEventToModel(event)); publishErr != nil {
+			break
+		}
+		published = append(published, event.ID)
+	}
+
+	if err = r.repo.DeleteUserEvents(ctx, published); err != nil {
+		// the events are published again once the lease expires, the stream drops the duplicates
+		return 0, errors.Errorf("sqlc: DeleteUserEvents: %w", err)
+	}
+
+	if publishErr != nil {
+		if err = r.repo.ReleaseUserEvents(ctx, &claimID); err != nil {
+			return len(published), errors.Errorf("sqlc: ReleaseUserEvents: %w", err)
+		}
+
+		return len(published), errors.Errorf("publish user event: %w", publishErr)
+	}
+
+	return len(published), nil
+}
+
+// claimUserEvents holds the advisory lock only while claiming, so that two replicas can not claim at the same time.
+func (r *OutboxRepository) claimUserEvents(
+	ctx context.Context, claimID uuid.UUID, limit int, lease time.Duration,
+) (events []sqlc.UserEventsOutbox, err error) {
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		locked, err := q.TryLockUserEventsOutbox(ctx)
+		if err != nil {
+			return errors.Errorf("sqlc: TryLockUserEventsOutbox: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		events, err = q.ClaimUserEvents(ctx, sqlc.ClaimUserEventsParams{
+			ClaimedBy:    &claimID,
+			LeaseSeconds: lease.Seconds(),
+			BatchSize:    int32(limit),
+		})
+		if err != nil {
+			return errors.Errorf("sqlc: ClaimUserEvents: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// enqueueUserEvent must be called with the queries bound to the transaction changing the user.
+func enqueueUserEvent(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, eventType models.UserEventType, user *authv1.User) error {
+	payload, err := proto.Marshal(user)
+	if err != nil {
+		return errors.Errorf("marshal user: %w", err)
+	}
+
+	return enqueueEvent(ctx, q, userID, eventType, payload)
+}
+
+// enqueueEvent stores the event with the payload already encoded.
+func enqueueEvent(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, eventType models.UserEventType, payload []byte) error {
+	eventID, err := uuid.NewV7()
+	if err != nil {
+		return errors.Errorf("generate event id: %w", err)
+	}
+
+	err = q.CreateUserEvent(ctx, sqlc.CreateUserEventParams{
+		EventID:   eventID,
+		UserID:    userID,
+		EventType: string(eventType),
+		Payload:   payload,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: CreateUserEvent: %w", err)
+	}
+
+	return nil
+}
+
+// enqueueUserSnapshot publishes the user as it is after the change made in the transaction.
+func enqueueUserSnapshot(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, eventType models.UserEventType) error {
+	user, err := q.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.ErrUserNotFound
+		}
+		return errors.Errorf("sqlc: GetUserByID: %w", err)
+	}
+
+	model, err := userToModel(user.User)
+	if err != nil {
+		return err
+	}
+
+	return enqueueUserEvent(ctx, q, userID, eventType, authproto.User(*model))
+}
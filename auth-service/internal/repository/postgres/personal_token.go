@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+)
+
+type PersonalTokensRepository struct {
+	repo *sqlc.Queries
+}
+
+func NewPersonalTokensRepository(repo *sqlc.Queries) *PersonalTokensRepository {
+	return &PersonalTokensRepository{repo: repo}
+}
+
+func (r *PersonalTokensRepository) CreatePersonalToken(ctx context.Context, token models.PersonalToken, tokenHash []byte) error {
+	err := r.repo.CreatePersonalToken(ctx, sqlc.CreatePersonalTokenParams{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		Name:      token.Name,
+		TokenHash: tokenHash,
+		Scopes:    token.Scopes,
+		ExpiresAt: token.ExpiresAt,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: CreatePersonalToken: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PersonalTokensRepository) GetPersonalTokenByHash(ctx context.Context, tokenHash []byte) (*models.PersonalToken, error) {
+	token, err := r.repo.GetPersonalTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrPersonalTokenNotFound
+		}
+		return nil, errors.Errorf("sqlc: GetPersonalTokenByHash: %w", err)
+	}
+
+	model := personalTokenToModel(token)
+	return &model, nil
+}
+
+func (r *PersonalTokensRepository) GetUserPersonalTokens(ctx context.Context, userID uuid.UUID) ([]models.PersonalToken, error) {
+	tokens, err := r.repo.GetUserPersonalTokens(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("sqlc: GetUserPersonalTokens: %w", err)
+	}
+
+	result := make([]models.PersonalToken, len(tokens))
+	for i, token := range tokens {
+		result[i] = personalTokenToModel(token)
+	}
+
+	return result, nil
+}
+
+// CountUserPersonalTokens does not count expired tokens.
+func (r *PersonalTokensRepository) CountUserPersonalTokens(ctx context.Context, userID uuid.UUID) (int64, error) {
+	count, err := r.repo.CountUserPersonalTokens(ctx, userID)
+	if err != nil {
+		return 0, errors.Errorf("sqlc: CountUserPersonalTokens: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PersonalTokensRepository) DeletePersonalToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	affected, err := r.repo.DeletePersonalToken(ctx, tokenID, userID)
+	if err != nil {
+		return errors.Errorf("sqlc: DeletePersonalToken: %w", err)
+	}
+	if affected == 0 {
+		return apperrors.ErrPersonalTokenNotFound
+	}
+
+	return nil
+}
+
+// DeleteUserPersonalTokens returns the number of the deleted tokens.
+func (r *PersonalTokensRepository) DeleteUserPersonalTokens(ctx context.Context, userID uuid.UUID) (int, error) {
+	deleted, err := r.repo.DeleteUserPersonalTokens(ctx, userID)
+	if err != nil {
+		return 0, errors.Errorf("sqlc: DeleteUserPersonalTokens: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// TouchPersonalToken updates the last usage time if the token was not used after usedBefore.
+func (r *PersonalTokensRepository) TouchPersonalToken(ctx context.Context, tokenID uuid.UUID, usedBefore time.Time) error {
+	if err := r.repo.TouchPersonalToken(ctx, tokenID, usedBefore); err != nil {
+		return errors.Errorf("sqlc: TouchPersonalToken: %w", err)
+	}
+
+	return nil
+}
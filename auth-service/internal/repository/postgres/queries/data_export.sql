@@ -0,0 +1,64 @@
+-- name: LockUserDataExports :exec
+-- serializes the requests of the user until the transaction ends, so that the limit is checked by one request at a time
+SELECT pg_advisory_xact_lock(hashtext('data_exports'), hashtext(@user_id::UUID::TEXT));
+
+-- name: CreateDataExport :execrows
+INSERT INTO data_exports (id, user_id)
+SELECT @id::UUID, @user_id::UUID
+WHERE NOT EXISTS (SELECT 1
+                  FROM data_exports
+                  WHERE user_id = @user_id
+                    AND requested_at > @requested_after
+                    AND status <> 'failed');
+
+-- name: GetLatestDataExport :one
+SELECT *
+FROM data_exports
+WHERE user_id = @user_id
+ORDER BY requested_at DESC
+LIMIT 1;
+
+-- name: GetDataExportParts :many
+SELECT *
+FROM data_export_parts
+WHERE export_id = @export_id;
+
+-- name: CreateDataExportPart :exec
+INSERT INTO data_export_parts (export_id, service, archive_key, data, completed_at)
+VALUES (@export_id, @service, @archive_key, @data, @completed_at)
+ON CONFLICT (export_id, service) DO NOTHING;
+
+-- name: LockNextDueDataExport :one
+-- the export is due when every service has sent its part or the time to wait for them is over
+SELECT *
+FROM data_exports
+WHERE status = 'pending'
+  AND (requested_at <= @timed_out_before
+    OR (SELECT COUNT(*)
+        FROM data_export_parts
+        WHERE export_id = data_exports.id
+          AND service = ANY (@services::TEXT[])) = CARDINALITY(@services::TEXT[]))
+ORDER BY requested_at
+LIMIT 1 FOR UPDATE SKIP LOCKED;
+
+-- name: FinishDataExport :exec
+UPDATE data_exports
+SET status       = @status,
+    archive_key  = @archive_key,
+    completed_at = NOW(),
+    expires_at   = @expires_at
+WHERE id = @id;
+
+-- name: LockNextExpiredDataExport :one
+SELECT *
+FROM data_exports
+WHERE status = 'ready'
+  AND expires_at <= @now::TIMESTAMP
+ORDER BY expires_at
+LIMIT 1 FOR UPDATE SKIP LOCKED;
+
+-- name: ExpireDataExport :exec
+UPDATE data_exports
+SET status      = 'expired',
+    archive_key = NULL
+WHERE id = @id;
@@ -0,0 +1,38 @@
+-- name: CreateUserDeletion :execrows
+INSERT INTO user_deletions (user_id, purge_after)
+VALUES (@user_id, @purge_after)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: CancelUserDeletion :execrows
+DELETE
+FROM user_deletions
+WHERE user_id = @user_id
+  AND deleted_at IS NULL;
+
+-- name: GetUserDeletion :one
+SELECT *
+FROM user_deletions
+WHERE user_id = @user_id;
+
+-- name: GetUserDeletionServices :many
+SELECT *
+FROM user_deletion_services
+WHERE user_id = @user_id;
+
+-- name: LockNextDueUserDeletion :one
+SELECT user_id
+FROM user_deletions
+WHERE deleted_at IS NULL
+  AND purge_after <= @now
+ORDER BY purge_after
+LIMIT 1 FOR UPDATE SKIP LOCKED;
+
+-- name: MarkUserDeleted :exec
+UPDATE user_deletions
+SET deleted_at = NOW()
+WHERE user_id = @user_id;
+
+-- name: CompleteUserDeletionService :exec
+INSERT INTO user_deletion_services (user_id, service, completed_at)
+VALUES (@user_id, @service, @completed_at)
+ON CONFLICT (user_id, service) DO NOTHING;
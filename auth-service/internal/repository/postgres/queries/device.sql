@@ -0,0 +1,8 @@
+-- name: HasUserDevices :one
+SELECT EXISTS (SELECT 1 FROM user_devices WHERE user_id = @user_id);
+
+-- name: UpsertUserDevice :one
+INSERT INTO user_devices (user_id, device_hash, location)
+VALUES (@user_id, @device_hash, @location)
+ON CONFLICT (user_id, device_hash, location) DO UPDATE SET last_seen_at = NOW()
+RETURNING (xmax = 0)::boolean AS inserted;
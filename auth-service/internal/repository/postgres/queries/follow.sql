@@ -0,0 +1,58 @@
+-- name: CreateFollow :execrows
+INSERT INTO follows (follower_id, followee_id)
+VALUES (@follower_id, @followee_id)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteFollow :execrows
+DELETE
+FROM follows
+WHERE follower_id = @follower_id
+  AND followee_id = @followee_id;
+
+-- name: AddFollowersCount :exec
+UPDATE users
+SET followers_count = followers_count + @delta
+WHERE user_id = @user_id;
+
+-- name: AddFollowingCount :exec
+UPDATE users
+SET following_count = following_count + @delta
+WHERE user_id = @user_id;
+
+-- name: GetFollowStatus :one
+SELECT EXISTS (SELECT 1 FROM follows WHERE follower_id = @user_id AND followee_id = @other_id) AS following,
+       EXISTS (SELECT 1 FROM follows WHERE follower_id = @other_id AND followee_id = @user_id) AS followed_by;
+
+-- name: GetFollowers :many
+SELECT follower_id, created_at
+FROM follows
+WHERE followee_id = @user_id
+  AND (sqlc.narg('before_created_at')::TIMESTAMP IS NULL
+    OR (created_at, follower_id) < (sqlc.narg('before_created_at')::TIMESTAMP, sqlc.narg('before_user_id')::UUID))
+ORDER BY created_at DESC, follower_id DESC
+LIMIT @max_count;
+
+-- name: GetFollowing :many
+SELECT followee_id, created_at
+FROM follows
+WHERE follower_id = @user_id
+  AND (sqlc.narg('before_created_at')::TIMESTAMP IS NULL
+    OR (created_at, followee_id) < (sqlc.narg('before_created_at')::TIMESTAMP, sqlc.narg('before_user_id')::UUID))
+ORDER BY created_at DESC, followee_id DESC
+LIMIT @max_count;
+
+-- name: DeleteUserFollows :many
+-- the removed follows are returned, the counters of the other users are decremented
+WITH removed AS (
+    DELETE FROM follows
+    WHERE follower_id = @user_id
+       OR followee_id = @user_id
+    RETURNING follower_id, followee_id),
+     counters AS (
+         UPDATE users
+             SET followers_count = followers_count - (SELECT COUNT(*) FROM removed WHERE removed.followee_id = users.user_id),
+                 following_count = following_count - (SELECT COUNT(*) FROM removed WHERE removed.follower_id = users.user_id)
+             WHERE user_id IN (SELECT follower_id FROM removed UNION SELECT followee_id FROM removed)
+                 AND user_id <> @user_id)
+SELECT follower_id, followee_id
+FROM removed;
@@ -0,0 +1,23 @@
+-- name: CreateUserIdentity :execrows
+INSERT INTO user_identities (provider, subject, user_id, email)
+VALUES (@provider, @subject, @user_id, @email)
+ON CONFLICT (provider, subject) DO NOTHING;
+
+-- name: GetUserIdentity :one
+SELECT *
+FROM user_identities
+WHERE provider = @provider
+  AND subject = @subject;
+
+-- name: GetUserIdentities :many
+SELECT *
+FROM user_identities
+WHERE user_id = @user_id
+ORDER BY created_at;
+
+-- name: DeleteUserIdentity :execrows
+DELETE
+FROM user_identities
+WHERE user_id = @user_id
+  AND provider = @provider
+  AND subject = @subject;
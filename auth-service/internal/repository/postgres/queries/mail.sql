@@ -0,0 +1,44 @@
+-- name: EnqueueMail :exec
+INSERT INTO mail_queue (recipient, subject, body, html_body)
+VALUES (@recipient, @subject, @body, @html_body);
+
+-- name: ClaimDueMails :many
+UPDATE mail_queue
+SET next_attempt_at = @lease_until
+WHERE id IN (SELECT id
+             FROM mail_queue
+             WHERE dead_at IS NULL
+               AND next_attempt_at <= @now
+             ORDER BY next_attempt_at
+             LIMIT @max_count FOR UPDATE SKIP LOCKED)
+RETURNING *;
+
+-- name: RenewMailLease :execrows
+-- the lease is renewed only if the mail was not claimed by another replica since
+UPDATE mail_queue
+SET next_attempt_at = @lease_until
+WHERE id = @id
+  AND next_attempt_at = @claimed_until
+  AND dead_at IS NULL;
+
+-- name: DeleteMail :exec
+DELETE
+FROM mail_queue
+WHERE id = @id;
+
+-- name: RetryMail :exec
+UPDATE mail_queue
+SET attempts        = attempts + 1,
+    next_attempt_at = @next_attempt_at,
+    last_error      = @last_error
+WHERE id = @id;
+
+-- name: DeadLetterMail :exec
+-- the body contains codes and links, only the headers are kept for inspection
+UPDATE mail_queue
+SET attempts   = attempts + 1,
+    last_error = @last_error,
+    dead_at    = NOW(),
+    body       = '',
+    html_body  = ''
+WHERE id = @id;
@@ -0,0 +1,47 @@
+-- name: GetUserTOTP :one
+SELECT *
+FROM user_totp
+WHERE user_id = @user_id;
+
+-- name: UpsertUserTOTP :exec
+INSERT INTO user_totp (user_id, secret)
+VALUES (@user_id, @secret)
+ON CONFLICT (user_id) DO UPDATE
+    SET secret            = excluded.secret,
+        confirmed_at      = NULL,
+        last_used_counter = 0,
+        created_at        = NOW();
+
+-- name: ConfirmUserTOTP :execrows
+UPDATE user_totp
+SET confirmed_at      = NOW(),
+    last_used_counter = @last_used_counter
+WHERE user_id = @user_id
+  AND confirmed_at IS NULL;
+
+-- name: UseUserTOTPCounter :execrows
+UPDATE user_totp
+SET last_used_counter = @last_used_counter
+WHERE user_id = @user_id
+  AND last_used_counter < @last_used_counter;
+
+-- name: DeleteUserTOTP :exec
+DELETE
+FROM user_totp
+WHERE user_id = @user_id;
+
+-- name: CreateUserRecoveryCodes :exec
+INSERT INTO user_recovery_codes (user_id, code_hash)
+SELECT @user_id, UNNEST(@code_hashes::bytea[]);
+
+-- name: DeleteUserRecoveryCodes :exec
+DELETE
+FROM user_recovery_codes
+WHERE user_id = @user_id;
+
+-- name: UseUserRecoveryCode :execrows
+UPDATE user_recovery_codes
+SET used_at = NOW()
+WHERE user_id = @user_id
+  AND code_hash = @code_hash
+  AND used_at IS NULL;
@@ -0,0 +1,36 @@
+-- name: CreateUserEvent :exec
+INSERT INTO user_events_outbox (event_id, user_id, event_type, payload)
+VALUES (@event_id, @user_id, @event_type, @payload);
+
+-- name: TryLockUserEventsOutbox :one
+SELECT pg_try_advisory_xact_lock(hashtext('user_events_outbox'));
+
+-- name: ClaimUserEvents :many
+-- the oldest events are claimed only if no other relay holds a lease, so that events are not reordered
+WITH claimed AS (
+    UPDATE user_events_outbox
+        SET claimed_by = @claimed_by,
+            claimed_until = NOW() + MAKE_INTERVAL(secs => @lease_seconds::float8)
+        WHERE id IN (SELECT id
+                     FROM user_events_outbox
+                     ORDER BY id
+                     LIMIT @batch_size)
+            AND NOT EXISTS (SELECT 1
+                            FROM user_events_outbox
+                            WHERE claimed_by <> @claimed_by
+                              AND claimed_until > NOW())
+        RETURNING *)
+SELECT *
+FROM claimed
+ORDER BY id;
+
+-- name: ReleaseUserEvents :exec
+UPDATE user_events_outbox
+SET claimed_by    = NULL,
+    claimed_until = NULL
+WHERE claimed_by = @claimed_by;
+
+-- name: DeleteUserEvents :exec
+DELETE
+FROM user_events_outbox
+WHERE id = ANY (@ids::bigint[]);
@@ -0,0 +1,37 @@
+-- name: CreatePersonalToken :exec
+INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, expires_at)
+VALUES (@id, @user_id, @name, @token_hash, @scopes, @expires_at);
+
+-- name: GetPersonalTokenByHash :one
+SELECT *
+FROM personal_access_tokens
+WHERE token_hash = @token_hash;
+
+-- name: GetUserPersonalTokens :many
+SELECT *
+FROM personal_access_tokens
+WHERE user_id = @user_id
+ORDER BY created_at DESC;
+
+-- name: CountUserPersonalTokens :one
+SELECT COUNT(*)
+FROM personal_access_tokens
+WHERE user_id = @user_id
+  AND expires_at > NOW();
+
+-- name: DeletePersonalToken :execrows
+DELETE
+FROM personal_access_tokens
+WHERE id = @id
+  AND user_id = @user_id;
+
+-- name: DeleteUserPersonalTokens :execrows
+DELETE
+FROM personal_access_tokens
+WHERE user_id = @user_id;
+
+-- name: TouchPersonalToken :exec
+UPDATE personal_access_tokens
+SET last_used_at = NOW()
+WHERE id = @id
+  AND (last_used_at IS NULL OR last_used_at < @used_before);
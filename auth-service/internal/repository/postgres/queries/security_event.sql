@@ -0,0 +1,16 @@
+-- name: CreateSecurityEvent :exec
+INSERT INTO security_events (user_id, actor_id, event_type, outcome, ip, user_agent, session_id, metadata)
+VALUES (@user_id, @actor_id, @event_type, @outcome, @ip, @user_agent, @session_id, @metadata);
+
+-- name: DeleteSecurityEventsBefore :execrows
+DELETE
+FROM security_events
+WHERE id IN (SELECT id
+             FROM security_events
+             WHERE created_at < @before
+             ORDER BY created_at
+             LIMIT @max_count);
+
+-- name: EnableSecurityEventsRetention :exec
+-- the events can be deleted only in the transaction of the retention job
+SELECT set_config('auth.security_events_retention', 'on', true);
@@ -0,0 +1,18 @@
+-- name: GetUserSuspension :one
+SELECT *
+FROM user_suspensions
+WHERE user_id = @user_id;
+
+-- name: UpsertUserSuspension :exec
+INSERT INTO user_suspensions (user_id, reason, expires_at, suspended_by)
+VALUES (@user_id, @reason, @expires_at, @suspended_by)
+ON CONFLICT (user_id) DO UPDATE
+    SET reason       = excluded.reason,
+        expires_at   = excluded.expires_at,
+        suspended_by = excluded.suspended_by,
+        created_at   = NOW();
+
+-- name: DeleteUserSuspension :execrows
+DELETE
+FROM user_suspensions
+WHERE user_id = @user_id;
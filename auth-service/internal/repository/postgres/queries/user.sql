@@ -1,6 +1,6 @@
 -- name: CreateUser :exec
-INSERT INTO users (user_id, email, name, username, password_hash, description, avatar_url)
-VALUES (@user_id, @email, @name, @username, @password_hash, @description, @avatar_url);
+INSERT INTO users (user_id, email, name, username, password_hash, description, avatar_url, locale)
+VALUES (@user_id, @email, @name, @username, @password_hash, @description, @avatar_url, @locale);
 
 -- name: GetUserByEmail :one
 SELECT sqlc.embed(users)
@@ -9,9 +9,10 @@ WHERE email = @email;
 
 
 -- name: GetUserByUsername :one
+-- usernames are unique ignoring the case
 SELECT sqlc.embed(users)
 FROM users
-WHERE username = @username;
+WHERE LOWER(username) = LOWER(@username);
 
 -- name: GetUserByID :one
 SELECT sqlc.embed(users)
@@ -42,8 +43,25 @@ SET name          = COALESCE(sqlc.narg('name'), name),
     username      = COALESCE(sqlc.narg('username'), username),
     description   = COALESCE(sqlc.narg('description'), description),
     avatar_url    = COALESCE(sqlc.narg('avatar_url'), avatar_url),
+    email         = COALESCE(sqlc.narg('email'), email),
+    notify_new_devices = COALESCE(sqlc.narg('notify_new_devices'), notify_new_devices),
+    avatar_variants = COALESCE(sqlc.narg('avatar_variants'), avatar_variants),
+    -- empty locale resets it to the default one
+    locale        = NULLIF(COALESCE(sqlc.narg('locale'), locale), ''),
     updated_at    = NOW()
 WHERE user_id = @user_id;
 
 -- name: ClearUserAvatarURL :exec
-UPDATE users SET avatar_url = NULL WHERE user_id = @user_id;
\ No newline at end of file
+UPDATE users SET avatar_url = NULL, avatar_variants = NULL WHERE user_id = @user_id;
+
+-- name: IncrementUserTokensRevision :one
+UPDATE users
+SET tokens_revision = tokens_revision + 1
+WHERE user_id = @user_id
+RETURNING tokens_revision;
+
+-- name: SetUserAdmin :execrows
+UPDATE users
+SET is_admin   = @is_admin,
+    updated_at = NOW()
+WHERE user_id = @user_id;
@@ -0,0 +1,27 @@
+-- name: CreateUsernameHistory :exec
+-- the current username is kept before it is changed to new_username, changing only its case is not recorded
+INSERT INTO username_history (username, user_id, released_at)
+SELECT username, user_id, @released_at::TIMESTAMP
+FROM users
+WHERE user_id = @user_id
+  AND LOWER(username) <> LOWER(@new_username)
+ON CONFLICT ((LOWER(username))) DO UPDATE
+    SET user_id     = excluded.user_id,
+        changed_at  = NOW(),
+        released_at = excluded.released_at;
+
+-- name: DeleteUsernameHistory :exec
+DELETE
+FROM username_history
+WHERE LOWER(username) = LOWER(@username);
+
+-- name: GetUsernameHistory :one
+SELECT *
+FROM username_history
+WHERE LOWER(username) = LOWER(@username)
+  AND released_at > @now;
+
+-- name: PruneUsernameHistory :execrows
+DELETE
+FROM username_history
+WHERE released_at <= @now;
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+)
+
+// SecurityEventsRepository is the append-only audit log, the table rejects updates and deletes
+// other than the retention ones.
+type SecurityEventsRepository struct {
+	repo *sqlc.Queries
+	pool *pgxpool.Pool
+}
+
+func NewSecurityEventsRepository(repo *sqlc.Queries, pool *pgxpool.Pool) *SecurityEventsRepository {
+	return &SecurityEventsRepository{repo: repo, pool: pool}
+}
+
+// CreateSecurityEvent ignores the id and creation time of the event.
+func (r *SecurityEventsRepository) CreateSecurityEvent(ctx context.Context, event models.SecurityEvent) error {
+	metadata := event.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Errorf("marshal metadata: %w", err)
+	}
+
+	err = r.repo.CreateSecurityEvent(ctx, sqlc.CreateSecurityEventParams{
+		UserID:    event.UserID,
+		ActorID:   event.ActorID,
+		EventType: string(event.Type),
+		Outcome:   string(event.Outcome),
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		SessionID: event.SessionID,
+		Metadata:  metadataJSON,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: CreateSecurityEvent: %w", err)
+	}
+
+	return nil
+}
+
+// GetSecurityEvents returns the events matching the filter, most recent first.
+func (r *SecurityEventsRepository) GetSecurityEvents(
+	ctx context.Context, filter dto.SecurityEventsFilter,
+) ([]models.SecurityEvent, error) {
+	builder := sqlbuilder.Select("*").
+		From("security_events")
+
+	if filter.UserID != nil {
+		builder.Where(builder.EQ("user_id", *filter.UserID))
+	}
+	if len(filter.Types) > 0 {
+		types := make([]any, len(filter.Types))
+		for i, eventType := range filter.Types {
+			types[i] = string(eventType)
+		}
+		builder.Where(builder.In("event_type", types...))
+	}
+	if filter.From != nil {
+		builder.Where(builder.GTE("created_at", *filter.From))
+	}
+	if filter.To != nil {
+		builder.Where(builder.LT("created_at", *filter.To))
+	}
+
+	builder.OrderBy("created_at DESC", "id DESC").Offset(filter.Offset).Limit(filter.Limit)
+
+	query, args := builder.BuildWithFlavor(sqlbuilder.PostgreSQL)
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Errorf("pgx: query (args: %v): %w", args, err)
+	}
+
+	events, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[sqlc.SecurityEvent])
+	if err != nil {
+		return nil, errors.Errorf("pgx: collect rows: %w", err)
+	}
+
+	out := make([]models.SecurityEvent, len(events))
+	for i, event := range events {
+		if out[i], err = securityEventToModel(event); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// DeleteSecurityEventsBefore deletes at most limit events, so that the table is not locked for long.
+// The table rejects deletes outside of the transactions marked by EnableSecurityEventsRetention.
+func (r *SecurityEventsRepository) DeleteSecurityEventsBefore(ctx context.Context, before time.Time, limit int) (int, error) {
+	var deleted int64
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		if err := q.EnableSecurityEventsRetention(ctx); err != nil {
+			return errors.Errorf("sqlc: EnableSecurityEventsRetention: %w", err)
+		}
+
+		var err error
+		if deleted, err = q.DeleteSecurityEventsBefore(ctx, before, int32(limit)); err != nil {
+			return errors.Errorf("sqlc: DeleteSecurityEventsBefore: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
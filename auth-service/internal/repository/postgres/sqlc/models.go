@@ -10,15 +10,146 @@ import (
 	"github.com/google/uuid"
 )
 
+type DataExport struct {
+	ID          uuid.UUID  `db:"id"`
+	UserID      uuid.UUID  `db:"user_id"`
+	Status      string     `db:"status"`
+	ArchiveKey  *string    `db:"archive_key"`
+	RequestedAt time.Time  `db:"requested_at"`
+	CompletedAt *time.Time `db:"completed_at"`
+	ExpiresAt   *time.Time `db:"expires_at"`
+}
+
+type DataExportPart struct {
+	ExportID    uuid.UUID `db:"export_id"`
+	Service     string    `db:"service"`
+	ArchiveKey  *string   `db:"archive_key"`
+	Data        []byte    `db:"data"`
+	CompletedAt time.Time `db:"completed_at"`
+}
+
+type MailQueue struct {
+	ID            int64      `db:"id"`
+	Recipient     string     `db:"recipient"`
+	Subject       string     `db:"subject"`
+	Body          string     `db:"body"`
+	Attempts      int32      `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	LastError     *string    `db:"last_error"`
+	CreatedAt     time.Time  `db:"created_at"`
+	DeadAt        *time.Time `db:"dead_at"`
+	HtmlBody      string     `db:"html_body"`
+}
+
+type PersonalAccessToken struct {
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	Name       string     `db:"name"`
+	TokenHash  []byte     `db:"token_hash"`
+	Scopes     []string   `db:"scopes"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+type SecurityEvent struct {
+	ID        int64      `db:"id"`
+	UserID    *uuid.UUID `db:"user_id"`
+	ActorID   *uuid.UUID `db:"actor_id"`
+	EventType string     `db:"event_type"`
+	Outcome   string     `db:"outcome"`
+	Ip        string     `db:"ip"`
+	UserAgent string     `db:"user_agent"`
+	SessionID *uuid.UUID `db:"session_id"`
+	Metadata  []byte     `db:"metadata"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
 type User struct {
-	UserID       uuid.UUID `db:"user_id"`
-	Email        string    `db:"email"`
-	Username     string    `db:"username"`
-	Name         string    `db:"name"`
-	Description  string    `db:"description"`
-	AvatarUrl    *string   `db:"avatar_url"`
-	PasswordHash []byte    `db:"password_hash"`
-	IsAdmin      bool      `db:"is_admin"`
-	CreatedAt    time.Time `db:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at"`
+	UserID           uuid.UUID `db:"user_id"`
+	Email            string    `db:"email"`
+	Username         string    `db:"username"`
+	Name             string    `db:"name"`
+	Description      string    `db:"description"`
+	AvatarUrl        *string   `db:"avatar_url"`
+	PasswordHash     []byte    `db:"password_hash"`
+	IsAdmin          bool      `db:"is_admin"`
+	CreatedAt        time.Time `db:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at"`
+	Locale           *string   `db:"locale"`
+	NotifyNewDevices bool      `db:"notify_new_devices"`
+	AvatarVariants   []byte    `db:"avatar_variants"`
+	FollowersCount   int64     `db:"followers_count"`
+	FollowingCount   int64     `db:"following_count"`
+	TokensRevision   int64     `db:"tokens_revision"`
+}
+
+type UserDeletion struct {
+	UserID      uuid.UUID  `db:"user_id"`
+	RequestedAt time.Time  `db:"requested_at"`
+	PurgeAfter  time.Time  `db:"purge_after"`
+	DeletedAt   *time.Time `db:"deleted_at"`
+}
+
+type UserDeletionService struct {
+	UserID      uuid.UUID `db:"user_id"`
+	Service     string    `db:"service"`
+	CompletedAt time.Time `db:"completed_at"`
+}
+
+type UserDevice struct {
+	UserID     uuid.UUID `db:"user_id"`
+	DeviceHash []byte    `db:"device_hash"`
+	Location   string    `db:"location"`
+	CreatedAt  time.Time `db:"created_at"`
+	LastSeenAt time.Time `db:"last_seen_at"`
+}
+
+type UserEventsOutbox struct {
+	ID           int64      `db:"id"`
+	EventID      uuid.UUID  `db:"event_id"`
+	UserID       uuid.UUID  `db:"user_id"`
+	EventType    string     `db:"event_type"`
+	Payload      []byte     `db:"payload"`
+	OccurredAt   time.Time  `db:"occurred_at"`
+	ClaimedBy    *uuid.UUID `db:"claimed_by"`
+	ClaimedUntil *time.Time `db:"claimed_until"`
+}
+
+type UserIdentity struct {
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	UserID    uuid.UUID `db:"user_id"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type UserRecoveryCode struct {
+	CodeHash  []byte     `db:"code_hash"`
+	UserID    uuid.UUID  `db:"user_id"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type UserSuspension struct {
+	UserID      uuid.UUID  `db:"user_id"`
+	Reason      string     `db:"reason"`
+	ExpiresAt   *time.Time `db:"expires_at"`
+	SuspendedBy *uuid.UUID `db:"suspended_by"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+type UserTotp struct {
+	UserID          uuid.UUID  `db:"user_id"`
+	Secret          []byte     `db:"secret"`
+	ConfirmedAt     *time.Time `db:"confirmed_at"`
+	LastUsedCounter int64      `db:"last_used_counter"`
+	CreatedAt       time.Time  `db:"created_at"`
+}
+
+type UsernameHistory struct {
+	Username   string    `db:"username"`
+	UserID     uuid.UUID `db:"user_id"`
+	ChangedAt  time.Time `db:"changed_at"`
+	ReleasedAt time.Time `db:"released_at"`
 }
@@ -6,20 +6,98 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AddFollowersCount(ctx context.Context, delta int64, userID uuid.UUID) error
+	AddFollowingCount(ctx context.Context, delta int64, userID uuid.UUID) error
+	CancelUserDeletion(ctx context.Context, userID uuid.UUID) (int64, error)
+	ClaimDueMails(ctx context.Context, arg ClaimDueMailsParams) ([]MailQueue, error)
+	// the oldest events are claimed only if no other relay holds a lease, so that events are not reordered
+	ClaimUserEvents(ctx context.Context, arg ClaimUserEventsParams) ([]UserEventsOutbox, error)
 	ClearUserAvatarURL(ctx context.Context, userID uuid.UUID) error
+	CompleteUserDeletionService(ctx context.Context, arg CompleteUserDeletionServiceParams) error
+	ConfirmUserTOTP(ctx context.Context, lastUsedCounter int64, userID uuid.UUID) (int64, error)
+	CountUserPersonalTokens(ctx context.Context, userID uuid.UUID) (int64, error)
+	CreateDataExport(ctx context.Context, arg CreateDataExportParams) (int64, error)
+	CreateDataExportPart(ctx context.Context, arg CreateDataExportPartParams) error
+	CreateFollow(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) (int64, error)
+	CreatePersonalToken(ctx context.Context, arg CreatePersonalTokenParams) error
+	CreateSecurityEvent(ctx context.Context, arg CreateSecurityEventParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
+	CreateUserDeletion(ctx context.Context, userID uuid.UUID, purgeAfter time.Time) (int64, error)
+	CreateUserEvent(ctx context.Context, arg CreateUserEventParams) error
+	CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (int64, error)
+	CreateUserRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes [][]byte) error
+	// the current username is kept before it is changed to new_username, changing only its case is not recorded
+	CreateUsernameHistory(ctx context.Context, arg CreateUsernameHistoryParams) error
+	// the body contains codes and links, only the headers are kept for inspection
+	DeadLetterMail(ctx context.Context, lastError *string, iD int64) error
+	DeleteFollow(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) (int64, error)
+	DeleteMail(ctx context.Context, id int64) error
+	DeletePersonalToken(ctx context.Context, iD uuid.UUID, userID uuid.UUID) (int64, error)
+	DeleteSecurityEventsBefore(ctx context.Context, before time.Time, maxCount int32) (int64, error)
 	DeleteUserByID(ctx context.Context, userID uuid.UUID) error
+	DeleteUserEvents(ctx context.Context, ids []int64) error
+	// the removed follows are returned, the counters of the other users are decremented
+	DeleteUserFollows(ctx context.Context, userID uuid.UUID) ([]DeleteUserFollowsRow, error)
+	DeleteUserIdentity(ctx context.Context, arg DeleteUserIdentityParams) (int64, error)
+	DeleteUserPersonalTokens(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteUserRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+	DeleteUserSuspension(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error
+	DeleteUsernameHistory(ctx context.Context, username string) error
+	// the events can be deleted only in the transaction of the retention job
+	EnableSecurityEventsRetention(ctx context.Context) error
+	EnqueueMail(ctx context.Context, arg EnqueueMailParams) error
+	ExpireDataExport(ctx context.Context, iD uuid.UUID) error
+	FinishDataExport(ctx context.Context, arg FinishDataExportParams) error
+	GetDataExportParts(ctx context.Context, exportID uuid.UUID) ([]DataExportPart, error)
+	GetFollowStatus(ctx context.Context, userID uuid.UUID, otherID uuid.UUID) (GetFollowStatusRow, error)
+	GetFollowers(ctx context.Context, arg GetFollowersParams) ([]GetFollowersRow, error)
+	GetFollowing(ctx context.Context, arg GetFollowingParams) ([]GetFollowingRow, error)
+	GetLatestDataExport(ctx context.Context, userID uuid.UUID) (DataExport, error)
+	GetPersonalTokenByHash(ctx context.Context, tokenHash []byte) (PersonalAccessToken, error)
 	GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error)
 	GetUserByID(ctx context.Context, userID uuid.UUID) (GetUserByIDRow, error)
+	// usernames are unique ignoring the case
 	GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error)
+	GetUserDeletion(ctx context.Context, userID uuid.UUID) (UserDeletion, error)
+	GetUserDeletionServices(ctx context.Context, userID uuid.UUID) ([]UserDeletionService, error)
+	GetUserIdentities(ctx context.Context, userID uuid.UUID) ([]UserIdentity, error)
+	GetUserIdentity(ctx context.Context, provider string, subject string) (UserIdentity, error)
+	GetUserPersonalTokens(ctx context.Context, userID uuid.UUID) ([]PersonalAccessToken, error)
+	GetUserSuspension(ctx context.Context, userID uuid.UUID) (UserSuspension, error)
+	GetUserTOTP(ctx context.Context, userID uuid.UUID) (UserTotp, error)
+	GetUsernameHistory(ctx context.Context, username string, now time.Time) (UsernameHistory, error)
 	GetUsersByIDs(ctx context.Context, userIds []uuid.UUID) ([]GetUsersByIDsRow, error)
+	HasUserDevices(ctx context.Context, userID uuid.UUID) (bool, error)
+	IncrementUserTokensRevision(ctx context.Context, userID uuid.UUID) (int64, error)
+	// the export is due when every service has sent its part or the time to wait for them is over
+	LockNextDueDataExport(ctx context.Context, timedOutBefore time.Time, services []string) (DataExport, error)
+	LockNextDueUserDeletion(ctx context.Context, now time.Time) (uuid.UUID, error)
+	LockNextExpiredDataExport(ctx context.Context, now time.Time) (DataExport, error)
+	// serializes the requests of the user until the transaction ends, so that the limit is checked by one request at a time
+	LockUserDataExports(ctx context.Context, userID uuid.UUID) error
+	MarkUserDeleted(ctx context.Context, userID uuid.UUID) error
+	PruneUsernameHistory(ctx context.Context, now time.Time) (int64, error)
+	ReleaseUserEvents(ctx context.Context, claimedBy *uuid.UUID) error
+	// the lease is renewed only if the mail was not claimed by another replica since
+	RenewMailLease(ctx context.Context, arg RenewMailLeaseParams) (int64, error)
+	RetryMail(ctx context.Context, arg RetryMailParams) error
+	SetUserAdmin(ctx context.Context, isAdmin bool, userID uuid.UUID) (int64, error)
+	TouchPersonalToken(ctx context.Context, iD uuid.UUID, usedBefore time.Time) error
+	TryLockUserEventsOutbox(ctx context.Context) (bool, error)
 	UpdateUserByID(ctx context.Context, arg UpdateUserByIDParams) error
 	UpdateUserPassword(ctx context.Context, passwordHash []byte, userID uuid.UUID) error
+	UpsertUserDevice(ctx context.Context, arg UpsertUserDeviceParams) (bool, error)
+	UpsertUserSuspension(ctx context.Context, arg UpsertUserSuspensionParams) error
+	UpsertUserTOTP(ctx context.Context, userID uuid.UUID, secret []byte) error
+	UseUserRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) (int64, error)
+	UseUserTOTPCounter(ctx context.Context, lastUsedCounter int64, userID uuid.UUID) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -12,7 +12,7 @@ import (
 )
 
 const clearUserAvatarURL = `-- name: ClearUserAvatarURL :exec
-UPDATE users SET avatar_url = NULL WHERE user_id = $1
+UPDATE users SET avatar_url = NULL, avatar_variants = NULL WHERE user_id = $1
 `
 
 func (q *Queries) ClearUserAvatarURL(ctx context.Context, userID uuid.UUID) error {
@@ -21,8 +21,8 @@ func (q *Queries) ClearUserAvatarURL(ctx context.Context, userID uuid.UUID) erro
 }
 
 const createUser = `-- name: CreateUser :exec
-INSERT INTO users (user_id, email, name, username, password_hash, description, avatar_url)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO users (user_id, email, name, username, password_hash, description, avatar_url, locale)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateUserParams struct {
@@ -33,6 +33,7 @@ type CreateUserParams struct {
 	PasswordHash []byte    `db:"password_hash"`
 	Description  string    `db:"description"`
 	AvatarUrl    *string   `db:"avatar_url"`
+	Locale       *string   `db:"locale"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
@@ -44,6 +45,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 		arg.PasswordHash,
 		arg.Description,
 		arg.AvatarUrl,
+		arg.Locale,
 	)
 	return err
 }
@@ -60,7 +62,7 @@ func (q *Queries) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at
+SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at, users.locale, users.notify_new_devices, users.avatar_variants, users.followers_count, users.following_count, users.tokens_revision
 FROM users
 WHERE email = $1
 `
@@ -83,12 +85,18 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEm
 		&i.User.IsAdmin,
 		&i.User.CreatedAt,
 		&i.User.UpdatedAt,
+		&i.User.Locale,
+		&i.User.NotifyNewDevices,
+		&i.User.AvatarVariants,
+		&i.User.FollowersCount,
+		&i.User.FollowingCount,
+		&i.User.TokensRevision,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at
+SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at, users.locale, users.notify_new_devices, users.avatar_variants, users.followers_count, users.following_count, users.tokens_revision
 FROM users
 WHERE users.user_id = $1
 `
@@ -111,20 +119,27 @@ func (q *Queries) GetUserByID(ctx context.Context, userID uuid.UUID) (GetUserByI
 		&i.User.IsAdmin,
 		&i.User.CreatedAt,
 		&i.User.UpdatedAt,
+		&i.User.Locale,
+		&i.User.NotifyNewDevices,
+		&i.User.AvatarVariants,
+		&i.User.FollowersCount,
+		&i.User.FollowingCount,
+		&i.User.TokensRevision,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at
+SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at, users.locale, users.notify_new_devices, users.avatar_variants, users.followers_count, users.following_count, users.tokens_revision
 FROM users
-WHERE username = $1
+WHERE LOWER(username) = LOWER($1)
 `
 
 type GetUserByUsernameRow struct {
 	User User `db:"user"`
 }
 
+// usernames are unique ignoring the case
 func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
 	row := q.db.QueryRow(ctx, getUserByUsername, username)
 	var i GetUserByUsernameRow
@@ -139,12 +154,18 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUs
 		&i.User.IsAdmin,
 		&i.User.CreatedAt,
 		&i.User.UpdatedAt,
+		&i.User.Locale,
+		&i.User.NotifyNewDevices,
+		&i.User.AvatarVariants,
+		&i.User.FollowersCount,
+		&i.User.FollowingCount,
+		&i.User.TokensRevision,
 	)
 	return i, err
 }
 
 const getUsersByIDs = `-- name: GetUsersByIDs :many
-SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at
+SELECT users.user_id, users.email, users.username, users.name, users.description, users.avatar_url, users.password_hash, users.is_admin, users.created_at, users.updated_at, users.locale, users.notify_new_devices, users.avatar_variants, users.followers_count, users.following_count, users.tokens_revision
 FROM users
 WHERE users.user_id = ANY ($1::uuid[])
 `
@@ -173,6 +194,12 @@ func (q *Queries) GetUsersByIDs(ctx context.Context, userIds []uuid.UUID) ([]Get
 			&i.User.IsAdmin,
 			&i.User.CreatedAt,
 			&i.User.UpdatedAt,
+			&i.User.Locale,
+			&i.User.NotifyNewDevices,
+			&i.User.AvatarVariants,
+			&i.User.FollowersCount,
+			&i.User.FollowingCount,
+			&i.User.TokensRevision,
 		); err != nil {
 			return nil, err
 		}
@@ -184,6 +211,35 @@ func (q *Queries) GetUsersByIDs(ctx context.Context, userIds []uuid.UUID) ([]Get
 	return items, nil
 }
 
+const incrementUserTokensRevision = `-- name: IncrementUserTokensRevision :one
+UPDATE users
+SET tokens_revision = tokens_revision + 1
+WHERE user_id = $1
+RETURNING tokens_revision
+`
+
+func (q *Queries) IncrementUserTokensRevision(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, incrementUserTokensRevision, userID)
+	var tokens_revision int64
+	err := row.Scan(&tokens_revision)
+	return tokens_revision, err
+}
+
+const setUserAdmin = `-- name: SetUserAdmin :execrows
+UPDATE users
+SET is_admin   = $1,
+    updated_at = NOW()
+WHERE user_id = $2
+`
+
+func (q *Queries) SetUserAdmin(ctx context.Context, isAdmin bool, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserAdmin, isAdmin, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateUserByID = `-- name: UpdateUserByID :exec
 UPDATE users
 SET name          = COALESCE($1, name),
@@ -191,17 +247,26 @@ SET name          = COALESCE($1, name),
     username      = COALESCE($3, username),
     description   = COALESCE($4, description),
     avatar_url    = COALESCE($5, avatar_url),
+    email         = COALESCE($6, email),
+    notify_new_devices = COALESCE($7, notify_new_devices),
+    avatar_variants = COALESCE($8, avatar_variants),
+    -- empty locale resets it to the default one
+    locale        = NULLIF(COALESCE($9, locale), ''),
     updated_at    = NOW()
-WHERE user_id = $6
+WHERE user_id = $10
 `
 
 type UpdateUserByIDParams struct {
-	Name         *string   `db:"name"`
-	PasswordHash []byte    `db:"password_hash"`
-	Username     *string   `db:"username"`
-	Description  *string   `db:"description"`
-	AvatarUrl    *string   `db:"avatar_url"`
-	UserID       uuid.UUID `db:"user_id"`
+	Name             *string   `db:"name"`
+	PasswordHash     []byte    `db:"password_hash"`
+	Username         *string   `db:"username"`
+	Description      *string   `db:"description"`
+	AvatarUrl        *string   `db:"avatar_url"`
+	Email            *string   `db:"email"`
+	NotifyNewDevices *bool     `db:"notify_new_devices"`
+	AvatarVariants   []byte    `db:"avatar_variants"`
+	Locale           *string   `db:"locale"`
+	UserID           uuid.UUID `db:"user_id"`
 }
 
 func (q *Queries) UpdateUserByID(ctx context.Context, arg UpdateUserByIDParams) error {
@@ -211,6 +276,10 @@ func (q *Queries) UpdateUserByID(ctx context.Context, arg UpdateUserByIDParams)
 		arg.Username,
 		arg.Description,
 		arg.AvatarUrl,
+		arg.Email,
+		arg.NotifyNewDevices,
+		arg.AvatarVariants,
+		arg.Locale,
 		arg.UserID,
 	)
 	return err
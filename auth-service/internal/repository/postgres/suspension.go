@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/repository/postgres/sqlc"
+)
+
+type SuspensionsRepository struct {
+	repo *sqlc.Queries
+	pool *pgxpool.Pool
+}
+
+func NewSuspensionsRepository(repo *sqlc.Queries, pool *pgxpool.Pool) *SuspensionsRepository {
+	return &SuspensionsRepository{repo: repo, pool: pool}
+}
+
+// GetUserSuspension returns the latest suspension of the user, it can be already expired.
+func (r *SuspensionsRepository) GetUserSuspension(ctx context.Context, userID uuid.UUID) (*models.UserSuspension, error) {
+	suspension, err := r.repo.GetUserSuspension(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrUserNotSuspended
+		}
+		return nil, errors.Errorf("sqlc: GetUserSuspension: %w", err)
+	}
+
+	return userSuspensionToModel(suspension), nil
+}
+
+// SuspendUser replaces the current suspension of the user. Nil expiresAt bans the user permanently.
+func (r *SuspensionsRepository) SuspendUser(
+	ctx context.Context, userID, suspendedBy uuid.UUID, reason string, expiresAt *time.Time,
+) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		err := q.UpsertUserSuspension(ctx, sqlc.UpsertUserSuspensionParams{
+			UserID:      userID,
+			Reason:      reason,
+			ExpiresAt:   expiresAt,
+			SuspendedBy: &suspendedBy,
+		})
+		if err != nil {
+			return errors.Errorf("sqlc: UpsertUserSuspension: %w", err)
+		}
+
+		return enqueueUserSnapshot(ctx, q, userID, models.UserEventSuspended)
+	})
+}
+
+func (r *SuspensionsRepository) LiftUserSuspension(ctx context.Context, userID uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		affected, err := q.DeleteUserSuspension(ctx, userID)
+		if err != nil {
+			return errors.Errorf("sqlc: DeleteUserSuspension: %w", err)
+		}
+		if affected == 0 {
+			return apperrors.ErrUserNotSuspended
+		}
+
+		return enqueueUserSnapshot(ctx, q, userID, models.UserEventUnsuspended)
+	})
+}
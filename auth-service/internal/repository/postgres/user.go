@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
@@ -26,19 +28,35 @@ func NewUserRepository(repo *sqlc.Queries, pool *pgxpool.Pool) *UserRepository {
 }
 
 func (r *UserRepository) CreateUser(ctx context.Context, params dto.CreateUserParams) error {
-	err := r.repo.CreateUser(ctx, sqlc.CreateUserParams{
-		UserID:       params.UserID,
-		Email:        params.Email,
-		Name:         params.Name,
-		Username:     params.Username,
-		PasswordHash: params.PasswordHash,
-		Description:  params.Description,
-	})
-	if err != nil {
-		return errors.Errorf("sqlc: create user: %w", err)
+	var locale *string
+	if params.Locale != "" {
+		locale = &params.Locale
 	}
 
-	return nil
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		err := q.CreateUser(ctx, sqlc.CreateUserParams{
+			UserID:       params.UserID,
+			Email:        params.Email,
+			Name:         params.Name,
+			Username:     params.Username,
+			PasswordHash: params.PasswordHash,
+			Description:  params.Description,
+			Locale:       locale,
+		})
+		if err != nil {
+			return errors.Errorf("sqlc: create user: %w", err)
+		}
+
+		if params.Identity != nil {
+			if err = createUserIdentity(ctx, q, *params.Identity); err != nil {
+				return err
+			}
+		}
+
+		return enqueueUserSnapshot(ctx, q, params.UserID, models.UserEventCreated)
+	})
 }
 
 func (r *UserRepository) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
@@ -50,7 +68,7 @@ func (r *UserRepository) GetUserByUsername(ctx context.Context, username string)
 		return nil, errors.Errorf("sqlc: GetUserByUsername: %w", err)
 	}
 
-	return userToModel(user.User), nil
+	return userToModel(user.User)
 }
 
 func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
@@ -62,7 +80,7 @@ func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 		return nil, errors.Errorf("sqlc: GetUserByEmail: %w", err)
 	}
 
-	return userToModel(user.User), nil
+	return userToModel(user.User)
 }
 
 func (r *UserRepository) GetUserByUsernameWithHash(ctx context.Context, username string) (admin *models.User, hash []byte, err error) {
@@ -75,7 +93,12 @@ func (r *UserRepository) GetUserByUsernameWithHash(ctx context.Context, username
 		return nil, nil, errors.Errorf("sqlc: GetUserByUsername(%s): %w", username, err)
 	}
 
-	return userToModel(res.User), res.User.PasswordHash, nil
+	user, err := userToModel(res.User)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, res.User.PasswordHash, nil
 }
 
 func (r *UserRepository) GetUserByEmailWithHash(ctx context.Context, email string) (admin *models.User, hash []byte, err error) {
@@ -88,7 +111,12 @@ func (r *UserRepository) GetUserByEmailWithHash(ctx context.Context, email strin
 		return nil, nil, errors.Errorf("sqlc: GetUserByEmail(%s): %w", email, err)
 	}
 
-	return userToModel(res.User), res.User.PasswordHash, nil
+	user, err := userToModel(res.User)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, res.User.PasswordHash, nil
 }
 
 func (r *UserRepository) GetUserByID(ctx context.Context, userID uuid.UUID) (*models.User, error) {
@@ -100,7 +128,25 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID uuid.UUID) (*mo
 		return nil, errors.Errorf("sqlc: GetUserByID: %w", err)
 	}
 
-	return userToModel(user.User), nil
+	return userToModel(user.User)
+}
+
+func (r *UserRepository) GetUserByIDWithHash(ctx context.Context, userID uuid.UUID) (*models.User, []byte, error) {
+	res, err := r.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, apperrors.ErrUserNotFound
+		}
+
+		return nil, nil, errors.Errorf("sqlc: GetUserByID(%s): %w", userID, err)
+	}
+
+	user, err := userToModel(res.User)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, res.User.PasswordHash, nil
 }
 
 func (r *UserRepository) GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error) {
@@ -114,7 +160,10 @@ func (r *UserRepository) GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID)
 
 	out := make([]*models.User, len(users))
 	for i, user := range users {
-		out[i] = userToModel(user.User)
+		out[i], err = userToModel(user.User)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return out, nil
@@ -129,15 +178,30 @@ func (r *UserRepository) UpdateUserPassword(ctx context.Context, userID uuid.UUI
 	return nil
 }
 
-func (r *UserRepository) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
-	err := r.repo.DeleteUserByID(ctx, userID)
+func (r *UserRepository) SetUserAdmin(ctx context.Context, userID uuid.UUID, isAdmin bool) error {
+	affected, err := r.repo.SetUserAdmin(ctx, isAdmin, userID)
 	if err != nil {
-		return errors.Errorf("sqlc: DeleteUserByID: %w", err)
+		return errors.Errorf("sqlc: SetUserAdmin: %w", err)
+	}
+	if affected == 0 {
+		return apperrors.ErrUserNotFound
 	}
 
 	return nil
 }
 
+func (r *UserRepository) IncrementUserTokensRevision(ctx context.Context, userID uuid.UUID) (int64, error) {
+	revision, err := r.repo.IncrementUserTokensRevision(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, apperrors.ErrUserNotFound
+		}
+		return 0, errors.Errorf("sqlc: IncrementUserTokensRevision: %w", err)
+	}
+
+	return revision, nil
+}
+
 func (*UserRepository) validateOrderField(orderBy string, direction string) (string, error) {
 	if !slices.Contains(
 		[]string{"name", "created_at"},
@@ -155,13 +219,31 @@ func (*UserRepository) validateOrderField(orderBy string, direction string) (str
 
 func (*UserRepository) applyFilters(builder *sqlbuilder.SelectBuilder, params dto.GetUsersParams) {
 	if params.UsernamePattern != nil {
-		builder.Where(builder.EQ("username", *params.UsernamePattern+"%"))
+		builder.Where(builder.Like("username", *params.UsernamePattern+"%"))
+	}
+	if params.EmailPattern != nil {
+		builder.Where(builder.Like("email", *params.EmailPattern+"%"))
 	}
 	if params.IDPattern != nil {
 		builder.Where(builder.Like("user_id::text", *params.IDPattern+"%"))
 	}
-	if params.IsBot != nil {
-		builder.Where(builder.EQ("is_bot", *params.IsBot))
+	if params.IsAdmin != nil {
+		builder.Where(builder.EQ("is_admin", *params.IsAdmin))
+	}
+	if params.Status != nil {
+		const (
+			banned    = "EXISTS (SELECT 1 FROM user_suspensions s WHERE s.user_id = users.user_id AND s.expires_at IS NULL)"
+			suspended = "EXISTS (SELECT 1 FROM user_suspensions s WHERE s.user_id = users.user_id AND s.expires_at > NOW())"
+		)
+
+		switch *params.Status {
+		case models.UserStatusBanned:
+			builder.Where(banned)
+		case models.UserStatusSuspended:
+			builder.Where(suspended)
+		case models.UserStatusActive:
+			builder.Where(builder.Not(banned), builder.Not(suspended))
+		}
 	}
 }
 
@@ -209,7 +291,11 @@ func (r *UserRepository) GetUsers(ctx context.Context, params dto.GetUsersParams
 
 	out := make([]models.User, len(users))
 	for i, user := range users {
-		out[i] = *userToModel(user)
+		model, err := userToModel(user)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = *model
 	}
 
 	return out, nil
@@ -221,16 +307,116 @@ func (r *UserRepository) UpdateUserByID(ctx context.Context, userID uuid.UUID, p
 		passwordHash = *params.Password
 	}
 
-	return r.repo.UpdateUserByID(ctx, sqlc.UpdateUserByIDParams{
-		Name:         params.Name,
-		PasswordHash: passwordHash,
-		Username:     params.Username,
-		Description:  params.Description,
-		AvatarUrl:    params.AvatarUrl,
-		UserID:       userID,
+	var avatarVariants []byte
+	if params.AvatarVariants != nil {
+		data, err := json.Marshal(params.AvatarVariants)
+		if err != nil {
+			return errors.Errorf("marshal avatar variants: %w", err)
+		}
+		avatarVariants = data
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		if params.Username != nil {
+			if err := r.recordUsernameChange(ctx, q, userID, *params.Username, params.UsernameReleasedAt); err != nil {
+				return err
+			}
+		}
+
+		err := q.UpdateUserByID(ctx, sqlc.UpdateUserByIDParams{
+			Name:         params.Name,
+			PasswordHash: passwordHash,
+			Username:     params.Username,
+			Description:  params.Description,
+			AvatarUrl:    params.AvatarUrl,
+			Email:        params.Email,
+			UserID:       userID,
+
+			NotifyNewDevices: params.NotifyNewDevices,
+			AvatarVariants:   avatarVariants,
+			Locale:           params.Locale,
+		})
+		if err != nil {
+			return errors.Errorf("sqlc: UpdateUserByID: %w", err)
+		}
+
+		eventType, ok := updateEventType(params)
+		if !ok {
+			return nil
+		}
+
+		return enqueueUserSnapshot(ctx, q, userID, eventType)
 	})
 }
 
+// recordUsernameChange keeps the current username in the history, it must be called before the username
+// is updated. The new username is removed from the history, as it is taken again.
+func (r *UserRepository) recordUsernameChange(
+	ctx context.Context, q *sqlc.Queries, userID uuid.UUID, newUsername string, releasedAt time.Time,
+) error {
+	if err := q.DeleteUsernameHistory(ctx, newUsername); err != nil {
+		return errors.Errorf("sqlc: DeleteUsernameHistory: %w", err)
+	}
+
+	err := q.CreateUsernameHistory(ctx, sqlc.CreateUsernameHistoryParams{
+		ReleasedAt:  releasedAt,
+		UserID:      userID,
+		NewUsername: newUsername,
+	})
+	if err != nil {
+		return errors.Errorf("sqlc: CreateUsernameHistory: %w", err)
+	}
+
+	return nil
+}
+
+// GetPreviousUsername returns the changed username if it is not released at now.
+func (r *UserRepository) GetPreviousUsername(ctx context.Context, username string, now time.Time) (*models.PreviousUsername, error) {
+	history, err := r.repo.GetUsernameHistory(ctx, username, now)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrUserNotFound
+		}
+		return nil, errors.Errorf("sqlc: GetUsernameHistory: %w", err)
+	}
+
+	return previousUsernameToModel(history), nil
+}
+
+// PruneUsernameHistory deletes the usernames released at now.
+func (r *UserRepository) PruneUsernameHistory(ctx context.Context, now time.Time) (pruned int, err error) {
+	count, err := r.repo.PruneUsernameHistory(ctx, now)
+	if err != nil {
+		return 0, errors.Errorf("sqlc: PruneUsernameHistory: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// updateEventType returns false if none of the public fields of the user was changed.
+func updateEventType(params dto.UpdateUsersParams) (models.UserEventType, bool) {
+	profileChanged := params.Name != nil || params.Username != nil || params.Description != nil
+
+	switch {
+	case profileChanged:
+		return models.UserEventUpdated, true
+	case params.AvatarUrl != nil:
+		return models.UserEventAvatarChanged, true
+	default:
+		return "", false
+	}
+}
+
 func (r *UserRepository) ClearUserAvatarURL(ctx context.Context, userID uuid.UUID) error {
-	return r.repo.ClearUserAvatarURL(ctx, userID)
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.repo.WithTx(tx)
+
+		if err := q.ClearUserAvatarURL(ctx, userID); err != nil {
+			return errors.Errorf("sqlc: ClearUserAvatarURL: %w", err)
+		}
+
+		return enqueueUserSnapshot(ctx, q, userID, models.UserEventAvatarChanged)
+	})
 }
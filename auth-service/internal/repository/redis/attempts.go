@@ -0,0 +1,116 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// AttemptsRepository counts failed attempts in a sliding window and stores temporary lockouts.
+type AttemptsRepository struct {
+	client redis.UniversalClient
+}
+
+func NewAttemptsRepository(client redis.UniversalClient) *AttemptsRepository {
+	return &AttemptsRepository{client: client}
+}
+
+func (*AttemptsRepository) getAttemptsKey(key string) string {
+	return fmt.Sprintf("attempts:%s", key)
+}
+
+func (*AttemptsRepository) getLockoutKey(key string) string {
+	return fmt.Sprintf("lockout:%s", key)
+}
+
+// GetLockout returns the longest remaining lockout among the keys, zero if none of them is locked.
+func (repo *AttemptsRepository) GetLockout(ctx context.Context, keys ...string) (time.Duration, error) {
+	cmds, err := repo.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.PTTL(ctx, repo.getLockoutKey(key))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Errorf("redis: pttl lockouts: %w", err)
+	}
+
+	var lockout time.Duration
+	for _, cmd := range cmds {
+		// negative values are returned for missing keys
+		lockout = max(lockout, cmd.(*redis.DurationCmd).Val())
+	}
+
+	return lockout, nil
+}
+
+// RegisterAttempt adds the attempt to the window and returns its id and the number of attempts
+// within the window, including this one. The commands run in a transaction, so the concurrent
+// attempts get distinct counts.
+func (repo *AttemptsRepository) RegisterAttempt(
+	ctx context.Context, key string, window time.Duration,
+) (id string, count int64, err error) {
+	var (
+		redisKey = repo.getAttemptsKey(key)
+		now      = time.Now()
+		countCmd *redis.IntCmd
+	)
+
+	id = uuid.NewString()
+
+	_, err = repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, redisKey, "-inf", strconv.FormatInt(now.Add(-window).UnixNano(), 10))
+		pipe.ZAdd(ctx, redisKey, redis.Z{
+			Score:  float64(now.UnixNano()),
+			Member: id,
+		})
+		countCmd = pipe.ZCard(ctx, redisKey)
+		pipe.PExpire(ctx, redisKey, window)
+		return nil
+	})
+	if err != nil {
+		return "", 0, errors.Errorf("redis: register attempt '%s': %w", redisKey, err)
+	}
+
+	return id, countCmd.Val(), nil
+}
+
+// ForgetAttempt removes the successful attempt from the window, only the failed ones are counted.
+func (repo *AttemptsRepository) ForgetAttempt(ctx context.Context, key, id string) error {
+	redisKey := repo.getAttemptsKey(key)
+
+	if err := repo.client.ZRem(ctx, redisKey, id).Err(); err != nil {
+		return errors.Errorf("redis: zrem '%s': %w", redisKey, err)
+	}
+
+	return nil
+}
+
+func (repo *AttemptsRepository) Lock(ctx context.Context, key string, duration time.Duration) error {
+	redisKey := repo.getLockoutKey(key)
+
+	if err := repo.client.Set(ctx, redisKey, 1, duration).Err(); err != nil {
+		return errors.Errorf("redis: set '%s': %w", redisKey, err)
+	}
+
+	return nil
+}
+
+// ResetAttempts clears failed attempts counters and lockouts of the keys.
+func (repo *AttemptsRepository) ResetAttempts(ctx context.Context, keys ...string) error {
+	redisKeys := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		redisKeys = append(redisKeys, repo.getAttemptsKey(key), repo.getLockoutKey(key))
+	}
+
+	if err := repo.client.Del(ctx, redisKeys...).Err(); err != nil {
+		return errors.Errorf("redis: del attempts: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestAttemptsRepository(t *testing.T) *AttemptsRepository {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return NewAttemptsRepository(client)
+}
+
+func TestRegisterAttemptConcurrent(t *testing.T) {
+	const attempts = 20
+
+	var (
+		ctx  = context.Background()
+		repo = newTestAttemptsRepository(t)
+	)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		counts = make(map[int64]bool)
+	)
+
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, count, err := repo.RegisterAttempt(ctx, "login:user:test", time.Minute)
+			if err != nil {
+				t.Errorf("register attempt: %v", err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if counts[count] {
+				t.Errorf("count %d returned twice", count)
+			}
+			counts[count] = true
+		}()
+	}
+	wg.Wait()
+
+	for count := int64(1); count <= attempts; count++ {
+		if !counts[count] {
+			t.Errorf("count %d was not returned", count)
+		}
+	}
+}
+
+func TestForgetAttempt(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		repo = newTestAttemptsRepository(t)
+	)
+
+	id, _, err := repo.RegisterAttempt(ctx, "code:email:test", time.Minute)
+	if err != nil {
+		t.Fatalf("register attempt: %v", err)
+	}
+
+	if err = repo.ForgetAttempt(ctx, "code:email:test", id); err != nil {
+		t.Fatalf("forget attempt: %v", err)
+	}
+
+	_, count, err := repo.RegisterAttempt(ctx, "code:email:test", time.Minute)
+	if err != nil {
+		t.Fatalf("register attempt: %v", err)
+	}
+
+	if count != 1 {
+		t.Fatalf("count = %d after the forgotten attempt, want 1", count)
+	}
+}
@@ -102,7 +102,5 @@ func (repo *ConfirmationCodesRepository) CheckCode(ctx context.Context, email, c
 		return &user, nil
 	}
 
-	// FIXME: the hell we return nil?
-
-	return nil, nil
+	return nil, apperrors.ErrConfirmationCodeNotFound
 }
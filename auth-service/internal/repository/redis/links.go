@@ -0,0 +1,119 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+type LinksRepository struct {
+	client redis.UniversalClient
+}
+
+func NewLinksRepository(client redis.UniversalClient) *LinksRepository {
+	return &LinksRepository{client: client}
+}
+
+func (*LinksRepository) getKey(id string) string {
+	return fmt.Sprintf("links:%s", id)
+}
+
+func (repo *LinksRepository) StoreLink(ctx context.Context, link models.Link) error {
+	data := Link{
+		Purpose:   string(link.Purpose),
+		Email:     link.Email,
+		NonceHash: link.NonceHash,
+		ExpiresAt: link.ExpiresAt,
+		UserID:    link.UserID,
+		SessionID: link.SessionID,
+	}
+	if link.Registration != nil {
+		registration := UserConfirmationData(*link.Registration)
+		data.Registration = &registration
+	}
+	if link.Identity != nil {
+		data.Identity = &UserIdentity{
+			Provider: link.Identity.Provider,
+			Subject:  link.Identity.Subject,
+			UserID:   link.Identity.UserID,
+			Email:    link.Identity.Email,
+		}
+	}
+
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Errorf("marshal link: %w", err)
+	}
+
+	key := repo.getKey(link.ID)
+
+	if err = repo.client.Set(ctx, key, bytes, time.Until(link.ExpiresAt)).Err(); err != nil {
+		return errors.Errorf("redis: set '%s': %w", key, err)
+	}
+
+	return nil
+}
+
+func (repo *LinksRepository) GetLink(ctx context.Context, id string) (*models.Link, error) {
+	key := repo.getKey(id)
+
+	bytes, err := repo.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apperrors.ErrLinkNotFound
+		}
+		return nil, errors.Errorf("redis: get '%s': %w", key, err)
+	}
+
+	var data Link
+	if err = json.Unmarshal(bytes, &data); err != nil {
+		return nil, errors.Errorf("unmarshal link: %w", err)
+	}
+
+	link := &models.Link{
+		ID:        id,
+		Purpose:   models.LinkPurpose(data.Purpose),
+		Email:     data.Email,
+		NonceHash: data.NonceHash,
+		ExpiresAt: data.ExpiresAt,
+		UserID:    data.UserID,
+		SessionID: data.SessionID,
+	}
+	if data.Registration != nil {
+		registration := models.ConfirmationUserData(*data.Registration)
+		link.Registration = &registration
+	}
+	if data.Identity != nil {
+		link.Identity = &models.UserIdentity{
+			Provider: data.Identity.Provider,
+			Subject:  data.Identity.Subject,
+			UserID:   data.Identity.UserID,
+			Email:    data.Identity.Email,
+		}
+	}
+
+	return link, nil
+}
+
+// DeleteLink returns apperrors.ErrLinkNotFound if the link was already deleted,
+// so that concurrent requests could not use the same link twice.
+func (repo *LinksRepository) DeleteLink(ctx context.Context, id string) error {
+	key := repo.getKey(id)
+
+	deleted, err := repo.client.Del(ctx, key).Result()
+	if err != nil {
+		return errors.Errorf("redis: del '%s': %w", key, err)
+	}
+
+	if deleted == 0 {
+		return apperrors.ErrLinkNotFound
+	}
+
+	return nil
+}
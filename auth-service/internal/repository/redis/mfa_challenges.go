@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+type MFAChallengesRepository struct {
+	client redis.UniversalClient
+}
+
+func NewMFAChallengesRepository(client redis.UniversalClient) *MFAChallengesRepository {
+	return &MFAChallengesRepository{client: client}
+}
+
+const (
+	mfaChallengeDataField     = "data"
+	mfaChallengeAttemptsField = "attempts"
+)
+
+func (*MFAChallengesRepository) getKey(token string) string {
+	return fmt.Sprintf("mfa:challenge:%s", token)
+}
+
+func (repo *MFAChallengesRepository) StoreChallenge(ctx context.Context, challenge models.MFAChallenge) error {
+	data, err := json.Marshal(MFAChallenge{
+		UserID:    challenge.UserID,
+		ExpiresAt: challenge.ExpiresAt,
+	})
+	if err != nil {
+		return errors.Errorf("marshal challenge: %w", err)
+	}
+
+	key := repo.getKey(challenge.Token)
+
+	_, err = repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, mfaChallengeDataField, data, mfaChallengeAttemptsField, 0)
+		pipe.ExpireAt(ctx, key, challenge.ExpiresAt)
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("redis: store challenge: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *MFAChallengesRepository) GetChallenge(ctx context.Context, token string) (*models.MFAChallenge, error) {
+	key := repo.getKey(token)
+
+	data, err := repo.client.HGet(ctx, key, mfaChallengeDataField).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apperrors.ErrMFAChallengeNotFound
+		}
+		return nil, errors.Errorf("redis: hget '%s': %w", key, err)
+	}
+
+	var challenge MFAChallenge
+	if err = json.Unmarshal(data, &challenge); err != nil {
+		return nil, errors.Errorf("unmarshal challenge: %w", err)
+	}
+
+	if time.Now().After(challenge.ExpiresAt) {
+		return nil, apperrors.ErrMFAChallengeNotFound
+	}
+
+	return &models.MFAChallenge{
+		Token:     token,
+		UserID:    challenge.UserID,
+		ExpiresAt: challenge.ExpiresAt,
+	}, nil
+}
+
+// RegisterFailedAttempt increments failed attempts counter and returns its new value.
+func (repo *MFAChallengesRepository) RegisterFailedAttempt(ctx context.Context, challenge models.MFAChallenge) (int64, error) {
+	key := repo.getKey(challenge.Token)
+
+	var attempts *redis.IntCmd
+	_, err := repo.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		attempts = pipe.HIncrBy(ctx, key, mfaChallengeAttemptsField, 1)
+		// key could expire between reads, so we make sure the counter does not outlive the challenge
+		pipe.ExpireAt(ctx, key, challenge.ExpiresAt)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Errorf("redis: hincrby '%s': %w", key, err)
+	}
+
+	return attempts.Val(), nil
+}
+
+func (repo *MFAChallengesRepository) DeleteChallenge(ctx context.Context, token string) error {
+	key := repo.getKey(token)
+
+	if err := repo.client.Del(ctx, key).Err(); err != nil {
+		return errors.Errorf("redis: del '%s': %w", key, err)
+	}
+
+	return nil
+}
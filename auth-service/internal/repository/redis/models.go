@@ -7,9 +7,19 @@ import (
 )
 
 type Session struct {
-	Token     string    `json:"token"`
-	CreatedAt time.Time `json:"created_at"`
-	ExpiresAt time.Time `json:"expires_at"`
+	ID          uuid.UUID `json:"id"`
+	Token       string    `json:"token"`
+	CreatedAt   time.Time `json:"created_at"`
+	RefreshedAt time.Time `json:"refreshed_at,omitzero"`
+	ExpiresAt   time.Time `json:"expires_at"`
+
+	// PreviousTokens holds fingerprints of the rotated session tokens to detect their reuse.
+	PreviousTokens []string `json:"previous_tokens,omitempty"`
+
+	IP         string `json:"ip,omitempty"`
+	UserAgent  string `json:"user_agent,omitempty"`
+	Location   string `json:"location,omitempty"`
+	DeviceName string `json:"device_name,omitempty"`
 }
 
 type UserConfirmationData struct {
@@ -19,11 +29,47 @@ type UserConfirmationData struct {
 	Username     string    `json:"username"`
 	Name         string    `json:"name"`
 	PasswordHash string    `json:"password_hash"`
+	Locale       string    `json:"locale,omitempty"`
 	ExpiresAt    time.Time `json:"expires_at"` // To track expiration time for the confirmation code
+
+	LinkID string `json:"link_id,omitempty"`
 }
 
 type ResetPasswordData struct {
 	UserID    uuid.UUID `json:"user_id"`
 	Code      string    `json:"code"`
 	ExpiresAt time.Time `json:"expires_at"`
+	LinkID    string    `json:"link_id,omitempty"`
+}
+
+type Link struct {
+	Purpose   string    `json:"purpose"`
+	Email     string    `json:"email"`
+	NonceHash []byte    `json:"nonce_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	UserID       uuid.UUID             `json:"user_id,omitzero"`
+	Registration *UserConfirmationData `json:"registration,omitempty"`
+	Identity     *UserIdentity         `json:"identity,omitempty"`
+	SessionID    *uuid.UUID            `json:"session_id,omitempty"`
+}
+
+type UserIdentity struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"`
+	UserID   uuid.UUID `json:"user_id"`
+	Email    string    `json:"email"`
+}
+
+type OIDCAuthorization struct {
+	Provider         string    `json:"provider"`
+	Nonce            string    `json:"nonce"`
+	CodeVerifier     string    `json:"code_verifier"`
+	BrowserNonceHash []byte    `json:"browser_nonce_hash"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+type MFAChallenge struct {
+	UserID    uuid.UUID `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
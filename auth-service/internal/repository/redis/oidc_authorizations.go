@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+type OIDCAuthorizationsRepository struct {
+	client redis.UniversalClient
+}
+
+func NewOIDCAuthorizationsRepository(client redis.UniversalClient) *OIDCAuthorizationsRepository {
+	return &OIDCAuthorizationsRepository{client: client}
+}
+
+func (*OIDCAuthorizationsRepository) getKey(state string) string {
+	return fmt.Sprintf("oidc:authorization:%s", state)
+}
+
+func (repo *OIDCAuthorizationsRepository) StoreAuthorization(ctx context.Context, authorization models.OIDCAuthorization) error {
+	bytes, err := json.Marshal(OIDCAuthorization{
+		Provider:         authorization.Provider,
+		Nonce:            authorization.Nonce,
+		CodeVerifier:     authorization.CodeVerifier,
+		BrowserNonceHash: authorization.BrowserNonceHash,
+		ExpiresAt:        authorization.ExpiresAt,
+	})
+	if err != nil {
+		return errors.Errorf("marshal authorization: %w", err)
+	}
+
+	key := repo.getKey(authorization.State)
+
+	if err = repo.client.Set(ctx, key, bytes, time.Until(authorization.ExpiresAt)).Err(); err != nil {
+		return errors.Errorf("redis: set '%s': %w", key, err)
+	}
+
+	return nil
+}
+
+// PopAuthorization deletes the authorization, so that the state could be used only once.
+func (repo *OIDCAuthorizationsRepository) PopAuthorization(ctx context.Context, state string) (*models.OIDCAuthorization, error) {
+	key := repo.getKey(state)
+
+	bytes, err := repo.client.GetDel(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apperrors.ErrOIDCAuthorizationNotFound
+		}
+		return nil, errors.Errorf("redis: getdel '%s': %w", key, err)
+	}
+
+	var data OIDCAuthorization
+	if err = json.Unmarshal(bytes, &data); err != nil {
+		return nil, errors.Errorf("unmarshal authorization: %w", err)
+	}
+
+	return &models.OIDCAuthorization{
+		State:            state,
+		Provider:         data.Provider,
+		Nonce:            data.Nonce,
+		CodeVerifier:     data.CodeVerifier,
+		BrowserNonceHash: data.BrowserNonceHash,
+		ExpiresAt:        data.ExpiresAt,
+	}, nil
+}
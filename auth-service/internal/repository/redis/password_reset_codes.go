@@ -12,18 +12,29 @@ import (
 	"github.com/tech-inspire/backend/auth-service/internal/models"
 )
 
+// ResetPasswordCodesRepository stores codes sent to the emails of existing users.
 type ResetPasswordCodesRepository struct {
-	client redis.UniversalClient
+	client  redis.UniversalClient
+	purpose string
 }
 
 func NewResetCodesRepository(client redis.UniversalClient) *ResetPasswordCodesRepository {
 	return &ResetPasswordCodesRepository{
-		client: client,
+		client:  client,
+		purpose: "password_reset",
 	}
 }
 
-func (*ResetPasswordCodesRepository) getKey(email string) string {
-	return fmt.Sprintf("codes:password_reset:%s", email)
+// NewEmailChangeCodesRepository stores codes sent to the new email of the user, keyed by the new email.
+func NewEmailChangeCodesRepository(client redis.UniversalClient) *ResetPasswordCodesRepository {
+	return &ResetPasswordCodesRepository{
+		client:  client,
+		purpose: "email_change",
+	}
+}
+
+func (repo *ResetPasswordCodesRepository) getKey(email string) string {
+	return fmt.Sprintf("codes:%s:%s", repo.purpose, email)
 }
 
 func (repo *ResetPasswordCodesRepository) StoreCode(ctx context.Context, data models.ResetPasswordData) error {
@@ -31,6 +42,7 @@ func (repo *ResetPasswordCodesRepository) StoreCode(ctx context.Context, data mo
 		UserID:    data.UserID,
 		Code:      data.Code,
 		ExpiresAt: data.ExpiresAt,
+		LinkID:    data.LinkID,
 	}
 
 	bytes, err := json.Marshal(redisUser)
@@ -116,5 +128,6 @@ func (repo *ResetPasswordCodesRepository) CheckCode(ctx context.Context, email,
 		Code:      rsData.Code,
 		Email:     email,
 		ExpiresAt: rsData.ExpiresAt,
+		LinkID:    rsData.LinkID,
 	}, nil
 }
@@ -2,10 +2,16 @@ package redis
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"sort"
+	"math/rand/v2"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
@@ -14,6 +20,10 @@ import (
 	"github.com/tech-inspire/backend/auth-service/internal/models"
 )
 
+// addSessionRetryDelay is the upper bound of the delay before the first retry of adding the session,
+// it grows with every attempt.
+const addSessionRetryDelay = 5 * time.Millisecond
+
 type SessionRepository struct {
 	client redis.UniversalClient
 }
@@ -27,26 +37,44 @@ func getUserSessionsHashKey(userID uuid.UUID) string {
 }
 
 func getSessionHashField(sessionID uuid.UUID) string {
-	if t := sessionID.Time(); t != 0 {
-		// if version of the uuid is 7, then we can use nested time as an id for sorting
-		return strconv.FormatInt(int64(t), 10)
+	return sessionID.String()
+}
+
+// getLegacySessionHashField is the field of the sessions stored before the fields were the ids.
+// It is derived from the uuid time, so sessions created in the same millisecond collided.
+func getLegacySessionHashField(sessionID uuid.UUID) string {
+	return strconv.FormatInt(int64(sessionID.Time()), 10)
+}
+
+// getSessionHashFields returns the possible fields of the session, the current one first.
+func getSessionHashFields(sessionID uuid.UUID) []string {
+	return []string{getSessionHashField(sessionID), getLegacySessionHashField(sessionID)}
+}
+
+// findSession returns the field the session is stored in and its data.
+func findSession(ctx context.Context, client redis.Cmdable, key string, sessionID uuid.UUID) (string, []byte, error) {
+	fields := getSessionHashFields(sessionID)
+
+	values, err := client.HMGet(ctx, key, fields...).Result()
+	if err != nil {
+		return "", nil, errors.Errorf("redis: hmget '%s': %w", key, err)
 	}
 
-	return sessionID.String()
+	for i, value := range values {
+		if data, ok := value.(string); ok {
+			return fields[i], []byte(data), nil
+		}
+	}
+
+	return "", nil, apperrors.ErrSessionNotFound
 }
 
 func (s SessionRepository) GetUserSession(ctx context.Context, userID, sessionID uuid.UUID) (*models.Session, error) {
-	var (
-		key   = getUserSessionsHashKey(userID)
-		field = getSessionHashField(sessionID)
-	)
+	key := getUserSessionsHashKey(userID)
 
-	data, err := s.client.HGet(ctx, key, field).Bytes()
+	_, data, err := findSession(ctx, s.client, key, sessionID)
 	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			return nil, apperrors.ErrSessionNotFound
-		}
-		return nil, errors.Errorf("redis: get '%s': %w", key, err)
+		return nil, err
 	}
 
 	var schemaSession Session
@@ -55,83 +83,359 @@ func (s SessionRepository) GetUserSession(ctx context.Context, userID, sessionID
 		return nil, errors.Errorf("unmarshal session from json: %w", err)
 	}
 
-	session := models.Session{
-		ID:        sessionID,
-		UserID:    userID,
-		Token:     schemaSession.Token,
-		CreatedAt: schemaSession.CreatedAt,
-		ExpiresAt: schemaSession.ExpiresAt,
-	}
+	session := sessionToModel(userID, sessionID, schemaSession)
 	return &session, nil
 }
 
+func sessionToModel(userID, sessionID uuid.UUID, session Session) models.Session {
+	refreshedAt := session.RefreshedAt
+	if refreshedAt.IsZero() {
+		refreshedAt = session.CreatedAt
+	}
+
+	return models.Session{
+		ID:          sessionID,
+		UserID:      userID,
+		Token:       session.Token,
+		CreatedAt:   session.CreatedAt,
+		RefreshedAt: refreshedAt,
+		ExpiresAt:   session.ExpiresAt,
+		Client: models.ClientInfo{
+			IP:         session.IP,
+			UserAgent:  session.UserAgent,
+			Location:   session.Location,
+			DeviceName: session.DeviceName,
+		},
+	}
+}
+
+// maxRememberedSessionTokens limits the number of rotated token fingerprints kept in the session.
+const maxRememberedSessionTokens = 64
+
+func sessionTokenFingerprint(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// RotateUserSessionToken replaces the session token if the presented one is current.
+// If the presented token was already rotated, apperrors.ErrRefreshTokenReused is returned.
+func (s SessionRepository) RotateUserSessionToken(
+	ctx context.Context, userID, sessionID uuid.UUID, presentedToken, newToken string, refreshedAt time.Time,
+) (*models.Session, error) {
+	return s.updateSession(ctx, userID, sessionID, func(session *Session) error {
+		if subtle.ConstantTimeCompare([]byte(session.Token), []byte(presentedToken)) != 1 {
+			if slices.Contains(session.PreviousTokens, sessionTokenFingerprint(presentedToken)) {
+				return apperrors.ErrRefreshTokenReused
+			}
+			return apperrors.ErrForbidden
+		}
+
+		session.PreviousTokens = append(session.PreviousTokens, sessionTokenFingerprint(presentedToken))
+		if extra := len(session.PreviousTokens) - maxRememberedSessionTokens; extra > 0 {
+			session.PreviousTokens = session.PreviousTokens[extra:]
+		}
+		session.Token = newToken
+		session.RefreshedAt = refreshedAt
+
+		return nil
+	})
+}
+
+func (s SessionRepository) RenameUserSession(ctx context.Context, userID, sessionID uuid.UUID, deviceName string) error {
+	_, err := s.updateSession(ctx, userID, sessionID, func(session *Session) error {
+		session.DeviceName = deviceName
+		return nil
+	})
+
+	return err
+}
+
+// updateSession applies the update to the session using optimistic locking.
+func (s SessionRepository) updateSession(
+	ctx context.Context, userID, sessionID uuid.UUID, update func(session *Session) error,
+) (*models.Session, error) {
+	var (
+		key = getUserSessionsHashKey(userID)
+
+		updated *models.Session
+	)
+
+	txf := func(tx *redis.Tx) error {
+		field, data, err := findSession(ctx, tx, key, sessionID)
+		if err != nil {
+			return err
+		}
+
+		var schemaSession Session
+		if err = json.Unmarshal(data, &schemaSession); err != nil {
+			return errors.Errorf("unmarshal session from json: %w", err)
+		}
+
+		if err = update(&schemaSession); err != nil {
+			return err
+		}
+
+		data, err = json.Marshal(schemaSession)
+		if err != nil {
+			return errors.Errorf("marshal session into json: %w", err)
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, key, field, data)
+			// overwriting the field resets its ttl
+			pipe.HExpire(ctx, key, time.Until(schemaSession.ExpiresAt), field)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		session := sessionToModel(userID, sessionID, schemaSession)
+		updated = &session
+
+		return nil
+	}
+
+	const maxRetries = 3
+	for range maxRetries {
+		err := s.client.Watch(ctx, txf, key)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue // session was modified concurrently, so we retry with the new state
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return updated, nil
+	}
+
+	return nil, errors.Errorf("redis: update session (key '%s', session '%s'): too many concurrent updates", key, sessionID)
+}
+
+// GetUserSessions returns all active sessions of the user.
+func (s SessionRepository) GetUserSessions(ctx context.Context, userID uuid.UUID) ([]models.Session, error) {
+	key := getUserSessionsHashKey(userID)
+
+	values, err := s.client.HVals(ctx, key).Result()
+	if err != nil {
+		return nil, errors.Errorf("redis: hvals '%s': %w", key, err)
+	}
+
+	sessions := make([]models.Session, 0, len(values))
+	for _, value := range values {
+		var schemaSession Session
+		if err = json.Unmarshal([]byte(value), &schemaSession); err != nil {
+			return nil, errors.Errorf("unmarshal session from json: %w", err)
+		}
+
+		if schemaSession.ID == uuid.Nil {
+			continue // sessions created before ids were stored can not be addressed
+		}
+
+		sessions = append(sessions, sessionToModel(userID, schemaSession.ID, schemaSession))
+	}
+
+	return sessions, nil
+}
+
+// DeleteUserSessions deletes all sessions of the user except the given ones.
+func (s SessionRepository) DeleteUserSessions(ctx context.Context, userID uuid.UUID, exceptSessionIDs ...uuid.UUID) error {
+	key := getUserSessionsHashKey(userID)
+
+	if len(exceptSessionIDs) == 0 {
+		if err := s.client.Del(ctx, key).Err(); err != nil {
+			return errors.Errorf("redis: del '%s': %w", key, err)
+		}
+		return nil
+	}
+
+	fields, err := s.client.HKeys(ctx, key).Result()
+	if err != nil {
+		return errors.Errorf("redis: hkeys '%s': %w", key, err)
+	}
+
+	keep := make([]string, 0, 2*len(exceptSessionIDs))
+	for _, sessionID := range exceptSessionIDs {
+		keep = append(keep, getSessionHashFields(sessionID)...)
+	}
+
+	fields = slices.DeleteFunc(fields, func(field string) bool {
+		return slices.Contains(keep, field)
+	})
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if err = s.client.HDel(ctx, key, fields...).Err(); err != nil {
+		return errors.Errorf("redis: hdel '%s': %w", key, err)
+	}
+
+	return nil
+}
+
 func (s SessionRepository) DeleteUserSession(ctx context.Context, userID, sessionID uuid.UUID) error {
 	key := getUserSessionsHashKey(userID)
 	return s.deleteSession(ctx, key, sessionID)
 }
 
 func (s SessionRepository) deleteSession(ctx context.Context, key string, sessionID uuid.UUID) error {
-	field := getSessionHashField(sessionID)
-
-	err := s.client.HDel(ctx, key, field).Err()
+	err := s.client.HDel(ctx, key, getSessionHashFields(sessionID)...).Err()
 	if err != nil {
-		return errors.Errorf("redis: del (key '%s', field '%s'): %w", key, field, err)
+		return errors.Errorf("redis: del (key '%s', session '%s'): %w", key, sessionID, err)
 	}
 
 	return nil
 }
 
-func (s SessionRepository) AddUserSession(ctx context.Context, session models.Session, sessionLimit int) error {
+// AddUserSession stores the session, evicting sessions above the limit according to the policy.
+// Evicted sessions are returned, so that the caller could notify the user.
+func (s SessionRepository) AddUserSession(
+	ctx context.Context, session models.Session, sessionLimit int, policy models.SessionEvictionPolicy,
+) ([]models.Session, error) {
 	key := getUserSessionsHashKey(session.UserID)
 
 	schemaSession := Session{
-		Token:     session.Token,
-		CreatedAt: session.CreatedAt,
-		ExpiresAt: session.ExpiresAt,
+		ID:          session.ID,
+		Token:       session.Token,
+		CreatedAt:   session.CreatedAt,
+		RefreshedAt: session.RefreshedAt,
+		ExpiresAt:   session.ExpiresAt,
+		IP:          session.Client.IP,
+		UserAgent:   session.Client.UserAgent,
+		Location:    session.Client.Location,
+		DeviceName:  session.Client.DeviceName,
 	}
 
-	return s.addSession(ctx, key, session.ID, schemaSession, sessionLimit)
+	return s.addSession(ctx, key, session.UserID, session.ID, schemaSession, sessionLimit, policy)
 }
 
-func (s SessionRepository) addSession(ctx context.Context, key string, sessionID uuid.UUID, session Session, sessionLimit int) error {
-	sessionCount, err := s.client.HLen(ctx, key).Result()
+// addSession checks the limit and stores the session in one transaction,
+// so concurrent logins can not exceed the limit.
+func (s SessionRepository) addSession(
+	ctx context.Context, key string, userID, sessionID uuid.UUID, session Session,
+	sessionLimit int, policy models.SessionEvictionPolicy,
+) ([]models.Session, error) {
+	data, err := json.Marshal(session)
 	if err != nil {
-		return errors.Errorf("redis: hlen (key '%s'): %w", key, err)
+		return nil, errors.Errorf("marshal session into json: %w", err)
 	}
 
-	if sessionCount >= int64(sessionLimit) {
-		keys, err := s.client.HKeys(ctx, key).Result()
+	field := getSessionHashField(sessionID)
+
+	var evicted []models.Session
+
+	txf := func(tx *redis.Tx) error {
+		values, err := tx.HGetAll(ctx, key).Result()
 		if err != nil {
-			return errors.Errorf("redis: hlen (key '%s'): %w", key, err)
+			return errors.Errorf("redis: hgetall '%s': %w", key, err)
 		}
+		delete(values, field)
 
-		if len(keys) >= sessionLimit {
-			sort.Strings(keys) // sessionIDs are sortable
+		evictedFields, evictedSessions, err := sessionsToEvict(values, sessionLimit, policy)
+		if err != nil {
+			return err
+		}
 
-			fieldsToDelete := keys[sessionLimit-1:]
-			err = s.client.HDel(ctx, key, fieldsToDelete...).Err()
-			if err != nil {
-				return errors.Errorf("redis: hdel (key '%s'): %w", key, err)
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if len(evictedFields) > 0 {
+				pipe.HDel(ctx, key, evictedFields...)
 			}
+			pipe.HSet(ctx, key, field, data)
+			pipe.HExpire(ctx, key, time.Until(session.ExpiresAt), field)
+			return nil
+		})
+		if err != nil {
+			return err
 		}
+
+		evicted = make([]models.Session, len(evictedSessions))
+		for i, evictedSession := range evictedSessions {
+			evicted[i] = sessionToModel(userID, evictedSession.ID, evictedSession)
+		}
+
+		return nil
 	}
 
-	data, err := json.Marshal(session)
-	if err != nil {
-		return errors.Errorf("marshal session into json: %w", err)
+	const maxRetries = 10
+	for attempt := range maxRetries {
+		err = s.client.Watch(ctx, txf, key)
+		if errors.Is(err, redis.TxFailedErr) {
+			// another session was added or removed concurrently, so the limit is checked again;
+			// the random delay lets concurrent logins of the user take turns
+			if err = sleepContext(ctx, rand.N(time.Duration(attempt+1)*addSessionRetryDelay)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, errors.Errorf("redis: add session (key '%s', field '%s'): %w", key, field, err)
+		}
+
+		return evicted, nil
 	}
 
-	field := getSessionHashField(sessionID)
+	return nil, errors.Errorf("redis: add session (key '%s', field '%s'): too many concurrent updates", key, field)
+}
 
-	err = s.client.HSet(ctx, key, field, data).Err()
-	if err != nil {
-		return errors.Errorf("redis: hset (key '%s', field '%s'): %w", key, field, err)
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
+}
 
-	err = s.client.HExpireAt(ctx, key, session.ExpiresAt, field).Err()
-	if err != nil {
-		return errors.Errorf("redis: hexpireat (key '%s', field '%s'): %w", key, field, err)
+// sessionsToEvict selects sessions to be deleted to make room for one more session.
+func sessionsToEvict(
+	values map[string]string, sessionLimit int, policy models.SessionEvictionPolicy,
+) (fields []string, sessions []Session, err error) {
+	count := len(values) - sessionLimit + 1
+	if count <= 0 {
+		return nil, nil, nil
 	}
 
-	return nil
+	type entry struct {
+		field   string
+		session Session
+	}
+
+	entries := make([]entry, 0, len(values))
+	for field, value := range values {
+		var schemaSession Session
+		if err = json.Unmarshal([]byte(value), &schemaSession); err != nil {
+			return nil, nil, errors.Errorf("unmarshal session from json: %w", err)
+		}
+
+		entries = append(entries, entry{field: field, session: schemaSession})
+	}
+
+	sortKey := func(session Session) time.Time {
+		if policy == models.EvictLeastRecentlyRefreshed && !session.RefreshedAt.IsZero() {
+			return session.RefreshedAt
+		}
+		return session.CreatedAt
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		if c := sortKey(a.session).Compare(sortKey(b.session)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.field, b.field)
+	})
+
+	// a limit below 1 would evict more sessions than there are
+	count = min(count, len(entries))
+
+	fields = make([]string, count)
+	sessions = make([]Session, count)
+	for i, e := range entries[:count] {
+		fields[i] = e.field
+		sessions[i] = e.session
+	}
+
+	return fields, sessions, nil
 }
@@ -0,0 +1,164 @@
+package redis
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+func newTestSessionRepository(t *testing.T) *SessionRepository {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return NewSessionRepository(client)
+}
+
+func newTestSession(userID uuid.UUID, createdAt time.Time) models.Session {
+	return models.Session{
+		ID:          uuid.Must(uuid.NewV7()),
+		UserID:      userID,
+		Token:       uuid.NewString(),
+		CreatedAt:   createdAt,
+		RefreshedAt: createdAt,
+		ExpiresAt:   createdAt.Add(time.Hour),
+	}
+}
+
+func TestAddUserSessionConcurrentLogins(t *testing.T) {
+	const (
+		limit  = 3
+		logins = 8
+	)
+
+	var (
+		ctx    = context.Background()
+		repo   = newTestSessionRepository(t)
+		userID = uuid.New()
+		now    = time.Now()
+	)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		added   = make(map[uuid.UUID]bool)
+		evicted = make(map[uuid.UUID]int)
+	)
+
+	for i := range logins {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			session := newTestSession(userID, now.Add(time.Duration(i)*time.Millisecond))
+
+			out, err := repo.AddUserSession(ctx, session, limit, models.EvictOldestCreated)
+			if err != nil {
+				t.Errorf("add session: %v", err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			added[session.ID] = true
+			for _, s := range out {
+				evicted[s.ID]++
+			}
+		}()
+	}
+	wg.Wait()
+
+	sessions, err := repo.GetUserSessions(ctx, userID)
+	if err != nil {
+		t.Fatalf("get sessions: %v", err)
+	}
+
+	if len(sessions) != limit {
+		t.Fatalf("got %d sessions, want %d", len(sessions), limit)
+	}
+
+	if len(evicted) != len(added)-limit {
+		t.Errorf("got %d evicted sessions, want %d", len(evicted), len(added)-limit)
+	}
+
+	for id, count := range evicted {
+		if count > 1 {
+			t.Errorf("session %s evicted %d times", id, count)
+		}
+	}
+
+	for _, s := range sessions {
+		if evicted[s.ID] > 0 {
+			t.Errorf("session %s is stored and evicted", s.ID)
+		}
+	}
+}
+
+func TestAddUserSessionEvictionPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy models.SessionEvictionPolicy
+		want   int // index of the evicted session
+	}{
+		{name: "oldest created", policy: models.EvictOldestCreated, want: 0},
+		{name: "least recently refreshed", policy: models.EvictLeastRecentlyRefreshed, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx    = context.Background()
+				repo   = newTestSessionRepository(t)
+				userID = uuid.New()
+				now    = time.Now()
+			)
+
+			first := newTestSession(userID, now)
+			first.RefreshedAt = now.Add(time.Minute)
+			second := newTestSession(userID, now.Add(time.Second))
+
+			for _, s := range []models.Session{first, second} {
+				if _, err := repo.AddUserSession(ctx, s, 2, tt.policy); err != nil {
+					t.Fatalf("add session: %v", err)
+				}
+			}
+
+			evicted, err := repo.AddUserSession(ctx, newTestSession(userID, now.Add(2*time.Second)), 2, tt.policy)
+			if err != nil {
+				t.Fatalf("add session: %v", err)
+			}
+
+			want := []models.Session{first, second}[tt.want]
+			if len(evicted) != 1 || evicted[0].ID != want.ID {
+				t.Fatalf("got evicted %v, want %s", evicted, want.ID)
+			}
+		})
+	}
+}
+
+func TestSessionsToEvictLimitBelowOne(t *testing.T) {
+	values := map[string]string{
+		"1": `{"created_at":"2026-01-01T00:00:00Z"}`,
+		"2": `{"created_at":"2026-01-02T00:00:00Z"}`,
+	}
+
+	for _, limit := range []int{0, -1} {
+		fields, _, err := sessionsToEvict(values, limit, models.EvictOldestCreated)
+		if err != nil {
+			t.Fatalf("limit %d: %v", limit, err)
+		}
+
+		if len(fields) != len(values) {
+			t.Errorf("limit %d: got %d evicted, want %d", limit, len(fields), len(values))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package avatarstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+)
+
+const exportArchiveContentType = "application/zip"
+
+// ExportStorage keeps the data exports in the private bucket, which is shared with other
+// services uploading their parts of the exports. The archives are downloaded by presigned links.
+type ExportStorage struct {
+	client        *s3.Client
+	presignClient *s3.PresignClient
+	bucketName    string
+}
+
+func NewExportStorage(cfg *config.Config, client *s3.Client) *ExportStorage {
+	return &ExportStorage{
+		client:        client,
+		presignClient: s3.NewPresignClient(client),
+		bucketName:    cfg.S3.ExportsBucketName,
+	}
+}
+
+func (s ExportStorage) GetExportObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucketName,
+		Key:    &key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get object %s: %w", key, err)
+	}
+
+	return out.Body, nil
+}
+
+func (s ExportStorage) PutExportArchive(ctx context.Context, key string, body io.ReadSeeker, size int64) error {
+	contentType := exportArchiveContentType
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Body:          body,
+		Bucket:        &s.bucketName,
+		Key:           &key,
+		ContentLength: &size,
+		ContentType:   &contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("put object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s ExportStorage) DeleteExportObject(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: &s.bucketName,
+		Key:    &key,
+	})
+	if err != nil {
+		return fmt.Errorf("remove object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// PresignExportArchive returns the download link, S3 limits its lifetime to 7 days.
+func (s ExportStorage) PresignExportArchive(ctx context.Context, key string, expire time.Duration) (string, error) {
+	res, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucketName,
+		Key:    &key,
+	}, s3.WithPresignExpires(expire))
+	if err != nil {
+		return "", fmt.Errorf("presign: get object %s: %w", key, err)
+	}
+
+	return res.URL, nil
+}
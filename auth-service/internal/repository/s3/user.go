@@ -5,27 +5,41 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
 )
 
-func (AvatarStorage) userAvatarObjectName(userID uuid.UUID) string {
+// avatarCacheControl lets CDN cache the avatars forever, every upload is stored under the new version.
+const avatarCacheControl = "public, max-age=31536000, immutable"
+
+// userAvatarPrefix is also the object name of the avatars uploaded before the versioning.
+func (AvatarStorage) userAvatarPrefix(userID uuid.UUID) string {
 	return fmt.Sprintf("avatars/user_%s", userID)
 }
 
-func (fs AvatarStorage) UploadUserAvatar(ctx context.Context, params dto.UploadUserAvatar) (path string, err error) {
-	objectName := fs.userAvatarObjectName(params.UserID)
+func (fs AvatarStorage) UploadUserAvatarVariant(ctx context.Context, params dto.AvatarVariantUpload) (path string, err error) {
+	extension := ".jpg"
+	if params.ContentType == "image/png" {
+		extension = ".png"
+	}
+
+	objectName := fmt.Sprintf("%s/%s/%d%s", fs.userAvatarPrefix(params.UserID), params.Version, params.Size, extension)
+	size := int64(len(params.Data))
+	cacheControl := avatarCacheControl
 
 	_, err = fs.client.PutObject(ctx, &s3.PutObjectInput{
 		Body:          bytes.NewReader(params.Data),
 		Bucket:        &fs.bucketName,
 		Key:           &objectName,
 		ACL:           types.ObjectCannedACLPublicRead,
-		ContentLength: &params.ImageSize,
+		ContentLength: &size,
 		ContentType:   &params.ContentType,
+		CacheControl:  &cacheControl,
 	})
 	if err != nil {
 		return "", fmt.Errorf("put object %s: %w", objectName, err)
@@ -35,7 +49,7 @@ func (fs AvatarStorage) UploadUserAvatar(ctx context.Context, params dto.UploadU
 }
 
 func (fs AvatarStorage) GetUserAvatarURL(ctx context.Context, userID uuid.UUID) (string, error) {
-	objectName := fs.userAvatarObjectName(userID)
+	objectName := fs.userAvatarPrefix(userID)
 
 	_, err := fs.client.GetObjectAttributes(ctx, &s3.GetObjectAttributesInput{
 		Bucket: &fs.bucketName,
@@ -53,15 +67,81 @@ func (fs AvatarStorage) GetUserAvatarURL(ctx context.Context, userID uuid.UUID)
 	return objectURL, nil
 }
 
+// DeleteUserAvatar removes all versions of the avatar.
 func (fs AvatarStorage) DeleteUserAvatar(ctx context.Context, userID uuid.UUID) error {
-	objectName := fs.userAvatarObjectName(userID)
+	prefix := fs.userAvatarPrefix(userID)
 
-	_, err := fs.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+	paginator := s3.NewListObjectsV2Paginator(fs.client, &s3.ListObjectsV2Input{
 		Bucket: &fs.bucketName,
-		Key:    &objectName,
+		Prefix: &prefix,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("list objects %s: %w", prefix, err)
+		}
+
+		paths := make([]string, len(page.Contents))
+		for i, object := range page.Contents {
+			paths[i] = aws.ToString(object.Key)
+		}
+
+		if err = fs.DeleteAvatarObjects(ctx, paths); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListUserAvatarObjects returns the paths of the objects of all versions of the avatar modified before the time.
+func (fs AvatarStorage) ListUserAvatarObjects(ctx context.Context, userID uuid.UUID, modifiedBefore time.Time) ([]string, error) {
+	prefix := fs.userAvatarPrefix(userID)
+
+	paginator := s3.NewListObjectsV2Paginator(fs.client, &s3.ListObjectsV2Input{
+		Bucket: &fs.bucketName,
+		Prefix: &prefix,
+	})
+
+	var paths []string
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list objects %s: %w", prefix, err)
+		}
+
+		for _, object := range page.Contents {
+			if aws.ToTime(object.LastModified).Before(modifiedBefore) {
+				paths = append(paths, aws.ToString(object.Key))
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// DeleteAvatarObjects removes the objects by their paths, at most 1000 at once.
+func (fs AvatarStorage) DeleteAvatarObjects(ctx context.Context, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	objects := make([]types.ObjectIdentifier, len(paths))
+	for i := range paths {
+		objects[i] = types.ObjectIdentifier{Key: &paths[i]}
+	}
+
+	out, err := fs.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		Bucket: &fs.bucketName,
+		Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
 	})
 	if err != nil {
-		return fmt.Errorf("remove object %s: %w", objectName, err)
+		return fmt.Errorf("remove objects: %w", err)
+	}
+
+	if len(out.Errors) > 0 {
+		return fmt.Errorf("remove object %s: %s", aws.ToString(out.Errors[0].Key), aws.ToString(out.Errors[0].Message))
 	}
 
 	return nil
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+// AccountDeletionService deletes accounts after the grace period. Other services delete the user data
+// when they receive users.<id>.deleted event, and report back, so the progress can be tracked.
+type AccountDeletionService struct {
+	logger      *logger.Logger
+	authService *AuthService
+
+	userRepository      UserRepository
+	deletionsRepository AccountDeletionsRepository
+	avatarStorage       AvatarStorage
+
+	gracePeriod time.Duration
+	// services must report the cleanup before the deletion is completed.
+	services []string
+}
+
+func NewAccountDeletionService(
+	log *logger.Logger,
+	cfg *config.Config,
+	authService *AuthService,
+	userRepository UserRepository,
+	deletionsRepository AccountDeletionsRepository,
+	avatarStorage AvatarStorage,
+) *AccountDeletionService {
+	return &AccountDeletionService{
+		logger:              log,
+		authService:         authService,
+		userRepository:      userRepository,
+		deletionsRepository: deletionsRepository,
+		avatarStorage:       avatarStorage,
+		gracePeriod:         cfg.AccountDeletion.GracePeriod,
+		services:            append([]string{models.AuthServiceName}, cfg.AccountDeletion.Services...),
+	}
+}
+
+// RequestAccountDeletion requires the password, so that stolen session could not delete the account.
+// The user can sign in and cancel the deletion until the grace period is over.
+func (s AccountDeletionService) RequestAccountDeletion(
+	ctx context.Context, userID uuid.UUID, password string,
+) (*models.AccountDeletion, error) {
+	user, err := s.authService.checkUserPassword(ctx, userID, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.scheduleDeletion(ctx, user)
+}
+
+func (s AccountDeletionService) CancelAccountDeletion(ctx context.Context, userID uuid.UUID) error {
+	if err := s.deletionsRepository.CancelAccountDeletion(ctx, userID); err != nil {
+		return errors.Errorf("cancel account deletion: %w", err)
+	}
+
+	s.logger.Info("account deletion cancelled", slog.String("user_id", userID.String()))
+
+	return nil
+}
+
+func (s AccountDeletionService) GetAccountDeletion(ctx context.Context, userID uuid.UUID) (*dto.AccountDeletionOutput, error) {
+	deletion, err := s.deletionsRepository.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get account deletion: %w", err)
+	}
+
+	var pending []string
+	for _, service := range s.services {
+		if _, ok := deletion.CompletedServices[service]; !ok {
+			pending = append(pending, service)
+		}
+	}
+
+	return &dto.AccountDeletionOutput{
+		Deletion:        deletion,
+		PendingServices: pending,
+	}, nil
+}
+
+// PurgeDueAccounts deletes all users whose grace period is over.
+func (s AccountDeletionService) PurgeDueAccounts(ctx context.Context) (purged int, err error) {
+	for {
+		found, err := s.deletionsRepository.PurgeNextDueAccount(ctx, time.Now())
+		if err != nil {
+			return purged, errors.Errorf("purge account: %w", err)
+		}
+		if !found {
+			return purged, nil
+		}
+
+		purged++
+	}
+}
+
+// CompleteServiceDeletion records that the service has deleted the user data.
+func (s AccountDeletionService) CompleteServiceDeletion(ctx context.Context, event models.UserDeletionCompletedEvent) error {
+	if !slices.Contains(s.services, event.Service) {
+		s.logger.Warn("deletion completed by unexpected service", slog.String("service", event.Service))
+	}
+
+	err := s.deletionsRepository.CompleteServiceDeletion(ctx, event.UserID, event.Service, event.CompletedAt)
+	if err != nil {
+		return errors.Errorf("complete service deletion: %w", err)
+	}
+
+	return nil
+}
+
+func (s AccountDeletionService) scheduleDeletion(ctx context.Context, user *models.User) (*models.AccountDeletion, error) {
+	purgeAfter := time.Now().Add(s.gracePeriod)
+
+	if err := s.deletionsRepository.CreateAccountDeletion(ctx, user.ID, purgeAfter); err != nil {
+		return nil, errors.Errorf("create account deletion: %w", err)
+	}
+
+	s.logger.Info("account deletion requested",
+		slog.String("user_id", user.ID.String()),
+		slog.Time("purge_after", purgeAfter),
+	)
+
+	if err := s.authService.mailClient.SendMail(ctx, user.Email, user.Locale, mail.AccountDeletionScheduled(purgeAfter)); err != nil {
+		s.logger.Error("send account deletion notice", logger.Error(err))
+	}
+
+	deletion, err := s.deletionsRepository.GetAccountDeletion(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Errorf("get account deletion: %w", err)
+	}
+
+	return deletion, nil
+}
+
+// PurgeDeletedUser removes the data of the deleted user stored outside of postgres, it is called by
+// the consumer of the users.<id>.deleted event like in other services. If it fails, the event is
+// redelivered, so every step must be idempotent.
+func (s AccountDeletionService) PurgeDeletedUser(ctx context.Context, userID uuid.UUID) error {
+	if err := s.avatarStorage.DeleteUserAvatar(ctx, userID); err != nil {
+		return errors.Errorf("delete user avatar: %w", err)
+	}
+
+	if err := s.authService.revokeAllSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	err := s.deletionsRepository.CompleteServiceDeletion(ctx, userID, models.AuthServiceName, time.Now())
+	if err != nil {
+		return errors.Errorf("complete service deletion: %w", err)
+	}
+
+	s.logger.Info("account deleted", slog.String("user_id", userID.String()))
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+const (
+	maxSuspensionReasonLength = 500
+
+	defaultUsersPageSize = 20
+	maxUsersPageSize     = 100
+)
+
+// AdminService manages users on behalf of administrators. Every method checks that the acting user
+// is still an administrator, as the is_admin claim of the access token can be outdated.
+type AdminService struct {
+	logger          *logger.Logger
+	authService     *AuthService
+	deletionService *AccountDeletionService
+
+	userRepository        UserRepository
+	suspensionsRepository SuspensionsRepository
+	sessionRepository     SessionRepository
+}
+
+func NewAdminService(
+	log *logger.Logger,
+	authService *AuthService,
+	deletionService *AccountDeletionService,
+	userRepository UserRepository,
+	suspensionsRepository SuspensionsRepository,
+	sessionRepository SessionRepository,
+) *AdminService {
+	return &AdminService{
+		logger:                log,
+		authService:           authService,
+		deletionService:       deletionService,
+		userRepository:        userRepository,
+		suspensionsRepository: suspensionsRepository,
+		sessionRepository:     sessionRepository,
+	}
+}
+
+func (a AdminService) SearchUsers(ctx context.Context, adminID uuid.UUID, params dto.GetUsersParams) (*dto.GetUsersOutput, error) {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	if params.Status != nil && !params.Status.Valid() {
+		return nil, apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "status"})
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = defaultUsersPageSize
+	}
+	params.Limit = min(params.Limit, maxUsersPageSize)
+	params.Offset = max(params.Offset, 0)
+
+	if params.OrderBy == "" {
+		params.OrderBy, params.OrderDirection = "created_at", "desc"
+	}
+
+	users, err := a.userRepository.GetUsers(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("get users: %w", err)
+	}
+
+	count, err := a.userRepository.GetUsersCount(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("count users: %w", err)
+	}
+
+	return &dto.GetUsersOutput{
+		Count: count,
+		Users: users,
+	}, nil
+}
+
+func (a AdminService) GetUser(ctx context.Context, adminID, userID uuid.UUID) (*dto.AdminUserOutput, error) {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	user, err := a.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	suspension, err := a.authService.getUserSuspension(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.UserStatusActive
+	if suspension != nil {
+		status = suspension.Status(time.Now())
+	}
+
+	sessions, err := a.sessionRepository.GetUserSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user sessions: %w", err)
+	}
+
+	return &dto.AdminUserOutput{
+		User:       user,
+		Status:     status,
+		Suspension: suspension,
+		Sessions:   sessions,
+	}, nil
+}
+
+// SuspendUser signs the user out everywhere and refuses new sessions until the suspension expires.
+func (a AdminService) SuspendUser(ctx context.Context, adminID uuid.UUID, params dto.SuspendUserInput) error {
+	if !params.Until.After(time.Now()) {
+		return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "until"})
+	}
+
+	return a.suspend(ctx, adminID, params.UserID, params.Reason, &params.Until)
+}
+
+// BanUser suspends the user permanently, until UnbanUser is called.
+func (a AdminService) BanUser(ctx context.Context, adminID, userID uuid.UUID, reason string) error {
+	return a.suspend(ctx, adminID, userID, reason, nil)
+}
+
+// UnbanUser lifts both temporary suspensions and permanent bans.
+func (a AdminService) UnbanUser(ctx context.Context, adminID, userID uuid.UUID) error {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	if err := a.suspensionsRepository.LiftUserSuspension(ctx, userID); err != nil {
+		return errors.Errorf("lift user suspension: %w", err)
+	}
+
+	a.logger.Info("user restored",
+		slog.String("user_id", userID.String()),
+		slog.String("admin_id", adminID.String()),
+	)
+
+	a.recordAdminAction(ctx, adminID, userID, models.SecurityEventUserUnbanned, nil)
+
+	return nil
+}
+
+// SetUserAdmin grants or revokes administrator rights. Access tokens of the user are revoked,
+// so that the is_admin claim is updated on the next refresh.
+func (a AdminService) SetUserAdmin(ctx context.Context, adminID, userID uuid.UUID, isAdmin bool) error {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	// prevents the last administrator from revoking own rights
+	if adminID == userID {
+		return apperrors.ErrAdminSelfAction
+	}
+
+	if err := a.userRepository.SetUserAdmin(ctx, userID, isAdmin); err != nil {
+		return errors.Errorf("set user admin: %w", err)
+	}
+
+	a.logger.Info("user admin rights changed",
+		slog.String("user_id", userID.String()),
+		slog.String("admin_id", adminID.String()),
+		slog.Bool("is_admin", isAdmin),
+	)
+
+	a.recordAdminAction(ctx, adminID, userID, models.SecurityEventAdminRightsChanged, map[string]string{
+		"is_admin": strconv.FormatBool(isAdmin),
+	})
+
+	a.authService.revokeUserTokens(ctx, userID)
+
+	return nil
+}
+
+// DeleteUser schedules the deletion of the account, the user can cancel it during the grace period.
+func (a AdminService) DeleteUser(ctx context.Context, adminID, userID uuid.UUID) (*models.AccountDeletion, error) {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	if adminID == userID {
+		return nil, apperrors.ErrAdminSelfAction
+	}
+
+	user, err := a.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	deletion, err := a.deletionService.scheduleDeletion(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	a.recordAdminAction(ctx, adminID, userID, models.SecurityEventUserDeletionScheduled, nil)
+
+	return deletion, nil
+}
+
+// SearchSecurityEvents searches the audit log of all users, most recent events first.
+func (a AdminService) SearchSecurityEvents(
+	ctx context.Context, adminID uuid.UUID, filter dto.SecurityEventsFilter,
+) ([]models.SecurityEvent, error) {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "to"})
+	}
+
+	return getSecurityEvents(ctx, a.authService.securityEventsRepository, filter)
+}
+
+// GetUserDeletion shows which services have not deleted the user data yet.
+func (a AdminService) GetUserDeletion(ctx context.Context, adminID, userID uuid.UUID) (*dto.AccountDeletionOutput, error) {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	return a.deletionService.GetAccountDeletion(ctx, userID)
+}
+
+func (a AdminService) suspend(ctx context.Context, adminID, userID uuid.UUID, reason string, expiresAt *time.Time) error {
+	if err := a.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	if adminID == userID {
+		return apperrors.ErrAdminSelfAction
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > maxSuspensionReasonLength {
+		return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "reason"})
+	}
+
+	if _, err := a.userRepository.GetUserByID(ctx, userID); err != nil {
+		return errors.Errorf("get user by id: %w", err)
+	}
+
+	if err := a.suspensionsRepository.SuspendUser(ctx, userID, adminID, reason, expiresAt); err != nil {
+		return errors.Errorf("suspend user: %w", err)
+	}
+
+	status := models.UserStatusSuspended
+	if expiresAt == nil {
+		status = models.UserStatusBanned
+	}
+
+	a.logger.Info("user "+string(status),
+		slog.String("user_id", userID.String()),
+		slog.String("admin_id", adminID.String()),
+		slog.String("reason", reason),
+	)
+
+	eventType, metadata := models.SecurityEventUserBanned, map[string]string{"reason": reason}
+	if expiresAt != nil {
+		eventType = models.SecurityEventUserSuspended
+		metadata["expires_at"] = expiresAt.UTC().Format(time.RFC3339)
+	}
+	a.recordAdminAction(ctx, adminID, userID, eventType, metadata)
+
+	return a.authService.revokeAllSessions(ctx, userID)
+}
+
+// recordAdminAction records the event made by the administrator in the history of the user.
+func (a AdminService) recordAdminAction(
+	ctx context.Context, adminID, userID uuid.UUID, eventType models.SecurityEventType, metadata map[string]string,
+) {
+	a.authService.recordSecurityEvent(ctx, models.ClientInfo{}, models.SecurityEvent{
+		UserID:   &userID,
+		ActorID:  &adminID,
+		Type:     eventType,
+		Outcome:  models.SecurityEventSuccess,
+		Metadata: metadata,
+	})
+}
+
+func (a AdminService) requireAdmin(ctx context.Context, adminID uuid.UUID) error {
+	admin, err := a.userRepository.GetUserByID(ctx, adminID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUserNotFound) {
+			return apperrors.ErrForbidden
+		}
+		return errors.Errorf("get admin by id: %w", err)
+	}
+
+	if !admin.IsAdmin {
+		return apperrors.ErrForbidden
+	}
+
+	return nil
+}
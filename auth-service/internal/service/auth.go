@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-errors/errors"
@@ -14,25 +17,57 @@ import (
 	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
 	"github.com/tech-inspire/backend/auth-service/pkg/generator"
 	"github.com/tech-inspire/backend/auth-service/pkg/logger"
-	"go.uber.org/zap"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type AuthService struct {
 	logger *logger.Logger
 
 	generator                    Generator
+	passwordHasher               PasswordHasher
 	userRepository               UserRepository
 	confirmationCodesRepository  ConfirmationCodesRepository
 	resetPasswordCodesRepository ResetPasswordCodesRepository
+	emailChangeCodesRepository   EmailChangeCodesRepository
+	attemptsRepository           AttemptsRepository
+	linksRepository              LinksRepository
+	linkSigner                   LinkSigner
 
 	sessionRepository SessionRepository
+	tokenRevoker      TokenRevoker
 	mailClient        MailClient
 
-	refreshTokenDuration time.Duration
-	sessionsLimitPerUser int
+	mfaRepository           MFARepository
+	mfaChallengesRepository MFAChallengesRepository
+	suspensionsRepository   SuspensionsRepository
+
+	securityEventsRepository SecurityEventsRepository
+	devicesRepository        DevicesRepository
+	personalTokensRepository PersonalTokensRepository
+
+	refreshTokenDuration          time.Duration
+	sessionsLimitPerUser          int
+	revokeSessionsOnPasswordReset bool
+	sessionEvictionPolicy         models.SessionEvictionPolicy
+
+	mfa        mfaConfig
+	bruteForce bruteForceConfig
+	links      linksConfig
+	usernames  usernamesConfig
+	// revokeLinkTTL is the lifetime of the link from the new device notification.
+	revokeLinkTTL time.Duration
+}
+
+type usernamesConfig struct {
+	// reserved are the keys of reservedUsernameKey.
+	reserved map[string]struct{}
+	cooldown time.Duration
+}
 
-	testMode bool
+type mfaConfig struct {
+	totpIssuer           string
+	challengeDuration    time.Duration
+	maxChallengeAttempts int64
+	recoveryCodesCount   int
 }
 
 func NewAuthService(
@@ -40,33 +75,93 @@ func NewAuthService(
 	cfg *config.Config,
 
 	generator Generator,
+	passwordHasher PasswordHasher,
 
 	userRepository UserRepository,
 	sessionRepository SessionRepository,
 	codesRepository ConfirmationCodesRepository,
 	resetPasswordCodesRepository ResetPasswordCodesRepository,
 	mailClient MailClient,
+	mfaRepository MFARepository,
+	mfaChallengesRepository MFAChallengesRepository,
+	tokenRevoker TokenRevoker,
+	attemptsRepository AttemptsRepository,
+	emailChangeCodesRepository EmailChangeCodesRepository,
+	suspensionsRepository SuspensionsRepository,
+	linksRepository LinksRepository,
+	linkSigner LinkSigner,
+	securityEventsRepository SecurityEventsRepository,
+	devicesRepository DevicesRepository,
+	personalTokensRepository PersonalTokensRepository,
 ) *AuthService {
 	authService := &AuthService{
 		logger: log,
 
 		generator:                    generator,
+		passwordHasher:               passwordHasher,
 		userRepository:               userRepository,
 		confirmationCodesRepository:  codesRepository,
 		resetPasswordCodesRepository: resetPasswordCodesRepository,
+		emailChangeCodesRepository:   emailChangeCodesRepository,
+		attemptsRepository:           attemptsRepository,
+		linksRepository:              linksRepository,
+		linkSigner:                   linkSigner,
 		mailClient:                   mailClient,
 
 		sessionRepository: sessionRepository,
-
-		refreshTokenDuration: cfg.JWT.RefreshTokenDuration,
-		sessionsLimitPerUser: cfg.Session.MaxAllowedSessionsPerUser,
-
-		testMode: cfg.TestMode,
+		tokenRevoker:      tokenRevoker,
+
+		mfaRepository:           mfaRepository,
+		mfaChallengesRepository: mfaChallengesRepository,
+		suspensionsRepository:   suspensionsRepository,
+
+		securityEventsRepository: securityEventsRepository,
+		devicesRepository:        devicesRepository,
+		personalTokensRepository: personalTokensRepository,
+
+		refreshTokenDuration:          cfg.JWT.RefreshTokenDuration,
+		sessionsLimitPerUser:          cfg.Session.MaxAllowedSessionsPerUser,
+		revokeSessionsOnPasswordReset: cfg.Session.RevokeOnPasswordReset,
+		sessionEvictionPolicy:         cfg.Session.EvictionPolicy,
+
+		mfa: mfaConfig{
+			totpIssuer:           cfg.MFA.TOTPIssuer,
+			challengeDuration:    cfg.MFA.ChallengeDuration,
+			maxChallengeAttempts: cfg.MFA.MaxChallengeAttempts,
+			recoveryCodesCount:   cfg.MFA.RecoveryCodesCount,
+		},
+
+		bruteForce: bruteForceConfig{
+			window:             cfg.BruteForce.Window,
+			freeAttempts:       cfg.BruteForce.FreeAttempts,
+			baseDelay:          cfg.BruteForce.BaseDelay,
+			maxDelay:           cfg.BruteForce.MaxDelay,
+			lockoutDuration:    cfg.BruteForce.LockoutDuration,
+			maxAccountAttempts: cfg.BruteForce.MaxAccountAttempts,
+			maxIPAttempts:      cfg.BruteForce.MaxIPAttempts,
+			maxCodeAttempts:    cfg.BruteForce.MaxCodeAttempts,
+		},
+
+		links: linksConfig{
+			ttl:            cfg.Links.TTL,
+			applicationURL: strings.TrimSuffix(cfg.ApplicationURL, "/"),
+		},
+		usernames: usernamesConfig{
+			reserved: make(map[string]struct{}, len(cfg.Usernames.Reserved)),
+			cooldown: cfg.Usernames.Cooldown,
+		},
+		revokeLinkTTL: cfg.Devices.RevokeLinkTTL,
+	}
+
+	for _, username := range cfg.Usernames.Reserved {
+		authService.usernames.reserved[reservedUsernameKey(username)] = struct{}{}
 	}
 
 	log.Info("starting with auth configuration",
-		zap.Duration("refresh_token_duration", authService.refreshTokenDuration),
-		zap.Int("sessions_limit_per_user", authService.sessionsLimitPerUser),
+		slog.Duration("refresh_token_duration", authService.refreshTokenDuration),
+		slog.Int("sessions_limit_per_user", authService.sessionsLimitPerUser),
+		slog.String("session_eviction_policy", string(authService.sessionEvictionPolicy)),
+		slog.Duration("mfa_challenge_duration", authService.mfa.challengeDuration),
 	)
 
 	return authService
@@ -85,17 +180,26 @@ func (a AuthService) GetSession(ctx context.Context, userID, sessionID uuid.UUID
 	return session, nil
 }
 
-func (a AuthService) DeleteSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+func (a AuthService) DeleteSession(ctx context.Context, userID, sessionID uuid.UUID, client models.ClientInfo) error {
 	err := a.sessionRepository.DeleteUserSession(ctx, userID, sessionID)
 	if err != nil {
 		return errors.Errorf("delete user session: %w", err)
 	}
 
+	a.revokeSessionTokens(ctx, sessionID)
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:    &userID,
+		Type:      models.SecurityEventLogout,
+		Outcome:   models.SecurityEventSuccess,
+		SessionID: &sessionID,
+	})
+
 	return nil
 }
 
-func (a AuthService) Register(ctx context.Context, params dto.RegisterParams) (*dto.RegisterOutput, error) {
-	if err := a.checkUsername(ctx, params.Username); err != nil {
+func (a AuthService) Register(ctx context.Context, params dto.RegisterParams, client models.ClientInfo) (*dto.RegisterOutput, error) {
+	if err := a.checkUsername(ctx, uuid.Nil, params.Username); err != nil {
 		return nil, err
 	}
 	if err := a.checkMail(ctx, params.Email); err != nil {
@@ -112,31 +216,39 @@ func (a AuthService) Register(ctx context.Context, params dto.RegisterParams) (*
 		return nil, errors.Errorf("%w: code was request too many times, try again later", apperrors.ErrForbidden)
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(params.Password), bcrypt.DefaultCost)
+	hash, err := a.passwordHasher.Hash(params.Password)
 	if err != nil {
 		return nil, errors.Errorf("hash password: %w", err)
 	}
 
-	code := "111111"
-
-	if !a.testMode {
-		const codeLength = 6
-		code = generator.NumberCode(codeLength)
-
-		err = a.mailClient.SendMail(params.Email, mail.ConfirmEmail(code))
-		if err != nil {
-			return nil, errors.Errorf("send confirmation email: %w", err)
-		}
-	}
-
-	err = a.confirmationCodesRepository.StoreCode(ctx, models.ConfirmationUserData{
-		ConfirmationCode: code,
+	const codeLength = 6
+	data := models.ConfirmationUserData{
+		ConfirmationCode: generator.NumberCode(codeLength),
 		Email:            params.Email,
 		Username:         params.Username,
 		Name:             params.Name,
 		PasswordHash:     string(hash),
+		Locale:           client.Locale,
 		ExpiresAt:        time.Now().Add(time.Second * 60 * 5),
-	})
+		LinkID:           a.generator.GenerateString(linkIDLength),
+	}
+
+	link, err := a.issueLink(ctx, models.Link{
+		ID:           data.LinkID,
+		Purpose:      models.LinkPurposeConfirmEmail,
+		Email:        params.Email,
+		Registration: &data,
+	}, client)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.mailClient.SendMail(ctx, params.Email, client.Locale, mail.ConfirmEmail(data.ConfirmationCode, link))
+	if err != nil {
+		return nil, errors.Errorf("send confirmation email: %w", err)
+	}
+
+	err = a.confirmationCodesRepository.StoreCode(ctx, data)
 	if err != nil {
 		return nil, errors.Errorf("store email confirmation code: %w", err)
 	}
@@ -147,15 +259,37 @@ func (a AuthService) Register(ctx context.Context, params dto.RegisterParams) (*
 	}, nil
 }
 
-func (a AuthService) checkUsername(ctx context.Context, username string) error {
-	_, err := a.userRepository.GetUserByUsername(ctx, username)
-	if err == nil {
-		return apperrors.ErrUsernameUsed
+// checkUsername returns nil if the username can be taken by the user, uuid.Nil is passed for the new users.
+// The usernames are compared ignoring the case, the previous usernames of other users are taken until released.
+func (a AuthService) checkUsername(ctx context.Context, userID uuid.UUID, username string) error {
+	if err := validateUsername(username); err != nil {
+		return err
 	}
-	if !errors.Is(err, apperrors.ErrUserNotFound) {
+
+	if _, ok := a.usernames.reserved[reservedUsernameKey(username)]; ok {
+		return apperrors.ErrUsernameReserved
+	}
+
+	user, err := a.userRepository.GetUserByUsername(ctx, username)
+	switch {
+	case err == nil:
+		if user.ID != userID {
+			return apperrors.ErrUsernameUsed
+		}
+	case !errors.Is(err, apperrors.ErrUserNotFound):
 		return errors.Errorf("get user by username: %w", err)
 	}
 
+	previous, err := a.userRepository.GetPreviousUsername(ctx, username, time.Now())
+	switch {
+	case err == nil:
+		if previous.UserID != userID {
+			return apperrors.ErrUsernameUsed
+		}
+	case !errors.Is(err, apperrors.ErrUserNotFound):
+		return errors.Errorf("get previous username: %w", err)
+	}
+
 	return nil
 }
 
@@ -171,10 +305,12 @@ func (a AuthService) checkMail(ctx context.Context, email string) error {
 	return nil
 }
 
-func (a AuthService) registerUser(ctx context.Context, data models.ConfirmationUserData) (*dto.LoginOutput, error) {
+func (a AuthService) registerUser(
+	ctx context.Context, data models.ConfirmationUserData, client models.ClientInfo,
+) (*dto.LoginOutput, error) {
 	userID := uuid.Must(uuid.NewV7())
 
-	if err := a.checkUsername(ctx, data.Username); err != nil {
+	if err := a.checkUsername(ctx, uuid.Nil, data.Username); err != nil {
 		return nil, err
 	}
 	if err := a.checkMail(ctx, data.Email); err != nil {
@@ -188,38 +324,41 @@ func (a AuthService) registerUser(ctx context.Context, data models.ConfirmationU
 		Username:     data.Username,
 		PasswordHash: []byte(data.PasswordHash),
 		Description:  "",
+		Locale:       data.Locale,
 	})
 	if err != nil {
 		return nil, errors.Errorf("create user: %w", err)
 	}
 
-	sessionID := uuid.Must(uuid.NewV7())
-	session, err := a.createSession(ctx, userID, sessionID)
-	if err != nil {
-		return nil, errors.Errorf("create session: %w", err)
-	}
-
 	user, err := a.userRepository.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, errors.Errorf("get user by id: %w", err)
 	}
 
-	return &dto.LoginOutput{
-		User:    user,
-		Session: session,
-	}, nil
+	return a.startSession(ctx, user, client, loginMethodRegistration)
 }
 
-func (a AuthService) ConfirmRegistrationByCode(ctx context.Context, email, code string) (*dto.LoginOutput, error) {
-	data, err := a.confirmationCodesRepository.CheckCode(ctx, email, code)
+func (a AuthService) ConfirmRegistrationByCode(
+	ctx context.Context, email, code string, client models.ClientInfo,
+) (*dto.LoginOutput, error) {
+	var data *models.ConfirmationUserData
+
+	err := a.checkCode(ctx, email, client, func() (err error) {
+		data, err = a.confirmationCodesRepository.CheckCode(ctx, email, code)
+		return err
+	}, apperrors.ErrConfirmationCodeNotFound, a.confirmationCodesRepository.ClearAllCodes)
 	if err != nil {
 		return nil, errors.Errorf("check code: %w", err)
 	}
 
-	return a.registerUser(ctx, *data)
+	if err = a.revokeLink(ctx, data.LinkID); err != nil {
+		return nil, err
+	}
+
+	return a.registerUser(ctx, *data, client)
 }
 
-func (a AuthService) SendResetPasswordCode(ctx context.Context, email string) error {
+func (a AuthService) SendResetPasswordCode(ctx context.Context, email string, client models.ClientInfo) error {
 	user, err := a.userRepository.GetUserByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrUserNotFound) {
@@ -241,9 +380,21 @@ func (a AuthService) SendResetPasswordCode(ctx context.Context, email string) er
 	}
 
 	const codeLength = 6
-	code := "222222" // generator.NumberCode(codeLength)
+	code := generator.NumberCode(codeLength)
+
+	linkID := a.generator.GenerateString(linkIDLength)
+
+	link, err := a.issueLink(ctx, models.Link{
+		ID:      linkID,
+		Purpose: models.LinkPurposeResetPassword,
+		Email:   user.Email,
+		UserID:  user.ID,
+	}, client)
+	if err != nil {
+		return err
+	}
 
-	err = a.mailClient.SendMail(email, mail.ResetPassword(code))
+	err = a.mailClient.SendMail(ctx, email, user.Locale, mail.ResetPassword(code, link))
 	if err != nil {
 		return errors.Errorf("send confirmation email: %w", err)
 	}
@@ -253,6 +404,7 @@ func (a AuthService) SendResetPasswordCode(ctx context.Context, email string) er
 		Code:      code,
 		Email:     user.Email,
 		ExpiresAt: time.Now().Add(time.Second * 60 * 5),
+		LinkID:    linkID,
 	})
 	if err != nil {
 		return errors.Errorf("store email confirmation code: %w", err)
@@ -261,16 +413,26 @@ func (a AuthService) SendResetPasswordCode(ctx context.Context, email string) er
 	return nil
 }
 
-func (a AuthService) CheckResetPasswordCode(ctx context.Context, email, code string) error {
-	_, err := a.resetPasswordCodesRepository.CheckCode(ctx, email, code)
+func (a AuthService) CheckResetPasswordCode(ctx context.Context, email, code string, client models.ClientInfo) error {
+	err := a.checkCode(ctx, email, client, func() error {
+		_, err := a.resetPasswordCodesRepository.CheckCode(ctx, email, code)
+		return err
+	}, apperrors.ErrResetPasswordCodeNotFound, a.resetPasswordCodesRepository.ClearAllCodes)
 	if err != nil {
 		return errors.Errorf("check code: %w", err)
 	}
 	return nil
 }
 
-func (a AuthService) ConfirmResetPasswordByCode(ctx context.Context, email, code, password string) error {
-	data, err := a.resetPasswordCodesRepository.CheckCode(ctx, email, code)
+func (a AuthService) ConfirmResetPasswordByCode(
+	ctx context.Context, email, code, password string, client models.ClientInfo,
+) error {
+	var data *models.ResetPasswordData
+
+	err := a.checkCode(ctx, email, client, func() (err error) {
+		data, err = a.resetPasswordCodesRepository.CheckCode(ctx, email, code)
+		return err
+	}, apperrors.ErrResetPasswordCodeNotFound, a.resetPasswordCodesRepository.ClearAllCodes)
 	if err != nil {
 		return errors.Errorf("check code: %w", err)
 	}
@@ -280,58 +442,163 @@ func (a AuthService) ConfirmResetPasswordByCode(ctx context.Context, email, code
 		return errors.Errorf("delete code: %w", err)
 	}
 
-	_, err = a.userRepository.GetUserByID(ctx, data.UserID)
+	if err = a.revokeLink(ctx, data.LinkID); err != nil {
+		return err
+	}
+
+	return a.resetPassword(ctx, data.UserID, password, client)
+}
+
+func (a AuthService) resetPassword(ctx context.Context, userID uuid.UUID, password string, client models.ClientInfo) error {
+	_, err := a.userRepository.GetUserByID(ctx, userID)
 	if err != nil {
 		return fmt.Errorf("get user by id: %w", err)
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hash, err := a.passwordHasher.Hash(password)
 	if err != nil {
 		return errors.Errorf("hash password: %w", err)
 	}
 
-	return a.userRepository.UpdateUserByID(ctx, data.UserID, dto.UpdateUsersParams{
+	err = a.userRepository.UpdateUserByID(ctx, userID, dto.UpdateUsersParams{
 		Password: &hash,
 	})
-}
-
-func (a AuthService) login(ctx context.Context, user *models.User, passwordHash []byte, password string) (*dto.LoginOutput, error) {
-	err := bcrypt.CompareHashAndPassword(passwordHash, []byte(password))
 	if err != nil {
-		return nil, apperrors.ErrForbidden
+		return errors.Errorf("update user password: %w", err)
+	}
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:  &userID,
+		Type:    models.SecurityEventPasswordReset,
+		Outcome: models.SecurityEventSuccess,
+	})
+
+	if a.revokeSessionsOnPasswordReset {
+		// whoever knew the old password may still be signed in
+		if err = a.revokeAllSessions(ctx, userID); err != nil {
+			return err
+		}
 	}
 
-	sessionID := uuid.Must(uuid.NewV7())
-	session, err := a.createSession(ctx, user.ID, sessionID)
+	// unlike the sessions, the personal tokens are revoked regardless of the settings:
+	// whoever knew the old password could have created them, and they live up to a year
+	revoked, err := a.personalTokensRepository.DeleteUserPersonalTokens(ctx, userID)
 	if err != nil {
-		return nil, errors.Errorf("create session: %w", err)
+		return errors.Errorf("delete personal tokens: %w", err)
 	}
 
-	return &dto.LoginOutput{
-		User:    user,
-		Session: session,
-	}, nil
+	if revoked > 0 {
+		a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+			UserID:  &userID,
+			Type:    models.SecurityEventPersonalTokenRevoked,
+			Outcome: models.SecurityEventSuccess,
+			Metadata: map[string]string{
+				"reason": "password_reset",
+				"count":  strconv.Itoa(revoked),
+			},
+		})
+	}
+
+	return nil
 }
 
-func (a AuthService) LoginByEmail(ctx context.Context, email, password string) (*dto.LoginOutput, error) {
-	user, passwordHash, err := a.userRepository.GetUserByEmailWithHash(ctx, email)
+func (a AuthService) login(
+	ctx context.Context, password string, client models.ClientInfo,
+	getUser func() (user *models.User, passwordHash []byte, err error),
+) (*dto.LoginOutput, error) {
+	var at attempt
+
+	if err := a.startAttempt(ctx, &at, a.ipAttempts("login", client)...); err != nil {
+		return nil, err
+	}
+
+	user, passwordHash, err := getUser()
 	if err != nil {
-		return nil, errors.Errorf("get user by email: %w", err)
+		if errors.Is(err, apperrors.ErrUserNotFound) {
+			a.recordLoginFailure(ctx, nil, client, "user_not_found")
+
+			if _, registerErr := a.failAttempt(ctx, &at); registerErr != nil {
+				return nil, registerErr
+			}
+		}
+		return nil, err
 	}
 
-	return a.login(ctx, user, passwordHash, password)
-}
+	accountTarget := a.accountAttempts(user.ID)
 
-func (a AuthService) LoginByUsername(ctx context.Context, username, password string) (*dto.LoginOutput, error) {
-	user, passwordHash, err := a.userRepository.GetUserByUsernameWithHash(ctx, username)
+	if err = a.startAttempt(ctx, &at, accountTarget); err != nil {
+		return nil, err
+	}
+
+	ok, err := a.verifyPassword(ctx, user.ID, password, passwordHash)
 	if err != nil {
-		return nil, errors.Errorf("get user by token: %w", err)
+		return nil, err
+	}
+
+	if !ok {
+		a.recordLoginFailure(ctx, &user.ID, client, "invalid_password")
+
+		if _, err = a.failAttempt(ctx, &at); err != nil {
+			return nil, err
+		}
+		return nil, apperrors.ErrForbidden
+	}
+
+	if err = a.completeAttempt(ctx, &at); err != nil {
+		return nil, err
+	}
+
+	if err = a.checkUserStatus(ctx, user.ID); err != nil {
+		if errors.Is(err, apperrors.ErrUserSuspended) || errors.Is(err, apperrors.ErrUserBanned) {
+			a.recordLoginFailure(ctx, &user.ID, client, "user_suspended")
+		}
+		return nil, err
 	}
 
-	return a.login(ctx, user, passwordHash, password)
+	output, err := a.startSession(ctx, user, client, loginMethodPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	// with MFA enabled the counter is reset only after the second factor is verified
+	if output.MFAChallenge == nil {
+		if err = a.resetAttempts(ctx, accountTarget); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func (a AuthService) LoginByEmail(
+	ctx context.Context, email, password string, client models.ClientInfo,
+) (*dto.LoginOutput, error) {
+	return a.login(ctx, password, client, func() (*models.User, []byte, error) {
+		user, passwordHash, err := a.userRepository.GetUserByEmailWithHash(ctx, email)
+		if err != nil {
+			return nil, nil, errors.Errorf("get user by email: %w", err)
+		}
+		return user, passwordHash, nil
+	})
+}
+
+func (a AuthService) LoginByUsername(
+	ctx context.Context, username, password string, client models.ClientInfo,
+) (*dto.LoginOutput, error) {
+	return a.login(ctx, password, client, func() (*models.User, []byte, error) {
+		user, passwordHash, err := a.userRepository.GetUserByUsernameWithHash(ctx, username)
+		if err != nil {
+			return nil, nil, errors.Errorf("get user by token: %w", err)
+		}
+		return user, passwordHash, nil
+	})
 }
 
-func (a AuthService) RefreshSession(ctx context.Context, userID, sessionID uuid.UUID, sessionToken string) (*models.User, error) {
+// RefreshSession rotates the session token: every refresh token can be used only once.
+// Presenting an already rotated token revokes the session, as the token was most likely stolen.
+func (a AuthService) RefreshSession(
+	ctx context.Context, userID, sessionID uuid.UUID, sessionToken string, client models.ClientInfo,
+) (*dto.LoginOutput, error) {
 	session, err := a.sessionRepository.GetUserSession(ctx, userID, sessionID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrSessionNotFound) {
@@ -349,9 +616,28 @@ func (a AuthService) RefreshSession(ctx context.Context, userID, sessionID uuid.
 		return nil, apperrors.ErrSessionExpired
 	}
 
-	if session.Token != sessionToken {
-		return nil, apperrors.ErrForbidden
+	if err = a.checkUserStatus(ctx, user.ID); err != nil {
+		return nil, err
 	}
 
-	return user, nil
+	rotatedSession, err := a.sessionRepository.RotateUserSessionToken(ctx,
+		userID, sessionID, sessionToken, a.generator.GenerateString(sessionTokenLength), time.Now(),
+	)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrRefreshTokenReused) {
+			if revokeErr := a.revokeReusedSession(ctx, userID, sessionID, client); revokeErr != nil {
+				return nil, revokeErr
+			}
+			return nil, err
+		}
+		if errors.Is(err, apperrors.ErrSessionNotFound) {
+			return nil, apperrors.ErrSessionExpired
+		}
+		return nil, errors.Errorf("rotate session token: %w", err)
+	}
+
+	return &dto.LoginOutput{
+		User:    user,
+		Session: rotatedSession,
+	}, nil
 }
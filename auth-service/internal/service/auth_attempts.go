@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+type bruteForceConfig struct {
+	window          time.Duration
+	freeAttempts    int64
+	baseDelay       time.Duration
+	maxDelay        time.Duration
+	lockoutDuration time.Duration
+
+	maxAccountAttempts int64
+	maxIPAttempts      int64
+	maxCodeAttempts    int64
+}
+
+// delay grows exponentially with every failed attempt after the free ones.
+func (c bruteForceConfig) delay(failures int64) time.Duration {
+	if failures <= c.freeAttempts {
+		return 0
+	}
+
+	const maxShift = 30 // prevents overflow, max delay is reached much earlier
+
+	delay := c.baseDelay << min(failures-c.freeAttempts-1, maxShift)
+	return min(delay, c.maxDelay)
+}
+
+type attemptsTarget struct {
+	key         string
+	maxAttempts int64
+}
+
+func (a AuthService) accountAttempts(userID uuid.UUID) attemptsTarget {
+	return attemptsTarget{key: "login:user:" + userID.String(), maxAttempts: a.bruteForce.maxAccountAttempts}
+}
+
+func (a AuthService) codeAttempts(email string) attemptsTarget {
+	return attemptsTarget{key: "code:email:" + strings.ToLower(email), maxAttempts: a.bruteForce.maxCodeAttempts}
+}
+
+// ipAttempts returns nothing if the client ip is unknown.
+func (a AuthService) ipAttempts(scope string, client models.ClientInfo) []attemptsTarget {
+	if client.IP == "" {
+		return nil
+	}
+
+	return []attemptsTarget{{key: scope + ":ip:" + client.IP, maxAttempts: a.bruteForce.maxIPAttempts}}
+}
+
+// attempt is recorded in the windows of its targets before the credentials are verified, so that
+// the concurrent requests can not make more guesses than the limit: each of them gets its own count.
+type attempt struct {
+	entries []attemptEntry
+}
+
+type attemptEntry struct {
+	target attemptsTarget
+	id     string
+	count  int64
+}
+
+// checkAttempts returns apperrors.ErrTooManyAttempts if any of the targets is locked.
+func (a AuthService) checkAttempts(ctx context.Context, targets ...attemptsTarget) error {
+	keys := make([]string, len(targets))
+	for i, target := range targets {
+		keys[i] = target.key
+	}
+
+	lockout, err := a.attemptsRepository.GetLockout(ctx, keys...)
+	if err != nil {
+		return errors.Errorf("get lockout: %w", err)
+	}
+
+	if lockout > 0 {
+		return tooManyAttempts(lockout)
+	}
+
+	return nil
+}
+
+// startAttempt adds the targets to the attempt. It returns apperrors.ErrTooManyAttempts if any of
+// the targets is locked or the attempt is over the limit, e.g. when it was sent concurrently with others.
+// The attempt stays counted unless it is completed.
+func (a AuthService) startAttempt(ctx context.Context, at *attempt, targets ...attemptsTarget) error {
+	if err := a.checkAttempts(ctx, targets...); err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		id, count, err := a.attemptsRepository.RegisterAttempt(ctx, target.key, a.bruteForce.window)
+		if err != nil {
+			return errors.Errorf("register attempt: %w", err)
+		}
+
+		at.entries = append(at.entries, attemptEntry{target: target, id: id, count: count})
+
+		if count > target.maxAttempts {
+			if err = a.attemptsRepository.Lock(ctx, target.key, a.bruteForce.lockoutDuration); err != nil {
+				return errors.Errorf("lock attempts: %w", err)
+			}
+			return tooManyAttempts(a.bruteForce.lockoutDuration)
+		}
+	}
+
+	return nil
+}
+
+// failAttempt delays the next attempt and locks targets that reached the limit.
+func (a AuthService) failAttempt(ctx context.Context, at *attempt) (limitReached bool, err error) {
+	for _, entry := range at.entries {
+		lockout := a.bruteForce.delay(entry.count)
+		if entry.count >= entry.target.maxAttempts {
+			lockout = a.bruteForce.lockoutDuration
+			limitReached = true
+		}
+
+		if lockout == 0 {
+			continue
+		}
+
+		if err = a.attemptsRepository.Lock(ctx, entry.target.key, lockout); err != nil {
+			return false, errors.Errorf("lock attempts: %w", err)
+		}
+	}
+
+	return limitReached, nil
+}
+
+// completeAttempt removes the successful attempt from the windows of its targets.
+func (a AuthService) completeAttempt(ctx context.Context, at *attempt) error {
+	for _, entry := range at.entries {
+		if err := a.attemptsRepository.ForgetAttempt(ctx, entry.target.key, entry.id); err != nil {
+			return errors.Errorf("forget attempt: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (a AuthService) resetAttempts(ctx context.Context, targets ...attemptsTarget) error {
+	keys := make([]string, len(targets))
+	for i, target := range targets {
+		keys[i] = target.key
+	}
+
+	if err := a.attemptsRepository.ResetAttempts(ctx, keys...); err != nil {
+		return errors.Errorf("reset attempts: %w", err)
+	}
+
+	return nil
+}
+
+// checkCode guards verification of the codes sent by email against enumeration.
+// When too many wrong codes were entered, all codes sent to the email are invalidated.
+func (a AuthService) checkCode(
+	ctx context.Context, email string, client models.ClientInfo,
+	check func() error, notFoundErr error, clearCodes func(ctx context.Context, email string) error,
+) error {
+	var (
+		emailTarget = a.codeAttempts(email)
+		targets     = append([]attemptsTarget{emailTarget}, a.ipAttempts("code", client)...)
+		at          attempt
+	)
+
+	if err := a.startAttempt(ctx, &at, targets...); err != nil {
+		return err
+	}
+
+	err := check()
+	if err == nil {
+		if err = a.completeAttempt(ctx, &at); err != nil {
+			return err
+		}
+		return a.resetAttempts(ctx, emailTarget)
+	}
+
+	if !errors.Is(err, notFoundErr) {
+		return err
+	}
+
+	limitReached, registerErr := a.failAttempt(ctx, &at)
+	if registerErr != nil {
+		return registerErr
+	}
+
+	if limitReached {
+		if clearErr := clearCodes(ctx, email); clearErr != nil {
+			return errors.Errorf("clear codes: %w", clearErr)
+		}
+	}
+
+	return err
+}
+
+func tooManyAttempts(retryAfter time.Duration) error {
+	return apperrors.ErrTooManyAttempts.WithMetadata(map[string]string{
+		"retry_after": strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))),
+	})
+}
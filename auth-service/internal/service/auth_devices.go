@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+// rememberDevice emails the user if the session is created from the device or location not used before.
+// The devices are remembered even if the user has opted out, so that nothing is sent after opting in again.
+// The session is already created, so failures are only logged.
+func (a AuthService) rememberDevice(ctx context.Context, user *models.User, session *models.Session, client models.ClientInfo) {
+	if err := a.notifyNewDevice(ctx, user, session, client); err != nil {
+		a.logger.Error("notify new device",
+			slog.String("user_id", user.ID.String()),
+			slog.String("session_id", session.ID.String()),
+			logger.Error(err),
+		)
+	}
+}
+
+func (a AuthService) notifyNewDevice(ctx context.Context, user *models.User, session *models.Session, client models.ClientInfo) error {
+	if client.DeviceID == "" {
+		return nil
+	}
+
+	// the first device of the user is the one used for registration, or the first one since devices are remembered
+	hasDevices, err := a.devicesRepository.HasUserDevices(ctx, user.ID)
+	if err != nil {
+		return errors.Errorf("check user devices: %w", err)
+	}
+
+	isNew, err := a.devicesRepository.RememberUserDevice(ctx, user.ID, hashDeviceID(client.DeviceID), client.Location)
+	if err != nil {
+		return errors.Errorf("remember user device: %w", err)
+	}
+
+	if !isNew || !hasDevices || !user.NotifyNewDevices {
+		return nil
+	}
+
+	link, err := a.issueLink(ctx, models.Link{
+		Purpose:   models.LinkPurposeRevokeSession,
+		Email:     user.Email,
+		UserID:    user.ID,
+		SessionID: &session.ID,
+		ExpiresAt: time.Now().Add(a.revokeLinkTTL),
+	}, client)
+	if err != nil {
+		return err
+	}
+
+	template := mail.NewDevice(client.DeviceName, client.Location, session.CreatedAt, link)
+	if err = a.mailClient.SendMail(ctx, user.Email, user.Locale, template); err != nil {
+		return errors.Errorf("send new device mail: %w", err)
+	}
+
+	a.logger.Info("new device notification sent",
+		slog.String("user_id", user.ID.String()),
+		slog.String("session_id", session.ID.String()),
+		slog.String("device_name", client.DeviceName),
+	)
+
+	return nil
+}
+
+// RevokeSessionByLink signs out the session from the new device notification. The link is not bound
+// to the browser, as the mail is usually opened on another device than the notified one.
+func (a AuthService) RevokeSessionByLink(ctx context.Context, token string, client models.ClientInfo) error {
+	link, err := a.consumeLink(ctx, token, models.LinkPurposeRevokeSession, client)
+	if err != nil {
+		return err
+	}
+
+	if link.SessionID == nil {
+		return errors.Errorf("link %s has no session", link.ID)
+	}
+
+	err = a.RevokeSession(ctx, link.UserID, *link.SessionID, client)
+	if errors.Is(err, apperrors.ErrSessionNotFound) {
+		// the session has already ended
+		return nil
+	}
+
+	return err
+}
+
+func hashDeviceID(deviceID string) []byte {
+	hash := sha256.Sum256([]byte(deviceID))
+	return hash[:]
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/generator"
+)
+
+const emailChangeCodeDuration = 5 * time.Minute
+
+// RequestEmailChange sends confirmation code to the new email. The email is not changed
+// until the code is confirmed, the current email is notified about the request.
+func (a AuthService) RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail, password string) error {
+	user, err := a.checkUserPassword(ctx, userID, password)
+	if err != nil {
+		return err
+	}
+
+	if err = a.checkMail(ctx, newEmail); err != nil {
+		return err
+	}
+
+	activeCodesCount, err := a.emailChangeCodesRepository.GetActiveCodesCount(ctx, newEmail)
+	if err != nil {
+		return errors.Errorf("get active codes count: %w", err)
+	}
+
+	const maxActiveCodesCount = 5
+	if activeCodesCount >= maxActiveCodesCount {
+		return errors.Errorf("%w: code was requested too many times, try again later", apperrors.ErrForbidden)
+	}
+
+	const codeLength = 6
+	code := generator.NumberCode(codeLength)
+
+	if err = a.mailClient.SendMail(ctx, newEmail, user.Locale, mail.ChangeEmail(code)); err != nil {
+		return errors.Errorf("send email change confirmation: %w", err)
+	}
+
+	if err = a.mailClient.SendMail(ctx, user.Email, user.Locale, mail.EmailChangeRequested(newEmail)); err != nil {
+		return errors.Errorf("send email change notice: %w", err)
+	}
+
+	err = a.emailChangeCodesRepository.StoreCode(ctx, models.ResetPasswordData{
+		UserID:    userID,
+		Code:      code,
+		Email:     newEmail,
+		ExpiresAt: time.Now().Add(emailChangeCodeDuration),
+	})
+	if err != nil {
+		return errors.Errorf("store email change code: %w", err)
+	}
+
+	return nil
+}
+
+func (a AuthService) ConfirmEmailChange(
+	ctx context.Context, userID uuid.UUID, newEmail, code string, client models.ClientInfo,
+) (*models.User, error) {
+	var data *models.ResetPasswordData
+
+	err := a.checkCode(ctx, newEmail, client, func() (err error) {
+		data, err = a.emailChangeCodesRepository.CheckCode(ctx, newEmail, code)
+		if err == nil && data.UserID != userID {
+			return apperrors.ErrEmailChangeCodeNotFound
+		}
+		return err
+	}, apperrors.ErrEmailChangeCodeNotFound, a.emailChangeCodesRepository.ClearAllCodes)
+	if err != nil {
+		return nil, errors.Errorf("check code: %w", err)
+	}
+
+	// the email could be taken after the code was sent
+	if err = a.checkMail(ctx, newEmail); err != nil {
+		return nil, err
+	}
+
+	if err = a.emailChangeCodesRepository.ClearAllCodes(ctx, newEmail); err != nil {
+		return nil, errors.Errorf("clear codes: %w", err)
+	}
+
+	err = a.userRepository.UpdateUserByID(ctx, userID, dto.UpdateUsersParams{
+		Email: &newEmail,
+	})
+	if err != nil {
+		return nil, errors.Errorf("update user email: %w", err)
+	}
+
+	user, err := a.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:   &userID,
+		Type:     models.SecurityEventEmailChange,
+		Outcome:  models.SecurityEventSuccess,
+		Metadata: map[string]string{"new_email": newEmail},
+	})
+
+	return user, nil
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+)
+
+const linkIDLength = 32
+
+type linksConfig struct {
+	ttl            time.Duration
+	applicationURL string
+}
+
+// linkPaths are the pages of the application, which send the token from the link to the api.
+var linkPaths = map[models.LinkPurpose]string{
+	models.LinkPurposeConfirmEmail:  "/auth/confirm-email",
+	models.LinkPurposeResetPassword: "/auth/reset-password",
+	models.LinkPurposeLogin:         "/auth/login-link",
+	models.LinkPurposeLinkIdentity:  "/auth/link-identity",
+	models.LinkPurposeRevokeSession: "/auth/revoke-session",
+}
+
+// SendLoginLink emails a link, which signs the user in without the password.
+// Nothing is returned if the user does not exist, so that registered emails could not be enumerated.
+func (a AuthService) SendLoginLink(ctx context.Context, email string, client models.ClientInfo) error {
+	// every requested link counts as an attempt, so that the mailbox could not be flooded
+	var (
+		targets = append([]attemptsTarget{a.linkAttempts(email)}, a.ipAttempts("link", client)...)
+		at      attempt
+	)
+
+	if err := a.startAttempt(ctx, &at, targets...); err != nil {
+		return err
+	}
+	if _, err := a.failAttempt(ctx, &at); err != nil {
+		return err
+	}
+
+	user, err := a.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUserNotFound) {
+			return nil
+		}
+		return errors.Errorf("get user by email: %w", err)
+	}
+
+	link, err := a.issueLink(ctx, models.Link{
+		Purpose: models.LinkPurposeLogin,
+		Email:   user.Email,
+		UserID:  user.ID,
+	}, client)
+	if err != nil {
+		return err
+	}
+
+	if err = a.mailClient.SendMail(ctx, user.Email, user.Locale, mail.LoginLink(link, a.links.ttl)); err != nil {
+		return errors.Errorf("send login link: %w", err)
+	}
+
+	return nil
+}
+
+// LoginByLink works as login with password: MFA is still required if the user has enabled it.
+func (a AuthService) LoginByLink(ctx context.Context, token string, client models.ClientInfo) (*dto.LoginOutput, error) {
+	link, err := a.consumeLink(ctx, token, models.LinkPurposeLogin, client)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.userRepository.GetUserByID(ctx, link.UserID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	// the link was sent to the previous email of the user
+	if !strings.EqualFold(user.Email, link.Email) {
+		return nil, apperrors.ErrLinkNotFound
+	}
+
+	if err = a.resetAttempts(ctx, a.linkAttempts(user.Email)); err != nil {
+		return nil, err
+	}
+
+	if err = a.checkUserStatus(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return a.startSession(ctx, user, client, loginMethodLink)
+}
+
+// ConfirmRegistrationByLink is an alternative to ConfirmRegistrationByCode, both are sent in the same mail.
+func (a AuthService) ConfirmRegistrationByLink(ctx context.Context, token string, client models.ClientInfo) (*dto.LoginOutput, error) {
+	link, err := a.consumeLink(ctx, token, models.LinkPurposeConfirmEmail, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.Registration == nil {
+		return nil, errors.Errorf("link %s has no registration data", link.ID)
+	}
+
+	if err = a.confirmationCodesRepository.ClearAllCodes(ctx, link.Email); err != nil {
+		return nil, errors.Errorf("clear confirmation codes: %w", err)
+	}
+
+	return a.registerUser(ctx, *link.Registration, client)
+}
+
+// ConfirmResetPasswordByLink is an alternative to ConfirmResetPasswordByCode, both are sent in the same mail.
+func (a AuthService) ConfirmResetPasswordByLink(ctx context.Context, token, password string, client models.ClientInfo) error {
+	link, err := a.consumeLink(ctx, token, models.LinkPurposeResetPassword, client)
+	if err != nil {
+		return err
+	}
+
+	if err = a.resetPasswordCodesRepository.ClearAllCodes(ctx, link.Email); err != nil {
+		return errors.Errorf("clear reset password codes: %w", err)
+	}
+
+	return a.resetPassword(ctx, link.UserID, password, client)
+}
+
+// issueLink stores the link bound to the browser of the client and returns its url.
+// The id is generated and the default ttl is used unless they are set.
+func (a AuthService) issueLink(ctx context.Context, link models.Link, client models.ClientInfo) (string, error) {
+	if link.ID == "" {
+		link.ID = a.generator.GenerateString(linkIDLength)
+	}
+	if link.Purpose.BoundToBrowser() {
+		link.NonceHash = hashLinkNonce(client.LinkNonce)
+	}
+	if link.ExpiresAt.IsZero() {
+		link.ExpiresAt = time.Now().Add(a.links.ttl)
+	}
+
+	if err := a.linksRepository.StoreLink(ctx, link); err != nil {
+		return "", errors.Errorf("store link: %w", err)
+	}
+
+	token := a.linkSigner.Sign(string(link.Purpose), link.ID, link.ExpiresAt)
+
+	return a.links.applicationURL + linkPaths[link.Purpose] + "?token=" + url.QueryEscape(token), nil
+}
+
+// consumeLink deletes the link, so that it could not be used again. The link is not deleted
+// if it was opened in another browser, then it still can be used in the browser which requested it.
+func (a AuthService) consumeLink(
+	ctx context.Context, token string, purpose models.LinkPurpose, client models.ClientInfo,
+) (*models.Link, error) {
+	id, err := a.linkSigner.Verify(token, string(purpose), time.Now())
+	if err != nil {
+		return nil, apperrors.ErrLinkNotFound
+	}
+
+	link, err := a.linksRepository.GetLink(ctx, id)
+	if err != nil {
+		return nil, errors.Errorf("get link: %w", err)
+	}
+
+	if link.Purpose != purpose {
+		return nil, apperrors.ErrLinkNotFound
+	}
+
+	if purpose.BoundToBrowser() &&
+		(client.LinkNonce == "" || subtle.ConstantTimeCompare(hashLinkNonce(client.LinkNonce), link.NonceHash) != 1) {
+		a.logger.Warn("link opened in another browser",
+			slog.String("purpose", string(purpose)),
+			slog.String("ip", client.IP),
+		)
+		return nil, apperrors.ErrLinkNotFound
+	}
+
+	// fails if the link was consumed by a concurrent request
+	if err = a.linksRepository.DeleteLink(ctx, id); err != nil {
+		return nil, errors.Errorf("delete link: %w", err)
+	}
+
+	return link, nil
+}
+
+// revokeLink deletes the link sent together with the code once the code is used,
+// so that the same mail could not be used twice.
+func (a AuthService) revokeLink(ctx context.Context, id string) error {
+	if id == "" {
+		return nil
+	}
+
+	err := a.linksRepository.DeleteLink(ctx, id)
+	if err != nil && !errors.Is(err, apperrors.ErrLinkNotFound) {
+		return errors.Errorf("delete link: %w", err)
+	}
+
+	return nil
+}
+
+func (a AuthService) linkAttempts(email string) attemptsTarget {
+	return attemptsTarget{key: "link:email:" + strings.ToLower(email), maxAttempts: a.bruteForce.maxCodeAttempts}
+}
+
+func hashLinkNonce(nonce string) []byte {
+	hash := sha256.Sum256([]byte(nonce))
+	return hash[:]
+}
@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/generator"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"github.com/tech-inspire/backend/auth-service/pkg/totp"
+)
+
+// totpAllowedSkew is the number of time steps accepted before and after the current one.
+const totpAllowedSkew = 1
+
+// startSession is called after the primary credentials of the user were verified.
+// It creates a new session or, if the user has enabled MFA, issues a challenge instead.
+// The method is recorded in the security log.
+func (a AuthService) startSession(
+	ctx context.Context, user *models.User, client models.ClientInfo, method string,
+) (*dto.LoginOutput, error) {
+	mfaEnabled, err := a.isMFAEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if mfaEnabled {
+		challenge := models.MFAChallenge{
+			Token:     a.generator.GenerateString(48),
+			UserID:    user.ID,
+			ExpiresAt: time.Now().Add(a.mfa.challengeDuration),
+		}
+
+		if err = a.mfaChallengesRepository.StoreChallenge(ctx, challenge); err != nil {
+			return nil, errors.Errorf("store mfa challenge: %w", err)
+		}
+
+		return &dto.LoginOutput{
+			User:         user,
+			MFAChallenge: &challenge,
+		}, nil
+	}
+
+	return a.signIn(ctx, user, client, method)
+}
+
+func (a AuthService) signIn(
+	ctx context.Context, user *models.User, client models.ClientInfo, method string,
+) (*dto.LoginOutput, error) {
+	sessionID := uuid.Must(uuid.NewV7())
+	session, err := a.createSession(ctx, user.ID, sessionID, client)
+	if err != nil {
+		return nil, errors.Errorf("create session: %w", err)
+	}
+
+	a.rememberDevice(ctx, user, session, client)
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      models.SecurityEventLogin,
+		Outcome:   models.SecurityEventSuccess,
+		SessionID: &session.ID,
+		Metadata:  map[string]string{"method": method},
+	})
+
+	return &dto.LoginOutput{
+		User:    user,
+		Session: session,
+	}, nil
+}
+
+func (a AuthService) isMFAEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	userTOTP, err := a.mfaRepository.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrMFANotEnabled) {
+			return false, nil
+		}
+		return false, errors.Errorf("get user totp: %w", err)
+	}
+
+	return userTOTP.Enabled(), nil
+}
+
+// VerifyMFALogin completes the login started with password by checking either TOTP or recovery code.
+func (a AuthService) VerifyMFALogin(
+	ctx context.Context, challengeToken, code string, client models.ClientInfo,
+) (*dto.LoginOutput, error) {
+	challenge, err := a.mfaChallengesRepository.GetChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, errors.Errorf("get mfa challenge: %w", err)
+	}
+
+	// the codes share the limits with the passwords, otherwise every new challenge would give more guesses
+	var (
+		accountTarget = a.accountAttempts(challenge.UserID)
+		targets       = append([]attemptsTarget{accountTarget}, a.ipAttempts("login", client)...)
+		at            attempt
+	)
+
+	if err = a.startAttempt(ctx, &at, targets...); err != nil {
+		return nil, err
+	}
+
+	ok, err := a.checkSecondFactor(ctx, challenge.UserID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		if _, err = a.failAttempt(ctx, &at); err != nil {
+			return nil, err
+		}
+
+		attempts, err := a.mfaChallengesRepository.RegisterFailedAttempt(ctx, *challenge)
+		if err != nil {
+			return nil, errors.Errorf("register failed mfa attempt: %w", err)
+		}
+
+		a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+			UserID:   &challenge.UserID,
+			Type:     models.SecurityEventLogin,
+			Outcome:  models.SecurityEventFailure,
+			Metadata: map[string]string{"method": loginMethodMFA, "reason": "invalid_mfa_code"},
+		})
+
+		if attempts >= a.mfa.maxChallengeAttempts {
+			// user has to start over with password
+			if err = a.mfaChallengesRepository.DeleteChallenge(ctx, challengeToken); err != nil {
+				return nil, errors.Errorf("delete mfa challenge: %w", err)
+			}
+		}
+
+		return nil, apperrors.ErrInvalidMFACode
+	}
+
+	if err = a.completeAttempt(ctx, &at); err != nil {
+		return nil, err
+	}
+
+	if err = a.mfaChallengesRepository.DeleteChallenge(ctx, challengeToken); err != nil {
+		return nil, errors.Errorf("delete mfa challenge: %w", err)
+	}
+
+	if err = a.resetAttempts(ctx, accountTarget); err != nil {
+		return nil, err
+	}
+
+	user, err := a.userRepository.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	// the user could have been suspended after the password was checked
+	if err = a.checkUserStatus(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return a.signIn(ctx, user, client, loginMethodMFA)
+}
+
+func (a AuthService) checkSecondFactor(ctx context.Context, userID uuid.UUID, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+
+	if !isTOTPCode(code) {
+		ok, err := a.mfaRepository.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+		if err != nil {
+			return false, errors.Errorf("use recovery code: %w", err)
+		}
+
+		return ok, nil
+	}
+
+	userTOTP, err := a.mfaRepository.GetUserTOTP(ctx, userID)
+	if err != nil {
+		return false, errors.Errorf("get user totp: %w", err)
+	}
+
+	if !userTOTP.Enabled() {
+		return false, nil
+	}
+
+	counter, ok := totp.Validate(userTOTP.Secret, code, time.Now(), totpAllowedSkew)
+	if !ok {
+		return false, nil
+	}
+
+	// the same code can not be used twice
+	ok, err = a.mfaRepository.UseTOTPCounter(ctx, userID, counter)
+	if err != nil {
+		return false, errors.Errorf("use totp counter: %w", err)
+	}
+
+	return ok, nil
+}
+
+// BeginTOTPEnrollment generates a new secret for the user. MFA is not enabled until
+// the user confirms the enrollment with a valid code.
+func (a AuthService) BeginTOTPEnrollment(ctx context.Context, userID uuid.UUID, password string) (*dto.TOTPEnrollmentOutput, error) {
+	user, err := a.checkUserPassword(ctx, userID, password)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaEnabled, err := a.isMFAEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaEnabled {
+		return nil, apperrors.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errors.Errorf("generate totp secret: %w", err)
+	}
+
+	if err = a.mfaRepository.CreateUserTOTP(ctx, userID, secret); err != nil {
+		return nil, errors.Errorf("create user totp: %w", err)
+	}
+
+	return &dto.TOTPEnrollmentOutput{
+		Secret:          totp.EncodeSecret(secret),
+		ProvisioningURI: totp.ProvisioningURI(a.mfa.totpIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTPEnrollment enables MFA and returns recovery codes.
+func (a AuthService) ConfirmTOTPEnrollment(
+	ctx context.Context, userID uuid.UUID, code string, client models.ClientInfo,
+) (*dto.RecoveryCodesOutput, error) {
+	userTOTP, err := a.mfaRepository.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrMFANotEnabled) {
+			return nil, apperrors.ErrTOTPEnrollmentNotFound
+		}
+		return nil, errors.Errorf("get user totp: %w", err)
+	}
+
+	if userTOTP.Enabled() {
+		return nil, apperrors.ErrMFAAlreadyEnabled
+	}
+
+	counter, ok := totp.Validate(userTOTP.Secret, strings.TrimSpace(code), time.Now(), totpAllowedSkew)
+	if !ok {
+		return nil, apperrors.ErrInvalidMFACode
+	}
+
+	codes, hashes := a.generateRecoveryCodes()
+
+	if err = a.mfaRepository.EnableUserTOTP(ctx, userID, counter, hashes); err != nil {
+		return nil, errors.Errorf("enable user totp: %w", err)
+	}
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:  &userID,
+		Type:    models.SecurityEventMFAEnabled,
+		Outcome: models.SecurityEventSuccess,
+	})
+
+	return &dto.RecoveryCodesOutput{Codes: codes}, nil
+}
+
+func (a AuthService) DisableTOTP(ctx context.Context, userID uuid.UUID, password string, client models.ClientInfo) error {
+	if _, err := a.checkUserPassword(ctx, userID, password); err != nil {
+		return err
+	}
+
+	if _, err := a.mfaRepository.GetUserTOTP(ctx, userID); err != nil {
+		return errors.Errorf("get user totp: %w", err)
+	}
+
+	if err := a.mfaRepository.DeleteUserTOTP(ctx, userID); err != nil {
+		return errors.Errorf("delete user totp: %w", err)
+	}
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:  &userID,
+		Type:    models.SecurityEventMFADisabled,
+		Outcome: models.SecurityEventSuccess,
+	})
+
+	return nil
+}
+
+// RegenerateRecoveryCodes invalidates all previous recovery codes of the user.
+func (a AuthService) RegenerateRecoveryCodes(
+	ctx context.Context, userID uuid.UUID, password string, client models.ClientInfo,
+) (*dto.RecoveryCodesOutput, error) {
+	if _, err := a.checkUserPassword(ctx, userID, password); err != nil {
+		return nil, err
+	}
+
+	mfaEnabled, err := a.isMFAEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !mfaEnabled {
+		return nil, apperrors.ErrMFANotEnabled
+	}
+
+	codes, hashes := a.generateRecoveryCodes()
+
+	if err = a.mfaRepository.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, errors.Errorf("replace recovery codes: %w", err)
+	}
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:  &userID,
+		Type:    models.SecurityEventRecoveryCodesRegenerated,
+		Outcome: models.SecurityEventSuccess,
+	})
+
+	return &dto.RecoveryCodesOutput{Codes: codes}, nil
+}
+
+func (a AuthService) checkUserPassword(ctx context.Context, userID uuid.UUID, password string) (*models.User, error) {
+	user, passwordHash, err := a.userRepository.GetUserByIDWithHash(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	ok, err := a.verifyPassword(ctx, userID, password, passwordHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, apperrors.ErrForbidden
+	}
+
+	return user, nil
+}
+
+// verifyPassword checks the password and, if it matches, replaces the outdated hash,
+// so that hashes are upgraded when hashing parameters change.
+func (a AuthService) verifyPassword(ctx context.Context, userID uuid.UUID, password string, passwordHash []byte) (bool, error) {
+	// users created with an external identity have no password, the hasher rejects the empty hash
+	ok, needsRehash, err := a.passwordHasher.Verify(password, passwordHash)
+	if err != nil {
+		return false, errors.Errorf("verify password: %w", err)
+	}
+
+	if ok && needsRehash {
+		if err = a.rehashPassword(ctx, userID, password); err != nil {
+			// the password is correct, so the user should not be affected, hash will be upgraded next time
+			a.logger.Error("rehash password", slog.String("user_id", userID.String()), logger.Error(err))
+		}
+	}
+
+	return ok, nil
+}
+
+func (a AuthService) rehashPassword(ctx context.Context, userID uuid.UUID, password string) error {
+	hash, err := a.passwordHasher.Hash(password)
+	if err != nil {
+		return errors.Errorf("hash password: %w", err)
+	}
+
+	err = a.userRepository.UpdateUserByID(ctx, userID, dto.UpdateUsersParams{
+		Password: &hash,
+	})
+	if err != nil {
+		return errors.Errorf("update user password: %w", err)
+	}
+
+	return nil
+}
+
+func (a AuthService) generateRecoveryCodes() (codes []string, hashes [][]byte) {
+	const partLength = 5
+
+	codes = make([]string, a.mfa.recoveryCodesCount)
+	hashes = make([][]byte, a.mfa.recoveryCodesCount)
+
+	for i := range codes {
+		codes[i] = generator.ReadableCode(partLength) + "-" + generator.ReadableCode(partLength)
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes
+}
+
+// hashRecoveryCode normalizes the code, so that users could type it with spaces or in upper case.
+// Recovery codes are random, so salt and slow hash are not needed.
+func hashRecoveryCode(code string) []byte {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+
+	hash := sha256.Sum256([]byte(normalized))
+	return hash[:]
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -2,33 +2,223 @@ package service
 
 import (
 	"context"
+	"log/slog"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
 	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
 )
 
-func (a AuthService) createSession(ctx context.Context, userID, sessionID uuid.UUID) (*models.Session, error) {
-	const refreshTokenLength = 64
+const (
+	sessionTokenLength  = 64
+	maxDeviceNameLength = 64
+)
+
+func (a AuthService) createSession(
+	ctx context.Context, userID, sessionID uuid.UUID, client models.ClientInfo,
+) (*models.Session, error) {
 	var (
 		createdAt    = time.Now()
 		expiresAt    = createdAt.Add(a.refreshTokenDuration)
-		refreshToken = a.generator.GenerateString(refreshTokenLength)
+		refreshToken = a.generator.GenerateString(sessionTokenLength)
 	)
 
 	session := models.Session{
-		ID:        sessionID,
-		UserID:    userID,
-		Token:     refreshToken,
-		CreatedAt: createdAt,
-		ExpiresAt: expiresAt,
+		ID:          sessionID,
+		UserID:      userID,
+		Token:       refreshToken,
+		CreatedAt:   createdAt,
+		RefreshedAt: createdAt,
+		ExpiresAt:   expiresAt,
+		Client:      client,
 	}
 
-	err := a.sessionRepository.AddUserSession(ctx, session, a.sessionsLimitPerUser)
+	evicted, err := a.sessionRepository.AddUserSession(ctx, session, a.sessionsLimitPerUser, a.sessionEvictionPolicy)
 	if err != nil {
 		return nil, errors.Errorf("create user session: %w", err)
 	}
 
+	for _, evictedSession := range evicted {
+		a.logger.Info("session signed out: sessions limit exceeded",
+			slog.String("user_id", userID.String()),
+			slog.String("session_id", evictedSession.ID.String()),
+			slog.String("device_name", evictedSession.Client.DeviceName),
+		)
+
+		a.revokeSessionTokens(ctx, evictedSession.ID)
+	}
+
 	return &session, nil
 }
+
+// revokeReusedSession ends the session whose rotated refresh token was presented again.
+// All tokens of the session are derived from the same login, so the whole session is revoked.
+func (a AuthService) revokeReusedSession(ctx context.Context, userID, sessionID uuid.UUID, client models.ClientInfo) error {
+	a.logger.Warn("security event: refresh token reuse detected, revoking session",
+		slog.String("user_id", userID.String()),
+		slog.String("session_id", sessionID.String()),
+	)
+
+	if err := a.sessionRepository.DeleteUserSession(ctx, userID, sessionID); err != nil {
+		return errors.Errorf("delete reused session: %w", err)
+	}
+
+	a.revokeSessionTokens(ctx, sessionID)
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:    &userID,
+		Type:      models.SecurityEventSessionRevoked,
+		Outcome:   models.SecurityEventSuccess,
+		SessionID: &sessionID,
+		Metadata:  map[string]string{"reason": "refresh_token_reuse"},
+	})
+
+	return nil
+}
+
+// ListSessions returns active sessions of the user, most recently used first.
+func (a AuthService) ListSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]dto.SessionOutput, error) {
+	sessions, err := a.sessionRepository.GetUserSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user sessions: %w", err)
+	}
+
+	now := time.Now()
+
+	out := make([]dto.SessionOutput, 0, len(sessions))
+	for _, session := range sessions {
+		if now.After(session.ExpiresAt) { // in case redis has not deleted it yet
+			continue
+		}
+
+		out = append(out, dto.SessionOutput{
+			Session: session,
+			Current: session.ID == currentSessionID,
+		})
+	}
+
+	slices.SortFunc(out, func(a, b dto.SessionOutput) int {
+		return b.RefreshedAt.Compare(a.RefreshedAt)
+	})
+
+	return out, nil
+}
+
+func (a AuthService) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID, client models.ClientInfo) error {
+	if _, err := a.sessionRepository.GetUserSession(ctx, userID, sessionID); err != nil {
+		return errors.Errorf("get user session: %w", err)
+	}
+
+	if err := a.sessionRepository.DeleteUserSession(ctx, userID, sessionID); err != nil {
+		return errors.Errorf("delete user session: %w", err)
+	}
+
+	a.revokeSessionTokens(ctx, sessionID)
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:    &userID,
+		Type:      models.SecurityEventSessionRevoked,
+		Outcome:   models.SecurityEventSuccess,
+		SessionID: &sessionID,
+	})
+
+	return nil
+}
+
+// RevokeOtherSessions signs the user out everywhere except the current session.
+func (a AuthService) RevokeOtherSessions(
+	ctx context.Context, userID, currentSessionID uuid.UUID, client models.ClientInfo,
+) error {
+	sessions, err := a.sessionRepository.GetUserSessions(ctx, userID)
+	if err != nil {
+		return errors.Errorf("get user sessions: %w", err)
+	}
+
+	if err = a.sessionRepository.DeleteUserSessions(ctx, userID, currentSessionID); err != nil {
+		return errors.Errorf("delete other user sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		if session.ID != currentSessionID {
+			a.revokeSessionTokens(ctx, session.ID)
+		}
+	}
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:    &userID,
+		Type:      models.SecurityEventSessionRevoked,
+		Outcome:   models.SecurityEventSuccess,
+		SessionID: &currentSessionID,
+		Metadata:  map[string]string{"scope": "others"},
+	})
+
+	return nil
+}
+
+func (a AuthService) RevokeAllSessions(ctx context.Context, userID uuid.UUID, client models.ClientInfo) error {
+	if err := a.revokeAllSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:   &userID,
+		Type:     models.SecurityEventSessionRevoked,
+		Outcome:  models.SecurityEventSuccess,
+		Metadata: map[string]string{"scope": "all"},
+	})
+
+	return nil
+}
+
+// revokeAllSessions is called by the actions recording their own security events.
+func (a AuthService) revokeAllSessions(ctx context.Context, userID uuid.UUID) error {
+	if err := a.sessionRepository.DeleteUserSessions(ctx, userID); err != nil {
+		return errors.Errorf("delete user sessions: %w", err)
+	}
+
+	a.revokeUserTokens(ctx, userID)
+
+	return nil
+}
+
+func (a AuthService) RenameSession(ctx context.Context, userID, sessionID uuid.UUID, deviceName string) error {
+	deviceName = strings.TrimSpace(deviceName)
+	if deviceName == "" || utf8.RuneCountInString(deviceName) > maxDeviceNameLength {
+		return apperrors.ErrInvalidDeviceName
+	}
+
+	if err := a.sessionRepository.RenameUserSession(ctx, userID, sessionID, deviceName); err != nil {
+		return errors.Errorf("rename user session: %w", err)
+	}
+
+	return nil
+}
+
+// revokeSessionTokens makes access tokens of the deleted sessions invalid before they expire.
+// Sessions are already deleted, so if it fails tokens are valid only until they expire.
+func (a AuthService) revokeSessionTokens(ctx context.Context, sessionIDs ...uuid.UUID) {
+	if err := a.tokenRevoker.RevokeSessionTokens(ctx, sessionIDs...); err != nil {
+		a.logger.Error("revoke session tokens", logger.Error(err))
+	}
+}
+
+// revokeUserTokens makes all access tokens of the user issued until now invalid.
+// The tokens issued from now on carry the incremented revision and stay valid.
+func (a AuthService) revokeUserTokens(ctx context.Context, userID uuid.UUID) {
+	revision, err := a.userRepository.IncrementUserTokensRevision(ctx, userID)
+	if err != nil {
+		a.logger.Error("increment user tokens revision", logger.Error(err))
+		return
+	}
+
+	if err = a.tokenRevoker.RevokeUserTokens(ctx, userID, revision); err != nil {
+		a.logger.Error("revoke user tokens", logger.Error(err))
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+type deletedSessionsRepository struct {
+	SessionRepository
+	deleted []uuid.UUID
+}
+
+func (r *deletedSessionsRepository) DeleteUserSession(_ context.Context, _ uuid.UUID, sessionID uuid.UUID) error {
+	r.deleted = append(r.deleted, sessionID)
+	return nil
+}
+
+type revokedTokens struct {
+	TokenRevoker
+	sessions []uuid.UUID
+}
+
+func (r *revokedTokens) RevokeSessionTokens(_ context.Context, sessionIDs ...uuid.UUID) error {
+	r.sessions = append(r.sessions, sessionIDs...)
+	return nil
+}
+
+type memorySecurityEvents struct {
+	SecurityEventsRepository
+	events []models.SecurityEvent
+}
+
+func (r *memorySecurityEvents) CreateSecurityEvent(_ context.Context, event models.SecurityEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func TestRevokeReusedSessionRecordsSecurityEvent(t *testing.T) {
+	var (
+		sessions = &deletedSessionsRepository{}
+		revoker  = &revokedTokens{}
+		events   = &memorySecurityEvents{}
+
+		userID    = uuid.Must(uuid.NewV7())
+		sessionID = uuid.Must(uuid.NewV7())
+		client    = models.ClientInfo{IP: "203.0.113.7", UserAgent: "curl/8.0"}
+	)
+
+	a := AuthService{
+		logger:                   &logger.Logger{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))},
+		sessionRepository:        sessions,
+		tokenRevoker:             revoker,
+		securityEventsRepository: events,
+	}
+
+	if err := a.revokeReusedSession(context.Background(), userID, sessionID, client); err != nil {
+		t.Fatalf("revoke reused session: %v", err)
+	}
+
+	if len(sessions.deleted) != 1 || sessions.deleted[0] != sessionID {
+		t.Fatalf("deleted sessions = %v, want [%s]", sessions.deleted, sessionID)
+	}
+	if len(revoker.sessions) != 1 || revoker.sessions[0] != sessionID {
+		t.Fatalf("revoked session tokens = %v, want [%s]", revoker.sessions, sessionID)
+	}
+
+	if len(events.events) != 1 {
+		t.Fatalf("got %d security events, want 1", len(events.events))
+	}
+
+	event := events.events[0]
+	switch {
+	case event.Type != models.SecurityEventSessionRevoked:
+		t.Errorf("event type = %q, want %q", event.Type, models.SecurityEventSessionRevoked)
+	case event.Outcome != models.SecurityEventSuccess:
+		t.Errorf("event outcome = %q, want %q", event.Outcome, models.SecurityEventSuccess)
+	case event.UserID == nil || *event.UserID != userID:
+		t.Errorf("event user = %v, want %s", event.UserID, userID)
+	case event.SessionID == nil || *event.SessionID != sessionID:
+		t.Errorf("event session = %v, want %s", event.SessionID, sessionID)
+	case event.Metadata["reason"] != "refresh_token_reuse":
+		t.Errorf("event reason = %q, want %q", event.Metadata["reason"], "refresh_token_reuse")
+	case event.IP != client.IP || event.UserAgent != client.UserAgent:
+		t.Errorf("event client = %q %q, want %q %q", event.IP, event.UserAgent, client.IP, client.UserAgent)
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+// getUserSuspension returns nil if the user is not suspended or the suspension has expired.
+func (a AuthService) getUserSuspension(ctx context.Context, userID uuid.UUID) (*models.UserSuspension, error) {
+	suspension, err := a.suspensionsRepository.GetUserSuspension(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUserNotSuspended) {
+			return nil, nil
+		}
+		return nil, errors.Errorf("get user suspension: %w", err)
+	}
+
+	if suspension.Status(time.Now()) == models.UserStatusActive {
+		return nil, nil
+	}
+
+	return suspension, nil
+}
+
+// checkUserStatus refuses suspended and banned users. It is checked after the credentials,
+// so that the status is not disclosed to anyone who knows only the login.
+func (a AuthService) checkUserStatus(ctx context.Context, userID uuid.UUID) error {
+	suspension, err := a.getUserSuspension(ctx, userID)
+	if err != nil || suspension == nil {
+		return err
+	}
+
+	metadata := map[string]string{"reason": suspension.Reason}
+
+	if suspension.ExpiresAt == nil {
+		return apperrors.ErrUserBanned.WithMetadata(metadata)
+	}
+
+	metadata["expires_at"] = suspension.ExpiresAt.UTC().Format(time.RFC3339)
+
+	return apperrors.ErrUserSuspended.WithMetadata(metadata)
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers webp decoder
+)
+
+// exifOrientation is the value of the orientation tag, see the TIFF specification.
+type exifOrientation int
+
+const (
+	orientationNormal exifOrientation = iota + 1
+	orientationFlipH
+	orientationRotate180
+	orientationFlipV
+	orientationTranspose
+	orientationRotate90
+	orientationTransverse
+	orientationRotate270
+)
+
+// swapsSides is true for the orientations which rotate the image by 90 degrees.
+func (o exifOrientation) swapsSides() bool {
+	return o >= orientationTranspose && o <= orientationRotate270
+}
+
+// sourcePoint maps the point of the displayed image to the stored one of w x h pixels.
+// The points are the corners of the pixels, so rectangles are mapped by their corners.
+func (o exifOrientation) sourcePoint(x, y, w, h int) image.Point {
+	switch o {
+	case orientationFlipH:
+		return image.Pt(w-x, y)
+	case orientationRotate180:
+		return image.Pt(w-x, h-y)
+	case orientationFlipV:
+		return image.Pt(x, h-y)
+	case orientationTranspose:
+		return image.Pt(y, x)
+	case orientationRotate90:
+		return image.Pt(y, h-x)
+	case orientationTransverse:
+		return image.Pt(w-y, h-x)
+	case orientationRotate270:
+		return image.Pt(w-y, x)
+	default:
+		return image.Pt(x, y)
+	}
+}
+
+// avatarImage is the decoded upload, the cropping is done in the displayed orientation.
+type avatarImage struct {
+	img         image.Image
+	orientation exifOrientation
+}
+
+func decodeAvatar(data []byte, maxPixels, minSide int) (*avatarImage, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, apperrors.ErrInvalidAvatar
+	}
+
+	if config.Width*config.Height > maxPixels || min(config.Width, config.Height) < minSide {
+		return nil, apperrors.ErrInvalidAvatarSize
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, apperrors.ErrInvalidAvatar
+	}
+
+	orientation := orientationNormal
+	if format == "jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	return &avatarImage{img: img, orientation: orientation}, nil
+}
+
+// displayedSize is the size of the image after the orientation is applied.
+func (a avatarImage) displayedSize() (width, height int) {
+	size := a.img.Bounds().Size()
+	if a.orientation.swapsSides() {
+		return size.Y, size.X
+	}
+
+	return size.X, size.Y
+}
+
+// squareCrop returns the square of the displayed image to be used as the avatar: the center of the image,
+// or the center of the crop rectangle, if it is set.
+func (a avatarImage) squareCrop(crop *dto.AvatarCrop, minSide int) (image.Rectangle, error) {
+	width, height := a.displayedSize()
+
+	rect := image.Rect(0, 0, width, height)
+	if crop != nil {
+		rect = image.Rect(crop.X, crop.Y, crop.X+crop.Width, crop.Y+crop.Height)
+		if crop.Width <= 0 || crop.Height <= 0 || !rect.In(image.Rect(0, 0, width, height)) {
+			return image.Rectangle{}, apperrors.ErrInvalidAvatarCrop
+		}
+	}
+
+	side := min(rect.Dx(), rect.Dy())
+	if side < minSide {
+		return image.Rectangle{}, apperrors.ErrInvalidAvatarSize
+	}
+
+	x := rect.Min.X + (rect.Dx()-side)/2
+	y := rect.Min.Y + (rect.Dy()-side)/2
+
+	return image.Rect(x, y, x+side, y+side), nil
+}
+
+// resize scales the square of the displayed image to size x size pixels. The square is scaled
+// in the stored orientation first, so only the small result has to be rotated.
+func (a avatarImage) resize(square image.Rectangle, size int) *image.NRGBA {
+	bounds := a.img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	source := image.Rectangle{
+		Min: a.orientation.sourcePoint(square.Min.X, square.Min.Y, w, h),
+		Max: a.orientation.sourcePoint(square.Max.X, square.Max.Y, w, h),
+	}.Canon().Add(bounds.Min)
+
+	scaled := image.NewNRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), a.img, source, draw.Src, nil)
+
+	return orientSquare(scaled, a.orientation)
+}
+
+// orientSquare applies the orientation to the square image.
+func orientSquare(src *image.NRGBA, orientation exifOrientation) *image.NRGBA {
+	if orientation == orientationNormal {
+		return src
+	}
+
+	// pixels are mapped by their centers, so the last pixel is at side-1
+	side := src.Bounds().Dx()
+	dst := image.NewNRGBA(src.Bounds())
+
+	for y := range side {
+		for x := range side {
+			p := orientation.sourcePoint(x, y, side-1, side-1)
+			dst.SetNRGBA(x, y, src.NRGBAAt(p.X, p.Y))
+		}
+	}
+
+	return dst
+}
+
+// encodeAvatar writes the image without any metadata, transparent images are kept in png.
+func encodeAvatar(img *image.NRGBA, jpegQuality int) (data []byte, contentType string, err error) {
+	var buf bytes.Buffer
+
+	if img.Opaque() {
+		if err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, "", errors.Errorf("encode jpeg: %w", err)
+		}
+
+		return buf.Bytes(), "image/jpeg", nil
+	}
+
+	if err = png.Encode(&buf, img); err != nil {
+		return nil, "", errors.Errorf("encode png: %w", err)
+	}
+
+	return buf.Bytes(), "image/png", nil
+}
+
+// jpegOrientation reads the orientation tag from the exif segment of the jpeg.
+// Missing or malformed metadata is treated as the normal orientation.
+func jpegOrientation(data []byte) exifOrientation {
+	const (
+		markerSOI  = 0xD8
+		markerSOS  = 0xDA
+		markerAPP1 = 0xE1
+	)
+
+	if len(data) < 2 || data[0] != 0xFF || data[1] != markerSOI {
+		return orientationNormal
+	}
+
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return orientationNormal
+		}
+
+		marker := data[pos+1]
+		if marker == markerSOS {
+			// the metadata segments come before the image data
+			return orientationNormal
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return orientationNormal
+		}
+
+		segment := data[pos+4 : end]
+		if marker == markerAPP1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+
+		pos = end
+	}
+
+	return orientationNormal
+}
+
+// tiffOrientation looks for the orientation tag in the first directory of the tiff structure of exif.
+func tiffOrientation(tiff []byte) exifOrientation {
+	const orientationTag = 0x0112
+
+	if len(tiff) < 8 {
+		return orientationNormal
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNormal
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return orientationNormal
+	}
+
+	entries := int(order.Uint16(tiff[offset:]))
+	for i := range entries {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+
+		if order.Uint16(tiff[entry:]) != orientationTag {
+			continue
+		}
+
+		orientation := exifOrientation(order.Uint16(tiff[entry+8:]))
+		if orientation < orientationNormal || orientation > orientationRotate270 {
+			return orientationNormal
+		}
+
+		return orientation
+	}
+
+	return orientationNormal
+}
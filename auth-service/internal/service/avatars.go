@@ -3,42 +3,162 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
 	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
 )
 
+// AvatarService processes the uploaded avatars: they are auto-oriented, cropped to a square
+// and re-encoded without metadata into several sizes.
 type AvatarService struct {
+	logger         *logger.Logger
 	userRepository UserRepository
 	storage        AvatarStorage
+
+	sizes       []int
+	jpegQuality int
+	maxPixels   int
 }
 
-func NewAvatarService(userRepository UserRepository, storage AvatarStorage) *AvatarService {
-	return &AvatarService{userRepository: userRepository, storage: storage}
+func NewAvatarService(log *logger.Logger, cfg *config.Config, userRepository UserRepository, storage AvatarStorage) *AvatarService {
+	return &AvatarService{
+		logger:         log,
+		userRepository: userRepository,
+		storage:        storage,
+		sizes:          cfg.Avatars.Sizes,
+		jpegQuality:    cfg.Avatars.JPEGQuality,
+		maxPixels:      cfg.Avatars.MaxPixels,
+	}
 }
 
 func (g AvatarService) UploadUserAvatar(ctx context.Context, params dto.UploadUserAvatar) error {
-	_, err := g.userRepository.GetUserByID(ctx, params.UserID)
+	user, err := g.userRepository.GetUserByID(ctx, params.UserID)
 	if err != nil {
 		return fmt.Errorf("get user: %w", err)
 	}
 
-	path, err := g.storage.UploadUserAvatar(ctx, params)
+	minSide := g.sizes[0]
+
+	avatar, err := decodeAvatar(params.Data, g.maxPixels, minSide)
+	if err != nil {
+		return err
+	}
+
+	square, err := avatar.squareCrop(params.Crop, minSide)
 	if err != nil {
-		return errors.Errorf("storage: get upload url: %w", err)
+		return err
+	}
+
+	// object names never change, so every upload gets the new version for CDN caches
+	version := strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	variants := make([]models.AvatarVariant, 0, len(g.sizes))
+	for _, size := range g.sizes {
+		if size > square.Dx() {
+			// small images are not upscaled
+			break
+		}
+
+		data, contentType, err := encodeAvatar(avatar.resize(square, size), g.jpegQuality)
+		if err != nil {
+			return errors.Errorf("encode %dpx variant: %w", size, err)
+		}
+
+		path, err := g.storage.UploadUserAvatarVariant(ctx, dto.AvatarVariantUpload{
+			UserID:      params.UserID,
+			Version:     version,
+			Size:        size,
+			Data:        data,
+			ContentType: contentType,
+		})
+		if err != nil {
+			return errors.Errorf("storage: upload %dpx variant: %w", size, err)
+		}
+
+		variants = append(variants, models.AvatarVariant{Size: size, URL: path})
 	}
 
 	err = g.userRepository.UpdateUserByID(ctx, params.UserID, dto.UpdateUsersParams{
-		AvatarUrl: &path,
+		// the largest variant is kept as the avatar url for the clients unaware of the variants
+		AvatarUrl:      &variants[len(variants)-1].URL,
+		AvatarVariants: variants,
 	})
 	if err != nil {
 		return errors.Errorf("update user by id: %w", err)
 	}
 
+	g.deletePreviousAvatar(ctx, user)
+
 	return nil
 }
 
+// avatarUploadGrace is longer than any upload takes, the objects of the avatars being uploaded
+// are not referenced by the user yet and must not be removed.
+const avatarUploadGrace = 10 * time.Minute
+
+// deletePreviousAvatar removes the objects which are not referenced by the user after the update: the replaced
+// avatar and the leftovers of the concurrent uploads. The objects of the uploads still in progress are kept.
+func (g AvatarService) deletePreviousAvatar(ctx context.Context, previous *models.User) {
+	err := g.deleteUnreferencedAvatarObjects(ctx, previous)
+	if err != nil {
+		// the objects are removed with the next upload or with the rest of the avatars when the account is deleted
+		g.logger.Error("delete previous avatar", slog.String("user_id", previous.ID.String()), logger.Error(err))
+	}
+}
+
+func (g AvatarService) deleteUnreferencedAvatarObjects(ctx context.Context, previous *models.User) error {
+	// the concurrent upload may have replaced the avatar after this one
+	current, err := g.userRepository.GetUserByID(ctx, previous.ID)
+	if err != nil {
+		return errors.Errorf("get user: %w", err)
+	}
+
+	referenced := avatarPaths(current)
+
+	paths, err := g.storage.ListUserAvatarObjects(ctx, previous.ID, time.Now().Add(-avatarUploadGrace))
+	if err != nil {
+		return errors.Errorf("storage: list avatar objects: %w", err)
+	}
+	// the previous avatar is removed right away, it is not being uploaded anymore
+	paths = append(paths, avatarPaths(previous)...)
+
+	var unreferenced []string
+	for _, path := range paths {
+		if !slices.Contains(referenced, path) && !slices.Contains(unreferenced, path) {
+			unreferenced = append(unreferenced, path)
+		}
+	}
+
+	if err = g.storage.DeleteAvatarObjects(ctx, unreferenced); err != nil {
+		return errors.Errorf("storage: delete avatar objects: %w", err)
+	}
+
+	return nil
+}
+
+// avatarPaths returns the objects of the avatar of the user.
+func avatarPaths(user *models.User) []string {
+	var paths []string
+	if user.AvatarURL != nil {
+		paths = append(paths, *user.AvatarURL)
+	}
+	for _, variant := range user.AvatarVariants {
+		if !slices.Contains(paths, variant.URL) {
+			paths = append(paths, variant.URL)
+		}
+	}
+
+	return paths
+}
+
 func (g AvatarService) DeleteProfileAvatar(ctx context.Context, userID uuid.UUID) error {
 	err := g.storage.DeleteUserAvatar(ctx, userID)
 	if err != nil {
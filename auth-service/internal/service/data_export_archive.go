@@ -0,0 +1,306 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+)
+
+const exportSecurityEventsPageSize = 100
+
+// exportManifest is written to manifest.json in the root of the archive.
+type exportManifest struct {
+	ExportID    uuid.UUID              `json:"export_id"`
+	UserID      uuid.UUID              `json:"user_id"`
+	RequestedAt time.Time              `json:"requested_at"`
+	GeneratedAt time.Time              `json:"generated_at"`
+	Services    []exportManifestSource `json:"services"`
+}
+
+type exportManifestSource struct {
+	Service     string    `json:"service"`
+	CompletedAt time.Time `json:"completed_at"`
+	Files       []string  `json:"files"`
+}
+
+type exportUser struct {
+	ID               uuid.UUID              `json:"id"`
+	Email            string                 `json:"email"`
+	Username         string                 `json:"username"`
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description"`
+	AvatarURL        *string                `json:"avatar_url"`
+	AvatarVariants   []models.AvatarVariant `json:"avatar_variants"`
+	IsAdmin          bool                   `json:"is_admin"`
+	Locale           string                 `json:"locale"`
+	NotifyNewDevices bool                   `json:"notify_new_devices"`
+	CreatedAt        time.Time              `json:"created_at"`
+}
+
+type exportSession struct {
+	ID          uuid.UUID `json:"id"`
+	DeviceName  string    `json:"device_name"`
+	UserAgent   string    `json:"user_agent"`
+	IP          string    `json:"ip"`
+	Location    string    `json:"location"`
+	CreatedAt   time.Time `json:"created_at"`
+	RefreshedAt time.Time `json:"refreshed_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type exportSecurityEvent struct {
+	Type      models.SecurityEventType    `json:"type"`
+	Outcome   models.SecurityEventOutcome `json:"outcome"`
+	IP        string                      `json:"ip"`
+	UserAgent string                      `json:"user_agent"`
+	SessionID *uuid.UUID                  `json:"session_id,omitempty"`
+	Metadata  map[string]string           `json:"metadata,omitempty"`
+	CreatedAt time.Time                   `json:"created_at"`
+}
+
+// exportArchive writes the files to the zip archive and remembers their names for the manifest.
+type exportArchive struct {
+	zw    *zip.Writer
+	files []string
+}
+
+func (a *exportArchive) writeJSON(name string, v any) error {
+	w, err := a.zw.Create(name)
+	if err != nil {
+		return errors.Errorf("create %s: %w", name, err)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(v); err != nil {
+		return errors.Errorf("encode %s: %w", name, err)
+	}
+
+	a.files = append(a.files, name)
+	return nil
+}
+
+// copyArchive copies the files of the archive into dir without recompressing them.
+func (a *exportArchive) copyArchive(dir string, r *zip.Reader) error {
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		// the archive is uploaded by another service, but it still should not write outside of its directory
+		if !fs.ValidPath(f.Name) {
+			return errors.Errorf("invalid file name %q", f.Name)
+		}
+
+		header := f.FileHeader
+		header.Name = path.Join(dir, f.Name)
+
+		w, err := a.zw.CreateRaw(&header)
+		if err != nil {
+			return errors.Errorf("create %s: %w", header.Name, err)
+		}
+
+		rc, err := f.OpenRaw()
+		if err != nil {
+			return errors.Errorf("open %s: %w", f.Name, err)
+		}
+
+		if _, err = io.Copy(w, rc); err != nil {
+			return errors.Errorf("copy %s: %w", f.Name, err)
+		}
+
+		a.files = append(a.files, header.Name)
+	}
+
+	return nil
+}
+
+// buildArchive writes the data of auth-service and the parts of other services to the archive
+// and uploads it to the exports bucket. The archive is built in a temporary file, as it may contain
+// a lot of media.
+func (s DataExportsService) buildArchive(
+	ctx context.Context, export models.DataExport, user *models.User, parts []models.DataExportPart,
+) (string, error) {
+	file, err := os.CreateTemp("", "export-*.zip")
+	if err != nil {
+		return "", errors.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	archive := &exportArchive{zw: zip.NewWriter(file)}
+
+	manifest := exportManifest{
+		ExportID:    export.ID,
+		UserID:      export.UserID,
+		RequestedAt: export.RequestedAt,
+		GeneratedAt: time.Now(),
+	}
+
+	if err = s.writeAuthData(ctx, archive, user); err != nil {
+		return "", err
+	}
+	manifest.Services = append(manifest.Services, exportManifestSource{
+		Service:     models.AuthServiceName,
+		CompletedAt: manifest.GeneratedAt,
+		Files:       archive.files,
+	})
+
+	for _, part := range parts {
+		archive.files = nil
+
+		if err = s.writePart(ctx, archive, part); err != nil {
+			return "", errors.Errorf("write part of %s: %w", part.Service, err)
+		}
+
+		manifest.Services = append(manifest.Services, exportManifestSource{
+			Service:     part.Service,
+			CompletedAt: part.CompletedAt,
+			Files:       archive.files,
+		})
+	}
+
+	if err = archive.writeJSON("manifest.json", manifest); err != nil {
+		return "", err
+	}
+
+	if err = archive.zw.Close(); err != nil {
+		return "", errors.Errorf("close archive: %w", err)
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", errors.Errorf("get archive size: %w", err)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Errorf("rewind archive: %w", err)
+	}
+
+	key := path.Join("exports", export.ID.String(), "export.zip")
+
+	if err = s.exportStorage.PutExportArchive(ctx, key, file, size); err != nil {
+		return "", errors.Errorf("upload export archive: %w", err)
+	}
+
+	return key, nil
+}
+
+func (s DataExportsService) writeAuthData(ctx context.Context, archive *exportArchive, user *models.User) error {
+	dir := models.AuthServiceName
+
+	err := archive.writeJSON(path.Join(dir, "user.json"), exportUser{
+		ID:               user.ID,
+		Email:            user.Email,
+		Username:         user.Username,
+		Name:             user.Name,
+		Description:      user.Description,
+		AvatarURL:        user.AvatarURL,
+		AvatarVariants:   user.AvatarVariants,
+		IsAdmin:          user.IsAdmin,
+		Locale:           user.Locale,
+		NotifyNewDevices: user.NotifyNewDevices,
+		CreatedAt:        user.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	sessions, err := s.authService.sessionRepository.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		return errors.Errorf("get user sessions: %w", err)
+	}
+
+	// tokens of the sessions are never exported
+	exported := make([]exportSession, len(sessions))
+	for i, session := range sessions {
+		exported[i] = exportSession{
+			ID:          session.ID,
+			DeviceName:  session.Client.DeviceName,
+			UserAgent:   session.Client.UserAgent,
+			IP:          session.Client.IP,
+			Location:    session.Client.Location,
+			CreatedAt:   session.CreatedAt,
+			RefreshedAt: session.RefreshedAt,
+			ExpiresAt:   session.ExpiresAt,
+		}
+	}
+
+	if err = archive.writeJSON(path.Join(dir, "sessions.json"), exported); err != nil {
+		return err
+	}
+
+	var events []exportSecurityEvent
+	for offset := 0; ; offset += exportSecurityEventsPageSize {
+		page, err := getSecurityEvents(ctx, s.authService.securityEventsRepository, dto.SecurityEventsFilter{
+			UserID: &user.ID,
+			Limit:  exportSecurityEventsPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, event := range page {
+			events = append(events, exportSecurityEvent{
+				Type:      event.Type,
+				Outcome:   event.Outcome,
+				IP:        event.IP,
+				UserAgent: event.UserAgent,
+				SessionID: event.SessionID,
+				Metadata:  event.Metadata,
+				CreatedAt: event.CreatedAt,
+			})
+		}
+
+		if len(page) < exportSecurityEventsPageSize {
+			break
+		}
+	}
+
+	return archive.writeJSON(path.Join(dir, "security_events.json"), events)
+}
+
+// writePart copies the archive uploaded by the service or writes the data sent in the event.
+func (s DataExportsService) writePart(ctx context.Context, archive *exportArchive, part models.DataExportPart) error {
+	if part.ArchiveKey == nil {
+		if len(part.Data) == 0 {
+			return nil
+		}
+
+		return archive.writeJSON(path.Join(part.Service, "data.json"), part.Data)
+	}
+
+	file, err := os.CreateTemp("", "export-part-*.zip")
+	if err != nil {
+		return errors.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	body, err := s.exportStorage.GetExportObject(ctx, *part.ArchiveKey)
+	if err != nil {
+		return errors.Errorf("get part archive: %w", err)
+	}
+	defer body.Close()
+
+	size, err := io.Copy(file, body)
+	if err != nil {
+		return errors.Errorf("download part archive: %w", err)
+	}
+
+	r, err := zip.NewReader(file, size)
+	if err != nil {
+		return errors.Errorf("open part archive: %w", err)
+	}
+
+	return archive.copyArchive(part.Service, r)
+}
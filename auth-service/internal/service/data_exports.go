@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+// DataExportsService builds the archives with all data of the user. Other services collect their data
+// when they receive users.<id>.export_requested event and report back with their parts, then the archive
+// is built and the link to download it is sent to the user.
+type DataExportsService struct {
+	logger      *logger.Logger
+	authService *AuthService
+
+	exportsRepository DataExportsRepository
+	exportStorage     DataExportStorage
+
+	// services must send their parts before the archive is built.
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+	ttl      time.Duration
+}
+
+func NewDataExportsService(
+	log *logger.Logger,
+	cfg *config.Config,
+	authService *AuthService,
+	exportsRepository DataExportsRepository,
+	exportStorage DataExportStorage,
+) *DataExportsService {
+	return &DataExportsService{
+		logger:            log,
+		authService:       authService,
+		exportsRepository: exportsRepository,
+		exportStorage:     exportStorage,
+		services:          cfg.DataExports.Services,
+		interval:          cfg.DataExports.Interval,
+		timeout:           cfg.DataExports.Timeout,
+		ttl:               cfg.DataExports.TTL,
+	}
+}
+
+// RequestDataExport starts the export, the user can request one export per interval.
+func (s DataExportsService) RequestDataExport(
+	ctx context.Context, userID uuid.UUID, client models.ClientInfo,
+) (*models.DataExport, error) {
+	now := time.Now()
+
+	export := models.DataExport{
+		ID:          uuid.Must(uuid.NewV7()),
+		UserID:      userID,
+		Status:      models.DataExportPending,
+		RequestedAt: now,
+	}
+
+	if err := s.exportsRepository.CreateDataExport(ctx, export, now.Add(-s.interval)); err != nil {
+		return nil, err
+	}
+
+	s.authService.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:   &userID,
+		Type:     models.SecurityEventDataExportRequested,
+		Outcome:  models.SecurityEventSuccess,
+		Metadata: map[string]string{"export_id": export.ID.String()},
+	})
+
+	s.logger.Info("data export requested",
+		slog.String("user_id", userID.String()),
+		slog.String("export_id", export.ID.String()),
+	)
+
+	return &export, nil
+}
+
+// GetDataExport returns the latest export of the user, the download link is presigned on every call.
+func (s DataExportsService) GetDataExport(ctx context.Context, userID uuid.UUID) (*dto.DataExportOutput, error) {
+	export, err := s.exportsRepository.GetLatestDataExport(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get latest data export: %w", err)
+	}
+
+	output := &dto.DataExportOutput{Export: export}
+
+	switch export.Status {
+	case models.DataExportPending:
+		parts, err := s.exportsRepository.GetDataExportParts(ctx, export.ID)
+		if err != nil {
+			return nil, errors.Errorf("get data export parts: %w", err)
+		}
+
+		for _, service := range s.services {
+			if !slices.ContainsFunc(parts, func(part models.DataExportPart) bool { return part.Service == service }) {
+				output.PendingServices = append(output.PendingServices, service)
+			}
+		}
+	case models.DataExportReady:
+		expiresIn := time.Until(*export.ExpiresAt)
+		if expiresIn <= 0 {
+			// the archive is about to be deleted by the worker
+			export.Status = models.DataExportExpired
+			break
+		}
+
+		output.DownloadURL, err = s.exportStorage.PresignExportArchive(ctx, *export.ArchiveKey, expiresIn)
+		if err != nil {
+			return nil, errors.Errorf("presign export archive: %w", err)
+		}
+	}
+
+	return output, nil
+}
+
+// CompleteDataExportPart records the part sent by the service.
+func (s DataExportsService) CompleteDataExportPart(ctx context.Context, event models.DataExportPartCompletedEvent) error {
+	if !slices.Contains(s.services, event.Service) {
+		s.logger.Warn("data export part sent by unexpected service", slog.String("service", event.Service))
+	}
+
+	err := s.exportsRepository.CreateDataExportPart(ctx, event.ExportID, models.DataExportPart{
+		Service:     event.Service,
+		ArchiveKey:  event.ArchiveKey,
+		Data:        event.Data,
+		CompletedAt: event.CompletedAt,
+	})
+	if err != nil {
+		return errors.Errorf("create data export part: %w", err)
+	}
+
+	return nil
+}
+
+// ProcessDueDataExports builds the archives of the exports with all parts sent
+// and fails the exports whose parts have not been sent in time.
+func (s DataExportsService) ProcessDueDataExports(ctx context.Context) (processed int, err error) {
+	for {
+		found, err := s.exportsRepository.ProcessNextDueDataExport(ctx, time.Now().Add(-s.timeout), s.services, s.processDataExport)
+		if err != nil {
+			return processed, errors.Errorf("process data export: %w", err)
+		}
+		if !found {
+			return processed, nil
+		}
+
+		processed++
+	}
+}
+
+// ExpireDataExports deletes the archives whose links have expired.
+func (s DataExportsService) ExpireDataExports(ctx context.Context) (expired int, err error) {
+	for {
+		found, err := s.exportsRepository.ExpireNextDataExport(ctx, time.Now(), s.expireDataExport)
+		if err != nil {
+			return expired, errors.Errorf("expire data export: %w", err)
+		}
+		if !found {
+			return expired, nil
+		}
+
+		expired++
+	}
+}
+
+func (s DataExportsService) processDataExport(
+	ctx context.Context, export models.DataExport, parts []models.DataExportPart,
+) (*models.DataExport, error) {
+	for _, service := range s.services {
+		if !slices.ContainsFunc(parts, func(part models.DataExportPart) bool { return part.Service == service }) {
+			s.logger.Warn("data export timed out",
+				slog.String("export_id", export.ID.String()),
+				slog.String("pending_service", service),
+			)
+
+			return s.failDataExport(ctx, export, parts), nil
+		}
+	}
+
+	user, err := s.authService.userRepository.GetUserByID(ctx, export.UserID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	archiveKey, err := s.buildArchive(ctx, export, user, parts)
+	if err != nil {
+		// retried by the next run until the export times out
+		if time.Since(export.RequestedAt) < s.timeout {
+			return nil, err
+		}
+
+		s.logger.Error("build data export archive", slog.String("export_id", export.ID.String()), logger.Error(err))
+		return s.failDataExport(ctx, export, parts), nil
+	}
+
+	expiresAt := time.Now().Add(s.ttl)
+
+	link, err := s.exportStorage.PresignExportArchive(ctx, archiveKey, s.ttl)
+	if err != nil {
+		return nil, errors.Errorf("presign export archive: %w", err)
+	}
+
+	if err = s.authService.mailClient.SendMail(ctx, user.Email, user.Locale, mail.DataExportReady(link, expiresAt)); err != nil {
+		return nil, errors.Errorf("send data export ready mail: %w", err)
+	}
+
+	s.logger.Info("data export ready",
+		slog.String("user_id", export.UserID.String()),
+		slog.String("export_id", export.ID.String()),
+	)
+
+	s.deletePartArchives(ctx, parts)
+
+	export.Status = models.DataExportReady
+	export.ArchiveKey = &archiveKey
+	export.ExpiresAt = &expiresAt
+
+	return &export, nil
+}
+
+func (s DataExportsService) failDataExport(
+	ctx context.Context, export models.DataExport, parts []models.DataExportPart,
+) *models.DataExport {
+	s.deletePartArchives(ctx, parts)
+
+	export.Status = models.DataExportFailed
+	return &export
+}
+
+func (s DataExportsService) expireDataExport(ctx context.Context, export models.DataExport) error {
+	if export.ArchiveKey == nil {
+		return nil
+	}
+
+	if err := s.exportStorage.DeleteExportObject(ctx, *export.ArchiveKey); err != nil {
+		return errors.Errorf("delete export archive: %w", err)
+	}
+
+	return nil
+}
+
+// deletePartArchives deletes the archives uploaded by the services, they are not needed after
+// the export is finished. Errors are only logged, the bucket should expire the objects left by a lifecycle rule.
+func (s DataExportsService) deletePartArchives(ctx context.Context, parts []models.DataExportPart) {
+	for _, part := range parts {
+		if part.ArchiveKey == nil {
+			continue
+		}
+
+		if err := s.exportStorage.DeleteExportObject(ctx, *part.ArchiveKey); err != nil {
+			s.logger.Error("delete data export part archive",
+				slog.String("key", *part.ArchiveKey),
+				logger.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+type AdminUserOutput struct {
+	User   *models.User
+	Status models.UserStatus
+	// Suspension is nil if the user is active.
+	Suspension *models.UserSuspension
+	Sessions   []models.Session
+}
+
+type SuspendUserInput struct {
+	UserID uuid.UUID
+	Reason string
+	Until  time.Time
+}
+
+type AccountDeletionOutput struct {
+	Deletion *models.AccountDeletion
+	// PendingServices have not deleted the user data yet.
+	PendingServices []string
+}
+
+// Completed reports whether the user data is deleted in every service.
+func (o AccountDeletionOutput) Completed() bool {
+	return !o.Deletion.Pending() && len(o.PendingServices) == 0
+}
+
+type SecurityEventsFilter struct {
+	// UserID is nil to search the events of all users.
+	UserID *uuid.UUID
+	// Types is empty to search the events of any type.
+	Types []models.SecurityEventType
+	From  *time.Time
+	To    *time.Time
+
+	Limit  int
+	Offset int
+}
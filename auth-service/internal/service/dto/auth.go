@@ -25,9 +25,30 @@ type CreateUserParams struct {
 	Username     string
 	PasswordHash []byte
 	Description  string
+	Locale       string
+	// Identity is linked to the user in the same transaction, if set.
+	Identity *models.UserIdentity
 }
 
 type LoginOutput struct {
 	User    *models.User
 	Session *models.Session
+
+	// MFAChallenge is set instead of Session when the user has to pass the second factor check.
+	MFAChallenge *models.MFAChallenge
+}
+
+type SessionOutput struct {
+	models.Session
+
+	// Current is true for the session the request was made with.
+	Current bool
+}
+
+type OIDCLoginOutput struct {
+	// LoginOutput is nil when the email of the provider account is used by another user,
+	// then the link to confirm linking of the accounts is sent to Email.
+	LoginOutput              *LoginOutput
+	LinkConfirmationRequired bool
+	Email                    string
 }
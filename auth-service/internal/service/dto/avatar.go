@@ -5,8 +5,24 @@ import (
 )
 
 type UploadUserAvatar struct {
-	Data        []byte
+	Data   []byte
+	UserID uuid.UUID
+	// Crop is the part of the auto-oriented image chosen by the user,
+	// the image is cropped to the center square if it is not set.
+	Crop *AvatarCrop
+}
+
+type AvatarCrop struct {
+	X, Y          int
+	Width, Height int
+}
+
+// AvatarVariantUpload is a processed avatar variant, the objects of each upload are
+// stored under the new version, so they can be cached forever.
+type AvatarVariantUpload struct {
 	UserID      uuid.UUID
-	ImageSize   int64
+	Version     string
+	Size        int
+	Data        []byte
 	ContentType string
 }
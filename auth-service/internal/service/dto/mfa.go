@@ -0,0 +1,13 @@
+package dto
+
+type TOTPEnrollmentOutput struct {
+	// Secret is base32 encoded secret for manual entry.
+	Secret string
+	// ProvisioningURI is otpauth:// URI to be shown as a QR code.
+	ProvisioningURI string
+}
+
+type RecoveryCodesOutput struct {
+	// Codes are shown to the user only once, only their hashes are stored.
+	Codes []string
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+)
+
+type CreatePersonalTokenInput struct {
+	Name   string
+	Scopes []string
+	// ExpiresAt is required, tokens without expiration are not allowed.
+	ExpiresAt time.Time
+}
+
+type CreatePersonalTokenOutput struct {
+	PersonalToken models.PersonalToken
+	// Token is returned only once, it can not be recovered later.
+	Token string
+}
@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/tech-inspire/backend/auth-service/internal/models"
 )
 
@@ -12,10 +15,18 @@ type GetCurrentUser struct {
 	GetUserByIDOutput
 }
 
+type GetUserByUsernameOutput struct {
+	GetUserByIDOutput
+	// Renamed is true if the user was found by the previous username, links should use the current one.
+	Renamed bool
+}
+
 type GetUsersParams struct {
 	UsernamePattern *string
+	EmailPattern    *string
 	IDPattern       *string // '123' matches '123456'
-	IsBot           *bool
+	IsAdmin         *bool
+	Status          *models.UserStatus
 
 	Offset int
 	Limit  int
@@ -31,9 +42,21 @@ type GetUsersOutput struct {
 
 type UpdateUsersInput struct {
 	Name        *string
-	Password    *string
 	Username    *string
 	Description *string
+	// Locale is a BCP 47 tag, empty resets it to the default one.
+	Locale *string
+
+	NotifyNewDevices *bool
+}
+
+type ChangePasswordInput struct {
+	CurrentPassword string
+	NewPassword     string
+
+	// RevokeOtherSessions ends all sessions except CurrentSessionID.
+	RevokeOtherSessions bool
+	CurrentSessionID    uuid.UUID
 }
 
 type UpdateUsersParams struct {
@@ -42,4 +65,46 @@ type UpdateUsersParams struct {
 	Username    *string
 	Description *string
 	AvatarUrl   *string
+	Email       *string
+	// Locale is reset to the default one if empty.
+	Locale *string
+
+	// UsernameReleasedAt is required with Username, the previous username is kept in the history until then.
+	UsernameReleasedAt time.Time
+
+	// AvatarVariants are replaced if not nil, AvatarUrl must be set with them.
+	AvatarVariants []models.AvatarVariant
+
+	NotifyNewDevices *bool
+}
+
+type DataExportOutput struct {
+	Export *models.DataExport
+	// DownloadURL is set for ready exports.
+	DownloadURL string
+	// PendingServices have not sent their parts of the pending export yet.
+	PendingServices []string
+}
+
+// FollowsCursor points to the last follow of the previous page, the follows are listed from the newest.
+type FollowsCursor struct {
+	CreatedAt time.Time
+	UserID    uuid.UUID
+}
+
+type FollowsPage struct {
+	Limit int
+	// Cursor is NextCursor of the previous page, empty for the first page.
+	Cursor string
+}
+
+type FollowOutput struct {
+	User       *models.User
+	FollowedAt time.Time
+}
+
+type FollowsOutput struct {
+	Users []FollowOutput
+	// NextCursor is empty on the last page.
+	NextCursor string
 }
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+)
+
+const (
+	defaultFollowsPageSize = 20
+	maxFollowsPageSize     = 100
+)
+
+// FollowsService manages the follow graph between the users. The follower and following counters
+// of the users are updated with the follows, and every change is published as the follower's event.
+type FollowsService struct {
+	authService         *AuthService
+	followsRepository   FollowsRepository
+	deletionsRepository AccountDeletionsRepository
+}
+
+func NewFollowsService(
+	authService *AuthService, followsRepository FollowsRepository, deletionsRepository AccountDeletionsRepository,
+) *FollowsService {
+	return &FollowsService{
+		authService:         authService,
+		followsRepository:   followsRepository,
+		deletionsRepository: deletionsRepository,
+	}
+}
+
+// Follow is idempotent, following the user again does not change anything.
+func (s FollowsService) Follow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	if followerID == followeeID {
+		return apperrors.ErrSelfFollow
+	}
+
+	if _, err := s.authService.userRepository.GetUserByID(ctx, followeeID); err != nil {
+		return errors.Errorf("get followee: %w", err)
+	}
+
+	if err := s.checkFollowable(ctx, followeeID); err != nil {
+		return err
+	}
+
+	if _, err := s.followsRepository.CreateFollow(ctx, followerID, followeeID, time.Now()); err != nil {
+		return errors.Errorf("create follow: %w", err)
+	}
+
+	return nil
+}
+
+// checkFollowable refuses the suspended and banned users and the users who have requested the deletion
+// of their account. The existing follows of these users are kept.
+func (s FollowsService) checkFollowable(ctx context.Context, userID uuid.UUID) error {
+	suspension, err := s.authService.getUserSuspension(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if suspension != nil {
+		return apperrors.ErrUserNotFollowable
+	}
+
+	deletion, err := s.deletionsRepository.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrAccountDeletionNotFound) {
+			return nil
+		}
+		return errors.Errorf("get account deletion: %w", err)
+	}
+	if deletion.Pending() {
+		return apperrors.ErrUserNotFollowable
+	}
+
+	return nil
+}
+
+// Unfollow is idempotent, unfollowing the user who is not followed does not change anything.
+func (s FollowsService) Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	if followerID == followeeID {
+		return apperrors.ErrSelfFollow
+	}
+
+	if _, err := s.followsRepository.DeleteFollow(ctx, followerID, followeeID, time.Now()); err != nil {
+		return errors.Errorf("delete follow: %w", err)
+	}
+
+	return nil
+}
+
+// GetFollowStatus returns whether the user follows the other user and is followed back,
+// both are true for the mutual follows.
+func (s FollowsService) GetFollowStatus(ctx context.Context, userID, otherID uuid.UUID) (models.FollowStatus, error) {
+	if userID == otherID {
+		return models.FollowStatus{}, nil
+	}
+
+	status, err := s.followsRepository.GetFollowStatus(ctx, userID, otherID)
+	if err != nil {
+		return models.FollowStatus{}, errors.Errorf("get follow status: %w", err)
+	}
+
+	return status, nil
+}
+
+// GetFollowers returns the users following the user, the latest followers first.
+func (s FollowsService) GetFollowers(ctx context.Context, userID uuid.UUID, page dto.FollowsPage) (*dto.FollowsOutput, error) {
+	return s.getFollowsPage(ctx, page, func(cursor *dto.FollowsCursor, limit int) ([]models.Follow, error) {
+		return s.followsRepository.GetFollowers(ctx, userID, cursor, limit)
+	}, func(follow models.Follow) uuid.UUID {
+		return follow.FollowerID
+	})
+}
+
+// GetFollowing returns the users followed by the user, the latest followed first.
+func (s FollowsService) GetFollowing(ctx context.Context, userID uuid.UUID, page dto.FollowsPage) (*dto.FollowsOutput, error) {
+	return s.getFollowsPage(ctx, page, func(cursor *dto.FollowsCursor, limit int) ([]models.Follow, error) {
+		return s.followsRepository.GetFollowing(ctx, userID, cursor, limit)
+	}, func(follow models.Follow) uuid.UUID {
+		return follow.FolloweeID
+	})
+}
+
+// getFollowsPage loads one more follow than requested to find out whether there is the next page.
+// otherUserID picks the listed user of the follow.
+func (s FollowsService) getFollowsPage(
+	ctx context.Context,
+	page dto.FollowsPage,
+	getFollows func(cursor *dto.FollowsCursor, limit int) ([]models.Follow, error),
+	otherUserID func(follow models.Follow) uuid.UUID,
+) (*dto.FollowsOutput, error) {
+	limit := page.Limit
+	if limit <= 0 {
+		limit = defaultFollowsPageSize
+	}
+	limit = min(limit, maxFollowsPageSize)
+
+	var cursor *dto.FollowsCursor
+	if page.Cursor != "" {
+		decoded, err := decodeFollowsCursor(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = decoded
+	}
+
+	follows, err := getFollows(cursor, limit+1)
+	if err != nil {
+		return nil, errors.Errorf("get follows: %w", err)
+	}
+
+	var output dto.FollowsOutput
+	if len(follows) > limit {
+		follows = follows[:limit]
+		last := follows[len(follows)-1]
+		output.NextCursor = encodeFollowsCursor(dto.FollowsCursor{
+			CreatedAt: last.CreatedAt,
+			UserID:    otherUserID(last),
+		})
+	}
+
+	userIDs := make([]uuid.UUID, len(follows))
+	for i, follow := range follows {
+		userIDs[i] = otherUserID(follow)
+	}
+
+	users, err := s.authService.userRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, errors.Errorf("get users by ids: %w", err)
+	}
+
+	usersByID := make(map[uuid.UUID]*models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	output.Users = make([]dto.FollowOutput, 0, len(follows))
+	for _, follow := range follows {
+		user, ok := usersByID[otherUserID(follow)]
+		if !ok {
+			// the account has been purged after the page was loaded
+			continue
+		}
+
+		output.Users = append(output.Users, dto.FollowOutput{
+			User:       user,
+			FollowedAt: follow.CreatedAt,
+		})
+	}
+
+	return &output, nil
+}
+
+// encodeFollowsCursor returns the opaque cursor of the "<unix nanoseconds>_<user id>" form.
+func encodeFollowsCursor(cursor dto.FollowsCursor) string {
+	value := strconv.FormatInt(cursor.CreatedAt.UnixNano(), 10) + "_" + cursor.UserID.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+func decodeFollowsCursor(value string) (*dto.FollowsCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	createdAt, userID, ok := strings.Cut(string(data), "_")
+	if !ok {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	nanos, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.ErrInvalidCursor
+	}
+
+	return &dto.FollowsCursor{
+		CreatedAt: time.Unix(0, nanos).UTC(),
+		UserID:    id,
+	}, nil
+}
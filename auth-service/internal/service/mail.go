@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+// MailService queues mails, so that requests are not blocked by the mail server and failed
+// deliveries are retried. Mails are delivered in background by DeliverQueuedMails.
+type MailService struct {
+	logger    *logger.Logger
+	queue     MailQueueRepository
+	transport MailTransport
+	renderer  *mail.Renderer
+
+	batchSize      int
+	sendTimeout    time.Duration
+	maxAttempts    int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+}
+
+func NewMailService(
+	log *logger.Logger,
+	cfg *config.Config,
+	queue MailQueueRepository,
+	transport MailTransport,
+	renderer *mail.Renderer,
+) *MailService {
+	return &MailService{
+		logger:         log,
+		queue:          queue,
+		transport:      transport,
+		renderer:       renderer,
+		batchSize:      cfg.Mail.DeliveryBatchSize,
+		sendTimeout:    cfg.Mail.SendTimeout,
+		maxAttempts:    cfg.Mail.MaxAttempts,
+		retryBaseDelay: cfg.Mail.RetryBaseDelay,
+		retryMaxDelay:  cfg.Mail.RetryMaxDelay,
+	}
+}
+
+// SendMail renders the template in the locale of the recipient before queueing it,
+// so that template errors are returned to the caller instead of failing the delivery.
+func (s MailService) SendMail(ctx context.Context, to, locale string, template mail.Template) error {
+	message, err := s.renderer.Render(locale, template)
+	if err != nil {
+		return errors.Errorf("render mail: %w", err)
+	}
+
+	err = s.queue.EnqueueMail(ctx, models.QueuedMail{
+		To:      to,
+		Subject: message.Subject,
+		Text:    message.Text,
+		HTML:    message.HTML,
+	})
+	if err != nil {
+		return errors.Errorf("enqueue mail: %w", err)
+	}
+
+	return nil
+}
+
+// DeliverQueuedMails sends due mails until the queue has none left.
+func (s MailService) DeliverQueuedMails(ctx context.Context) (delivered int, err error) {
+	for {
+		now := time.Now()
+
+		// the lease is renewed before sending every mail, so a slow mail does not release the rest of the batch
+		mails, err := s.queue.ClaimDueMails(ctx, now, now.Add(s.sendTimeout), s.batchSize)
+		if err != nil {
+			return delivered, errors.Errorf("claim due mails: %w", err)
+		}
+
+		for _, queued := range mails {
+			ok, err := s.deliver(ctx, queued)
+			if err != nil {
+				return delivered, err
+			}
+			if ok {
+				delivered++
+			}
+		}
+
+		if len(mails) < s.batchSize {
+			return delivered, nil
+		}
+	}
+}
+
+// deliver returns false if the mail was not sent, it is retried later or dead-lettered.
+func (s MailService) deliver(ctx context.Context, queued models.QueuedMail) (bool, error) {
+	renewed, err := s.queue.RenewMailLease(ctx, queued, time.Now().Add(s.sendTimeout))
+	if err != nil {
+		return false, errors.Errorf("renew mail lease: %w", err)
+	}
+	if !renewed {
+		// sent by the replica that claimed it after the lease expired
+		return false, nil
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, s.sendTimeout)
+	defer cancel()
+
+	sendErr := s.transport.Send(sendCtx, queued.To, mail.Message{
+		Subject: queued.Subject,
+		Text:    queued.Text,
+		HTML:    queued.HTML,
+	})
+	if sendErr == nil {
+		if err := s.queue.DeleteMail(ctx, queued.ID); err != nil {
+			return true, errors.Errorf("delete delivered mail: %w", err)
+		}
+
+		return true, nil
+	}
+
+	attempts := queued.Attempts + 1
+	if attempts >= s.maxAttempts {
+		s.logger.Error("mail dead-lettered",
+			slog.Int64("mail_id", queued.ID),
+			slog.Int("attempts", attempts),
+			logger.Error(sendErr),
+		)
+
+		if err := s.queue.DeadLetterMail(ctx, queued.ID, sendErr.Error()); err != nil {
+			return false, errors.Errorf("dead-letter mail: %w", err)
+		}
+
+		return false, nil
+	}
+
+	s.logger.Warn("mail delivery failed",
+		slog.Int64("mail_id", queued.ID),
+		slog.Int("attempts", attempts),
+		logger.Error(sendErr),
+	)
+
+	if err := s.queue.RetryMail(ctx, queued.ID, time.Now().Add(s.retryDelay(attempts)), sendErr.Error()); err != nil {
+		return false, errors.Errorf("retry mail: %w", err)
+	}
+
+	return false, nil
+}
+
+// retryDelay doubles with every failed attempt.
+func (s MailService) retryDelay(attempts int) time.Duration {
+	const maxShift = 30 // prevents overflow, max delay is reached much earlier
+
+	delay := s.retryBaseDelay << min(attempts-1, maxShift)
+	return min(delay, s.retryMaxDelay)
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+// memoryMailQueue behaves like mail_queue: claimed mails are skipped until the lease expires.
+type memoryMailQueue struct {
+	mu     sync.Mutex
+	lastID int64
+	mails  map[int64]*models.QueuedMail
+	dead   map[int64]*models.QueuedMail
+}
+
+func newMemoryMailQueue() *memoryMailQueue {
+	return &memoryMailQueue{
+		mails: make(map[int64]*models.QueuedMail),
+		dead:  make(map[int64]*models.QueuedMail),
+	}
+}
+
+func (q *memoryMailQueue) EnqueueMail(_ context.Context, mail models.QueuedMail) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.lastID++
+	mail.ID = q.lastID
+	q.mails[mail.ID] = &mail
+
+	return nil
+}
+
+func (q *memoryMailQueue) ClaimDueMails(_ context.Context, now, leaseUntil time.Time, limit int) ([]models.QueuedMail, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var claimed []models.QueuedMail
+	for id := int64(1); id <= q.lastID && len(claimed) < limit; id++ {
+		mail, ok := q.mails[id]
+		if !ok || mail.ClaimedUntil.After(now) {
+			continue
+		}
+
+		mail.ClaimedUntil = leaseUntil
+		claimed = append(claimed, *mail)
+	}
+
+	return claimed, nil
+}
+
+func (q *memoryMailQueue) RenewMailLease(_ context.Context, mail models.QueuedMail, leaseUntil time.Time) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stored, ok := q.mails[mail.ID]
+	if !ok || !stored.ClaimedUntil.Equal(mail.ClaimedUntil) {
+		return false, nil
+	}
+
+	stored.ClaimedUntil = leaseUntil
+
+	return true, nil
+}
+
+func (q *memoryMailQueue) DeleteMail(_ context.Context, id int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.mails, id)
+
+	return nil
+}
+
+func (q *memoryMailQueue) RetryMail(_ context.Context, id int64, nextAttemptAt time.Time, _ string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.mails[id].Attempts++
+	q.mails[id].ClaimedUntil = nextAttemptAt
+
+	return nil
+}
+
+func (q *memoryMailQueue) DeadLetterMail(_ context.Context, id int64, _ string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	mail := q.mails[id]
+	mail.Attempts++
+	mail.Text, mail.HTML = "", ""
+
+	q.dead[id] = mail
+	delete(q.mails, id)
+
+	return nil
+}
+
+// failingTransport fails the given number of sends and delivers the rest to the mailbox.
+type failingTransport struct {
+	*mail.MemoryTransport
+	failures int
+}
+
+func (t *failingTransport) Send(ctx context.Context, to string, message mail.Message) error {
+	if t.failures > 0 {
+		t.failures--
+		return errors.New("connection refused")
+	}
+
+	return t.MemoryTransport.Send(ctx, to, message)
+}
+
+func newTestMailService(t *testing.T, queue MailQueueRepository, transport MailTransport) *MailService {
+	t.Helper()
+
+	renderer, err := mail.NewRenderer("en")
+	if err != nil {
+		t.Fatalf("create renderer: %v", err)
+	}
+
+	return &MailService{
+		logger:         &logger.Logger{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))},
+		queue:          queue,
+		transport:      transport,
+		renderer:       renderer,
+		batchSize:      2,
+		sendTimeout:    time.Minute,
+		maxAttempts:    3,
+		retryBaseDelay: 0,
+		retryMaxDelay:  0,
+	}
+}
+
+func TestDeliverQueuedMailsSendsRenderedMail(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		queue   = newMemoryMailQueue()
+		mailbox = mail.NewMemoryTransport()
+		svc     = newTestMailService(t, queue, mailbox)
+	)
+
+	recipients := []string{"first@example.com", "second@example.com", "third@example.com"}
+	for _, to := range recipients {
+		if err := svc.SendMail(ctx, to, "en", mail.ConfirmEmail("481516", "https://example.com/confirm")); err != nil {
+			t.Fatalf("send mail: %v", err)
+		}
+	}
+
+	if messages := mailbox.Messages(recipients[0]); len(messages) != 0 {
+		t.Fatalf("got %d messages before delivery, want 0", len(messages))
+	}
+
+	delivered, err := svc.DeliverQueuedMails(ctx)
+	if err != nil {
+		t.Fatalf("deliver mails: %v", err)
+	}
+	if delivered != len(recipients) {
+		t.Fatalf("got %d delivered mails, want %d", delivered, len(recipients))
+	}
+
+	for _, to := range recipients {
+		messages := mailbox.Messages(to)
+		if len(messages) != 1 {
+			t.Fatalf("got %d messages for %s, want 1", len(messages), to)
+		}
+		if !strings.Contains(messages[0].Text, "481516") || !strings.Contains(messages[0].HTML, "481516") {
+			t.Errorf("message for %s does not contain the code", to)
+		}
+	}
+
+	if len(queue.mails) != 0 {
+		t.Errorf("got %d mails left in the queue, want 0", len(queue.mails))
+	}
+}
+
+func TestDeliverQueuedMailsRetriesAndDeadLetters(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		wantSent bool
+	}{
+		{name: "delivered after retries", failures: 2, wantSent: true},
+		{name: "dead-lettered", failures: 3, wantSent: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				queue     = newMemoryMailQueue()
+				transport = &failingTransport{MemoryTransport: mail.NewMemoryTransport(), failures: tt.failures}
+				svc       = newTestMailService(t, queue, transport)
+				to        = "user@example.com"
+			)
+
+			if err := svc.SendMail(ctx, to, "en", mail.ResetPassword("271828", "https://example.com/reset")); err != nil {
+				t.Fatalf("send mail: %v", err)
+			}
+
+			for range svc.maxAttempts {
+				if _, err := svc.DeliverQueuedMails(ctx); err != nil {
+					t.Fatalf("deliver mails: %v", err)
+				}
+			}
+
+			_, sent := transport.LastMessage(to)
+			if sent != tt.wantSent {
+				t.Fatalf("got sent %v, want %v", sent, tt.wantSent)
+			}
+
+			if tt.wantSent {
+				return
+			}
+
+			if len(queue.dead) != 1 {
+				t.Fatalf("got %d dead mails, want 1", len(queue.dead))
+			}
+			for _, dead := range queue.dead {
+				if dead.Attempts != svc.maxAttempts {
+					t.Errorf("got %d attempts, want %d", dead.Attempts, svc.maxAttempts)
+				}
+			}
+		})
+	}
+}
+
+func TestDeliverQueuedMailsSkipsMailsClaimedByOtherReplica(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		queue   = newMemoryMailQueue()
+		mailbox = mail.NewMemoryTransport()
+		svc     = newTestMailService(t, queue, mailbox)
+		to      = "user@example.com"
+	)
+
+	if err := svc.SendMail(ctx, to, "en", mail.ChangeEmail("314159")); err != nil {
+		t.Fatalf("send mail: %v", err)
+	}
+
+	claimed, err := queue.ClaimDueMails(ctx, time.Now(), time.Now().Add(-time.Second), 1)
+	if err != nil {
+		t.Fatalf("claim mails: %v", err)
+	}
+
+	// the lease has expired and another replica claims the mail
+	if _, err = queue.ClaimDueMails(ctx, time.Now(), time.Now().Add(time.Minute), 1); err != nil {
+		t.Fatalf("claim mails: %v", err)
+	}
+
+	sent, err := svc.deliver(ctx, claimed[0])
+	if err != nil {
+		t.Fatalf("deliver mail: %v", err)
+	}
+	if sent {
+		t.Fatal("mail claimed by another replica was sent")
+	}
+	if messages := mailbox.Messages(to); len(messages) != 0 {
+		t.Fatalf("got %d messages, want 0", len(messages))
+	}
+}
@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/generator"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+const (
+	oidcStateLength = 32
+	oidcNonceLength = 32
+	// PKCE code verifier must be from 43 to 128 characters, see RFC 7636, section 4.1.
+	oidcCodeVerifierLength = 64
+
+	maxUsernameLength      = 30
+	usernameSuffixLength   = 4
+	maxUsernameGenerations = 5
+	fallbackUsername       = "user"
+)
+
+// OIDCService signs users in with the accounts of OpenID Connect providers.
+// The user is created on the first sign in, unless the email is used by another user:
+// then the identity is linked only after the owner of the email confirms it by the link sent to the email.
+type OIDCService struct {
+	logger      *logger.Logger
+	authService *AuthService
+
+	oidcClient               OIDCClient
+	authorizationsRepository OIDCAuthorizationsRepository
+	identitiesRepository     IdentitiesRepository
+
+	authorizationTTL time.Duration
+}
+
+func NewOIDCService(
+	log *logger.Logger,
+	cfg *config.Config,
+	authService *AuthService,
+	oidcClient OIDCClient,
+	authorizationsRepository OIDCAuthorizationsRepository,
+	identitiesRepository IdentitiesRepository,
+) *OIDCService {
+	return &OIDCService{
+		logger:                   log,
+		authService:              authService,
+		oidcClient:               oidcClient,
+		authorizationsRepository: authorizationsRepository,
+		identitiesRepository:     identitiesRepository,
+		authorizationTTL:         cfg.OIDC.AuthorizationTTL,
+	}
+}
+
+func (s OIDCService) GetProviders() []string {
+	return s.oidcClient.Providers()
+}
+
+// StartAuthorization returns the url of the provider, where the user should be redirected.
+func (s OIDCService) StartAuthorization(ctx context.Context, provider string, client models.ClientInfo) (string, error) {
+	authorization := models.OIDCAuthorization{
+		State:            s.authService.generator.GenerateString(oidcStateLength),
+		Provider:         provider,
+		Nonce:            s.authService.generator.GenerateString(oidcNonceLength),
+		CodeVerifier:     s.authService.generator.GenerateString(oidcCodeVerifierLength),
+		BrowserNonceHash: hashLinkNonce(client.LinkNonce),
+		ExpiresAt:        time.Now().Add(s.authorizationTTL),
+	}
+
+	authURL, err := s.oidcClient.AuthCodeURL(ctx, authorization)
+	if err != nil {
+		return "", errors.Errorf("get auth code url: %w", err)
+	}
+
+	if err = s.authorizationsRepository.StoreAuthorization(ctx, authorization); err != nil {
+		return "", errors.Errorf("store authorization: %w", err)
+	}
+
+	return authURL, nil
+}
+
+// CompleteAuthorization handles the callback of the provider.
+func (s OIDCService) CompleteAuthorization(
+	ctx context.Context, provider, code, state string, client models.ClientInfo,
+) (*dto.OIDCLoginOutput, error) {
+	// the state is deleted, so that the callback could not be replayed
+	authorization, err := s.authorizationsRepository.PopAuthorization(ctx, state)
+	if err != nil {
+		return nil, errors.Errorf("pop authorization: %w", err)
+	}
+
+	if authorization.Provider != provider ||
+		client.LinkNonce == "" ||
+		subtle.ConstantTimeCompare(hashLinkNonce(client.LinkNonce), authorization.BrowserNonceHash) != 1 {
+		s.logger.Warn("oidc callback from another browser",
+			slog.String("provider", provider),
+			slog.String("ip", client.IP),
+		)
+		return nil, apperrors.ErrOIDCAuthorizationNotFound
+	}
+
+	claims, err := s.oidcClient.Exchange(ctx, *authorization, code)
+	if err != nil {
+		return nil, errors.Errorf("exchange code: %w", err)
+	}
+
+	identity, err := s.identitiesRepository.GetUserIdentity(ctx, claims.Provider, claims.Subject)
+	switch {
+	case err == nil:
+		loginOutput, err := s.login(ctx, identity.UserID, client)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.OIDCLoginOutput{LoginOutput: loginOutput}, nil
+	case !errors.Is(err, apperrors.ErrIdentityNotFound):
+		return nil, errors.Errorf("get user identity: %w", err)
+	}
+
+	// unverified email could belong to another person, so it is not used for neither linking nor registration
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, apperrors.ErrOIDCEmailNotVerified
+	}
+
+	user, err := s.authService.userRepository.GetUserByEmail(ctx, claims.Email)
+	switch {
+	case err == nil:
+		if err = s.sendLinkConfirmation(ctx, user, *claims, client); err != nil {
+			return nil, err
+		}
+		return &dto.OIDCLoginOutput{LinkConfirmationRequired: true, Email: user.Email}, nil
+	case !errors.Is(err, apperrors.ErrUserNotFound):
+		return nil, errors.Errorf("get user by email: %w", err)
+	}
+
+	loginOutput, err := s.registerUser(ctx, *claims, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.OIDCLoginOutput{LoginOutput: loginOutput}, nil
+}
+
+// ConfirmIdentityLink links the identity by the link sent from CompleteAuthorization and signs the user in.
+func (s OIDCService) ConfirmIdentityLink(ctx context.Context, token string, client models.ClientInfo) (*dto.LoginOutput, error) {
+	link, err := s.authService.consumeLink(ctx, token, models.LinkPurposeLinkIdentity, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.Identity == nil {
+		return nil, errors.Errorf("link %s has no identity", link.ID)
+	}
+
+	user, err := s.authService.userRepository.GetUserByID(ctx, link.UserID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	// the link was sent to the previous email of the user
+	if !strings.EqualFold(user.Email, link.Email) {
+		return nil, apperrors.ErrLinkNotFound
+	}
+
+	if err = s.authService.checkUserStatus(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	identity := *link.Identity
+	identity.UserID = user.ID
+
+	err = s.identitiesRepository.CreateUserIdentity(ctx, identity)
+	switch {
+	case errors.Is(err, apperrors.ErrIdentityAlreadyLinked):
+		// the identity could be linked by the concurrent confirmation, or to another user
+		// while the mail was delivered
+		linked, err := s.identitiesRepository.GetUserIdentity(ctx, identity.Provider, identity.Subject)
+		if err != nil {
+			return nil, errors.Errorf("get user identity: %w", err)
+		}
+		if linked.UserID != user.ID {
+			return nil, apperrors.ErrIdentityAlreadyLinked
+		}
+
+		return s.authService.startSession(ctx, user, client, loginMethodOIDC)
+	case err != nil:
+		return nil, errors.Errorf("create user identity: %w", err)
+	}
+
+	s.logger.Info("identity linked",
+		slog.String("user_id", user.ID.String()),
+		slog.String("provider", identity.Provider),
+	)
+
+	s.authService.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:   &user.ID,
+		Type:     models.SecurityEventIdentityLinked,
+		Outcome:  models.SecurityEventSuccess,
+		Metadata: map[string]string{"provider": identity.Provider},
+	})
+
+	return s.authService.startSession(ctx, user, client, loginMethodOIDC)
+}
+
+func (s OIDCService) GetUserIdentities(ctx context.Context, userID uuid.UUID) ([]models.UserIdentity, error) {
+	identities, err := s.identitiesRepository.GetUserIdentities(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user identities: %w", err)
+	}
+
+	return identities, nil
+}
+
+// UnlinkIdentity does not let the user without password unlink the last identity,
+// otherwise the user could sign in only by the links sent to the email.
+func (s OIDCService) UnlinkIdentity(
+	ctx context.Context, userID uuid.UUID, provider, subject string, client models.ClientInfo,
+) error {
+	_, passwordHash, err := s.authService.userRepository.GetUserByIDWithHash(ctx, userID)
+	if err != nil {
+		return errors.Errorf("get user by id: %w", err)
+	}
+
+	if len(passwordHash) == 0 {
+		identities, err := s.identitiesRepository.GetUserIdentities(ctx, userID)
+		if err != nil {
+			return errors.Errorf("get user identities: %w", err)
+		}
+		if len(identities) <= 1 {
+			return apperrors.ErrLastLoginMethod
+		}
+	}
+
+	if err = s.identitiesRepository.DeleteUserIdentity(ctx, userID, provider, subject); err != nil {
+		return errors.Errorf("delete user identity: %w", err)
+	}
+
+	s.logger.Info("identity unlinked",
+		slog.String("user_id", userID.String()),
+		slog.String("provider", provider),
+	)
+
+	s.authService.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:   &userID,
+		Type:     models.SecurityEventIdentityUnlinked,
+		Outcome:  models.SecurityEventSuccess,
+		Metadata: map[string]string{"provider": provider},
+	})
+
+	return nil
+}
+
+func (s OIDCService) login(ctx context.Context, userID uuid.UUID, client models.ClientInfo) (*dto.LoginOutput, error) {
+	user, err := s.authService.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	if err = s.authService.checkUserStatus(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return s.authService.startSession(ctx, user, client, loginMethodOIDC)
+}
+
+func (s OIDCService) sendLinkConfirmation(
+	ctx context.Context, user *models.User, claims models.OIDCClaims, client models.ClientInfo,
+) error {
+	link, err := s.authService.issueLink(ctx, models.Link{
+		Purpose: models.LinkPurposeLinkIdentity,
+		Email:   user.Email,
+		UserID:  user.ID,
+		Identity: &models.UserIdentity{
+			Provider: claims.Provider,
+			Subject:  claims.Subject,
+			UserID:   user.ID,
+			Email:    claims.Email,
+		},
+	}, client)
+	if err != nil {
+		return err
+	}
+
+	if err = s.authService.mailClient.SendMail(ctx, user.Email, user.Locale, mail.LinkIdentity(claims.Provider, link)); err != nil {
+		return errors.Errorf("send link identity mail: %w", err)
+	}
+
+	return nil
+}
+
+func (s OIDCService) registerUser(ctx context.Context, claims models.OIDCClaims, client models.ClientInfo) (*dto.LoginOutput, error) {
+	username, err := s.generateUsername(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(claims.Name)
+	if !namePattern.MatchString(name) {
+		name = username
+	}
+
+	userID := uuid.Must(uuid.NewV7())
+
+	err = s.authService.userRepository.CreateUser(ctx, dto.CreateUserParams{
+		UserID:   userID,
+		Email:    claims.Email,
+		Name:     name,
+		Username: username,
+		// the user has no password until it is set by the reset password flow
+		PasswordHash: []byte{},
+		Locale:       client.Locale,
+		Identity: &models.UserIdentity{
+			Provider: claims.Provider,
+			Subject:  claims.Subject,
+			UserID:   userID,
+			Email:    claims.Email,
+		},
+	})
+	if err != nil {
+		return nil, errors.Errorf("create user: %w", err)
+	}
+
+	s.logger.Info("user registered with identity",
+		slog.String("user_id", userID.String()),
+		slog.String("provider", claims.Provider),
+	)
+
+	user, err := s.authService.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	return s.authService.startSession(ctx, user, client, loginMethodOIDC)
+}
+
+// generateUsername derives the username from the claims, random suffix is added if it is taken.
+func (s OIDCService) generateUsername(ctx context.Context, claims models.OIDCClaims) (string, error) {
+	localPart, _, _ := strings.Cut(claims.Email, "@")
+
+	base := fallbackUsername
+	for _, candidate := range []string{claims.PreferredUsername, localPart} {
+		if username := sanitizeUsername(candidate); username != "" {
+			base = username
+			break
+		}
+	}
+
+	username := base
+	for range maxUsernameGenerations {
+		err := s.authService.checkUsername(ctx, uuid.Nil, username)
+		if err == nil {
+			return username, nil
+		}
+		if !errors.Is(err, apperrors.ErrUsernameUsed) && !errors.Is(err, apperrors.ErrUsernameReserved) {
+			return "", err
+		}
+
+		prefix := base[:min(len(base), maxUsernameLength-usernameSuffixLength-1)]
+		username = prefix + "_" + generator.NumberCode(usernameSuffixLength)
+	}
+
+	return "", errors.Errorf("generate username from '%s': all candidates are taken", base)
+}
+
+// sanitizeUsername drops the characters not allowed by usernamePattern and repeated separators,
+// empty string is returned if the rest is not a valid username.
+func sanitizeUsername(value string) string {
+	var b strings.Builder
+	separator := false
+	for _, r := range value {
+		switch {
+		case r == '.' || r == '_':
+			if !separator {
+				b.WriteRune(r)
+			}
+			separator = true
+		case 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9':
+			b.WriteRune(r)
+			separator = false
+		}
+	}
+
+	username := b.String()
+	username = username[:min(len(username), maxUsernameLength)]
+	username = strings.Trim(username, "._")
+
+	if validateUsername(username) != nil {
+		return ""
+	}
+
+	return username
+}
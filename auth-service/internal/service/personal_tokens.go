@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+const (
+	personalTokenLength        = 40
+	maxPersonalTokenNameLength = 64
+)
+
+// PersonalTokensService manages the personal access tokens, which the users create for scripts
+// and integrations. The tokens are opaque, so they are checked by auth-service on every request.
+type PersonalTokensService struct {
+	logger      *logger.Logger
+	authService *AuthService
+
+	personalTokensRepository PersonalTokensRepository
+
+	scopes           []string
+	maxPerUser       int
+	maxTTL           time.Duration
+	lastUsedInterval time.Duration
+}
+
+func NewPersonalTokensService(
+	log *logger.Logger,
+	cfg *config.Config,
+	authService *AuthService,
+	personalTokensRepository PersonalTokensRepository,
+) *PersonalTokensService {
+	return &PersonalTokensService{
+		logger:                   log,
+		authService:              authService,
+		personalTokensRepository: personalTokensRepository,
+		scopes:                   cfg.PersonalTokens.Scopes,
+		maxPerUser:               cfg.PersonalTokens.MaxPerUser,
+		maxTTL:                   cfg.PersonalTokens.MaxTTL,
+		lastUsedInterval:         cfg.PersonalTokens.LastUsedInterval,
+	}
+}
+
+// CreatePersonalToken returns the token only once, only its hash is stored.
+func (s PersonalTokensService) CreatePersonalToken(
+	ctx context.Context, userID uuid.UUID, params dto.CreatePersonalTokenInput, client models.ClientInfo,
+) (*dto.CreatePersonalTokenOutput, error) {
+	if err := s.validatePersonalToken(&params); err != nil {
+		return nil, err
+	}
+
+	count, err := s.personalTokensRepository.CountUserPersonalTokens(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("count personal tokens: %w", err)
+	}
+	if count >= int64(s.maxPerUser) {
+		return nil, apperrors.ErrPersonalTokensLimit
+	}
+
+	token := models.PersonalToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		UserID:    userID,
+		Name:      params.Name,
+		Scopes:    params.Scopes,
+		ExpiresAt: params.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	secret := jwt.PersonalTokenPrefix + s.authService.generator.GenerateString(personalTokenLength)
+
+	if err = s.personalTokensRepository.CreatePersonalToken(ctx, token, hashPersonalToken(secret)); err != nil {
+		return nil, errors.Errorf("create personal token: %w", err)
+	}
+
+	s.authService.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:  &userID,
+		Type:    models.SecurityEventPersonalTokenCreated,
+		Outcome: models.SecurityEventSuccess,
+		Metadata: map[string]string{
+			"token_id": token.ID.String(),
+			"name":     token.Name,
+			"scopes":   strings.Join(token.Scopes, ","),
+		},
+	})
+
+	return &dto.CreatePersonalTokenOutput{PersonalToken: token, Token: secret}, nil
+}
+
+// GetPersonalTokens returns the tokens of the user including the expired ones, most recent first.
+func (s PersonalTokensService) GetPersonalTokens(ctx context.Context, userID uuid.UUID) ([]models.PersonalToken, error) {
+	tokens, err := s.personalTokensRepository.GetUserPersonalTokens(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get personal tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// RevokePersonalToken deletes the token, it stops working immediately.
+func (s PersonalTokensService) RevokePersonalToken(
+	ctx context.Context, userID, tokenID uuid.UUID, client models.ClientInfo,
+) error {
+	if err := s.personalTokensRepository.DeletePersonalToken(ctx, userID, tokenID); err != nil {
+		return err
+	}
+
+	s.authService.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:   &userID,
+		Type:     models.SecurityEventPersonalTokenRevoked,
+		Outcome:  models.SecurityEventSuccess,
+		Metadata: map[string]string{"token_id": tokenID.String()},
+	})
+
+	return nil
+}
+
+// ValidatePersonalToken authenticates the request made with the personal access token.
+// The tokens of suspended users are rejected, but not deleted, so that they work again after the suspension.
+func (s PersonalTokensService) ValidatePersonalToken(ctx context.Context, secret string) (*models.PersonalToken, error) {
+	token, err := s.personalTokensRepository.GetPersonalTokenByHash(ctx, hashPersonalToken(secret))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrPersonalTokenNotFound) {
+			return nil, apperrors.ErrUnauthorized
+		}
+		return nil, errors.Errorf("get personal token: %w", err)
+	}
+
+	now := time.Now()
+	if token.Expired(now) {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if err = s.authService.checkUserStatus(ctx, token.UserID); err != nil {
+		return nil, err
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= s.lastUsedInterval {
+		// the request should not fail because of the usage statistics
+		err = s.personalTokensRepository.TouchPersonalToken(ctx, token.ID, now.Add(-s.lastUsedInterval))
+		if err != nil {
+			s.logger.Error("touch personal token", slog.String("token_id", token.ID.String()), logger.Error(err))
+		}
+	}
+
+	return token, nil
+}
+
+// validatePersonalToken trims the name, sorts the scopes and checks the expiration time.
+func (s PersonalTokensService) validatePersonalToken(params *dto.CreatePersonalTokenInput) error {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" || utf8.RuneCountInString(params.Name) > maxPersonalTokenNameLength {
+		return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "name"})
+	}
+
+	if len(params.Scopes) == 0 {
+		return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "scopes"})
+	}
+	for _, scope := range params.Scopes {
+		if !slices.Contains(s.scopes, scope) {
+			return apperrors.ErrScopeNotAllowed.WithMetadata(map[string]string{"scope": scope})
+		}
+	}
+	params.Scopes = slices.Compact(slices.Sorted(slices.Values(params.Scopes)))
+
+	now := time.Now()
+	if !params.ExpiresAt.After(now) || params.ExpiresAt.After(now.Add(s.maxTTL)) {
+		return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "expires_at"})
+	}
+
+	return nil
+}
+
+func hashPersonalToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tech-inspire/backend/auth-service/internal/clients/mail"
@@ -14,6 +16,11 @@ type Generator interface {
 	NewUUID() uuid.UUID
 }
 
+type PasswordHasher interface {
+	Hash(password string) ([]byte, error)
+	Verify(password string, hash []byte) (ok, needsRehash bool, err error)
+}
+
 type UserRepository interface {
 	GetUsersCount(ctx context.Context, params dto.GetUsersParams) (count int, err error)
 	UpdateUserByID(ctx context.Context, userID uuid.UUID, params dto.UpdateUsersParams) error
@@ -22,26 +29,107 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, params dto.CreateUserParams) error
 
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*models.User, error)
+	GetUserByIDWithHash(ctx context.Context, userID uuid.UUID) (user *models.User, hash []byte, err error)
 	GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error)
 
 	GetUserByUsernameWithHash(ctx context.Context, username string) (admin *models.User, hash []byte, err error)
 	GetUserByUsername(ctx context.Context, email string) (*models.User, error)
+	GetPreviousUsername(ctx context.Context, username string, now time.Time) (*models.PreviousUsername, error)
+	PruneUsernameHistory(ctx context.Context, now time.Time) (pruned int, err error)
 
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
-	DeleteUserByID(ctx context.Context, userID uuid.UUID) error
-
 	ClearUserAvatarURL(ctx context.Context, userID uuid.UUID) error
+	SetUserAdmin(ctx context.Context, userID uuid.UUID, isAdmin bool) error
+	IncrementUserTokensRevision(ctx context.Context, userID uuid.UUID) (revision int64, err error)
 }
 type SessionRepository interface {
 	GetUserSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (*models.Session, error)
 	DeleteUserSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error
-	AddUserSession(ctx context.Context, session models.Session, sessionLimit int) error
+	AddUserSession(
+		ctx context.Context, session models.Session, sessionLimit int, policy models.SessionEvictionPolicy,
+	) ([]models.Session, error)
+	GetUserSessions(ctx context.Context, userID uuid.UUID) ([]models.Session, error)
+	DeleteUserSessions(ctx context.Context, userID uuid.UUID, exceptSessionIDs ...uuid.UUID) error
+	RenameUserSession(ctx context.Context, userID, sessionID uuid.UUID, deviceName string) error
+	RotateUserSessionToken(
+		ctx context.Context, userID, sessionID uuid.UUID, presentedToken, newToken string, refreshedAt time.Time,
+	) (*models.Session, error)
+}
+
+type LinkSigner interface {
+	Sign(purpose, id string, expiresAt time.Time) string
+	Verify(token, purpose string, now time.Time) (id string, err error)
+}
+
+type LinksRepository interface {
+	StoreLink(ctx context.Context, link models.Link) error
+	GetLink(ctx context.Context, id string) (*models.Link, error)
+	DeleteLink(ctx context.Context, id string) error
+}
+
+type AttemptsRepository interface {
+	GetLockout(ctx context.Context, keys ...string) (time.Duration, error)
+	RegisterAttempt(ctx context.Context, key string, window time.Duration) (id string, count int64, err error)
+	ForgetAttempt(ctx context.Context, key, id string) error
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	ResetAttempts(ctx context.Context, keys ...string) error
+}
+
+type TokenRevoker interface {
+	RevokeSessionTokens(ctx context.Context, sessionIDs ...uuid.UUID) error
+	RevokeUserTokens(ctx context.Context, userID uuid.UUID, revision int64) error
+}
+
+type SuspensionsRepository interface {
+	GetUserSuspension(ctx context.Context, userID uuid.UUID) (*models.UserSuspension, error)
+	SuspendUser(ctx context.Context, userID, suspendedBy uuid.UUID, reason string, expiresAt *time.Time) error
+	LiftUserSuspension(ctx context.Context, userID uuid.UUID) error
+}
+
+type AccountDeletionsRepository interface {
+	CreateAccountDeletion(ctx context.Context, userID uuid.UUID, purgeAfter time.Time) error
+	CancelAccountDeletion(ctx context.Context, userID uuid.UUID) error
+	GetAccountDeletion(ctx context.Context, userID uuid.UUID) (*models.AccountDeletion, error)
+	PurgeNextDueAccount(ctx context.Context, now time.Time) (bool, error)
+	CompleteServiceDeletion(ctx context.Context, userID uuid.UUID, service string, completedAt time.Time) error
+}
+
+type UserEventsOutbox interface {
+	RelayUserEvents(
+		ctx context.Context, limit int, lease time.Duration, publish func(ctx context.Context, event models.UserEvent) error,
+	) (relayed int, err error)
+}
+
+type UserEventsPublisher interface {
+	PublishUserEvent(ctx context.Context, event models.UserEvent) error
+}
+
+type MFARepository interface {
+	GetUserTOTP(ctx context.Context, userID uuid.UUID) (*models.UserTOTP, error)
+	CreateUserTOTP(ctx context.Context, userID uuid.UUID, secret []byte) error
+	EnableUserTOTP(ctx context.Context, userID uuid.UUID, counter int64, recoveryCodeHashes [][]byte) error
+	UseTOTPCounter(ctx context.Context, userID uuid.UUID, counter int64) (bool, error)
+	DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error
+
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes [][]byte) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) (bool, error)
+}
+
+type MFAChallengesRepository interface {
+	StoreChallenge(ctx context.Context, challenge models.MFAChallenge) error
+	GetChallenge(ctx context.Context, token string) (*models.MFAChallenge, error)
+	RegisterFailedAttempt(ctx context.Context, challenge models.MFAChallenge) (int64, error)
+	DeleteChallenge(ctx context.Context, token string) error
 }
 
 type AvatarStorage interface {
-	UploadUserAvatar(ctx context.Context, params dto.UploadUserAvatar) (path string, err error)
+	UploadUserAvatarVariant(ctx context.Context, params dto.AvatarVariantUpload) (path string, err error)
 	GetUserAvatarURL(ctx context.Context, userID uuid.UUID) (string, error)
+	// DeleteUserAvatar removes all versions of the avatar.
 	DeleteUserAvatar(ctx context.Context, userID uuid.UUID) error
+	// ListUserAvatarObjects returns the paths of the objects of all versions of the avatar modified before the time.
+	ListUserAvatarObjects(ctx context.Context, userID uuid.UUID, modifiedBefore time.Time) ([]string, error)
+	DeleteAvatarObjects(ctx context.Context, paths []string) error
 }
 
 type FavoriteQuestionsRepository interface {
@@ -50,7 +138,20 @@ type FavoriteQuestionsRepository interface {
 }
 
 type MailClient interface {
-	SendMail(to string, message mail.Message) error
+	SendMail(ctx context.Context, to, locale string, template mail.Template) error
+}
+
+type MailTransport interface {
+	Send(ctx context.Context, to string, message mail.Message) error
+}
+
+type MailQueueRepository interface {
+	EnqueueMail(ctx context.Context, mail models.QueuedMail) error
+	ClaimDueMails(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.QueuedMail, error)
+	RenewMailLease(ctx context.Context, mail models.QueuedMail, leaseUntil time.Time) (bool, error)
+	DeleteMail(ctx context.Context, id int64) error
+	RetryMail(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	DeadLetterMail(ctx context.Context, id int64, lastError string) error
 }
 
 type ConfirmationCodesRepository interface {
@@ -60,6 +161,14 @@ type ConfirmationCodesRepository interface {
 	ClearAllCodes(ctx context.Context, email string) error
 }
 
+type EmailChangeCodesRepository interface {
+	StoreCode(ctx context.Context, data models.ResetPasswordData) error
+	GetActiveCodesCount(ctx context.Context, email string) (int64, error)
+	ClearAllCodes(ctx context.Context, email string) error
+	CheckCode(ctx context.Context, email string, confirmationCode string) (*models.ResetPasswordData, error)
+	DeleteCode(ctx context.Context, email, code string) error
+}
+
 type ResetPasswordCodesRepository interface {
 	StoreCode(ctx context.Context, data models.ResetPasswordData) error
 	GetActiveCodesCount(ctx context.Context, email string) (int64, error)
@@ -67,3 +176,71 @@ type ResetPasswordCodesRepository interface {
 	CheckCode(ctx context.Context, email string, confirmationCode string) (*models.ResetPasswordData, error)
 	DeleteCode(ctx context.Context, email, code string) error
 }
+
+type OIDCClient interface {
+	Providers() []string
+	AuthCodeURL(ctx context.Context, authorization models.OIDCAuthorization) (string, error)
+	Exchange(ctx context.Context, authorization models.OIDCAuthorization, code string) (*models.OIDCClaims, error)
+}
+
+type OIDCAuthorizationsRepository interface {
+	StoreAuthorization(ctx context.Context, authorization models.OIDCAuthorization) error
+	PopAuthorization(ctx context.Context, state string) (*models.OIDCAuthorization, error)
+}
+
+type IdentitiesRepository interface {
+	GetUserIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+	GetUserIdentities(ctx context.Context, userID uuid.UUID) ([]models.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, identity models.UserIdentity) error
+	DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider, subject string) error
+}
+
+type SecurityEventsRepository interface {
+	CreateSecurityEvent(ctx context.Context, event models.SecurityEvent) error
+	GetSecurityEvents(ctx context.Context, filter dto.SecurityEventsFilter) ([]models.SecurityEvent, error)
+	DeleteSecurityEventsBefore(ctx context.Context, before time.Time, limit int) (deleted int, err error)
+}
+
+type DevicesRepository interface {
+	HasUserDevices(ctx context.Context, userID uuid.UUID) (bool, error)
+	RememberUserDevice(ctx context.Context, userID uuid.UUID, deviceHash []byte, location string) (isNew bool, err error)
+}
+
+type PersonalTokensRepository interface {
+	CreatePersonalToken(ctx context.Context, token models.PersonalToken, tokenHash []byte) error
+	GetPersonalTokenByHash(ctx context.Context, tokenHash []byte) (*models.PersonalToken, error)
+	GetUserPersonalTokens(ctx context.Context, userID uuid.UUID) ([]models.PersonalToken, error)
+	CountUserPersonalTokens(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeletePersonalToken(ctx context.Context, userID, tokenID uuid.UUID) error
+	DeleteUserPersonalTokens(ctx context.Context, userID uuid.UUID) (deleted int, err error)
+	TouchPersonalToken(ctx context.Context, tokenID uuid.UUID, usedBefore time.Time) error
+}
+
+type DataExportsRepository interface {
+	CreateDataExport(ctx context.Context, export models.DataExport, requestedAfter time.Time) error
+	GetLatestDataExport(ctx context.Context, userID uuid.UUID) (*models.DataExport, error)
+	GetDataExportParts(ctx context.Context, exportID uuid.UUID) ([]models.DataExportPart, error)
+	CreateDataExportPart(ctx context.Context, exportID uuid.UUID, part models.DataExportPart) error
+	ProcessNextDueDataExport(
+		ctx context.Context,
+		timedOutBefore time.Time,
+		services []string,
+		process func(ctx context.Context, export models.DataExport, parts []models.DataExportPart) (*models.DataExport, error),
+	) (bool, error)
+	ExpireNextDataExport(ctx context.Context, now time.Time, expire func(ctx context.Context, export models.DataExport) error) (bool, error)
+}
+
+type FollowsRepository interface {
+	CreateFollow(ctx context.Context, followerID, followeeID uuid.UUID, now time.Time) (created bool, err error)
+	DeleteFollow(ctx context.Context, followerID, followeeID uuid.UUID, now time.Time) (deleted bool, err error)
+	GetFollowStatus(ctx context.Context, userID, otherID uuid.UUID) (models.FollowStatus, error)
+	GetFollowers(ctx context.Context, userID uuid.UUID, cursor *dto.FollowsCursor, limit int) ([]models.Follow, error)
+	GetFollowing(ctx context.Context, userID uuid.UUID, cursor *dto.FollowsCursor, limit int) ([]models.Follow, error)
+}
+
+type DataExportStorage interface {
+	GetExportObject(ctx context.Context, key string) (io.ReadCloser, error)
+	PutExportArchive(ctx context.Context, key string, body io.ReadSeeker, size int64) error
+	DeleteExportObject(ctx context.Context, key string) error
+	PresignExportArchive(ctx context.Context, key string, expire time.Duration) (string, error)
+}
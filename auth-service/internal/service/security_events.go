@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+// Sign in methods stored in the metadata of login events.
+const (
+	loginMethodPassword     = "password"
+	loginMethodLink         = "link"
+	loginMethodOIDC         = "oidc"
+	loginMethodRegistration = "registration"
+	loginMethodMFA          = "mfa"
+)
+
+const (
+	defaultSecurityEventsPageSize = 20
+	maxSecurityEventsPageSize     = 100
+)
+
+// SecurityEventsService gives the users access to their own security history
+// and deletes the events older than the retention period.
+type SecurityEventsService struct {
+	securityEventsRepository SecurityEventsRepository
+
+	retention      time.Duration
+	pruneBatchSize int
+}
+
+func NewSecurityEventsService(cfg *config.Config, securityEventsRepository SecurityEventsRepository) *SecurityEventsService {
+	return &SecurityEventsService{
+		securityEventsRepository: securityEventsRepository,
+		retention:                cfg.SecurityEvents.Retention,
+		pruneBatchSize:           cfg.SecurityEvents.PruneBatchSize,
+	}
+}
+
+// GetUserSecurityEvents returns the recent activity of the user, most recent first.
+func (s SecurityEventsService) GetUserSecurityEvents(
+	ctx context.Context, userID uuid.UUID, limit, offset int,
+) ([]models.SecurityEvent, error) {
+	return getSecurityEvents(ctx, s.securityEventsRepository, dto.SecurityEventsFilter{
+		UserID: &userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+// PruneSecurityEvents deletes the events older than the retention period in batches.
+func (s SecurityEventsService) PruneSecurityEvents(ctx context.Context) (pruned int, err error) {
+	before := time.Now().Add(-s.retention)
+
+	for {
+		deleted, err := s.securityEventsRepository.DeleteSecurityEventsBefore(ctx, before, s.pruneBatchSize)
+		if err != nil {
+			return pruned, errors.Errorf("delete security events: %w", err)
+		}
+
+		pruned += deleted
+		if deleted < s.pruneBatchSize {
+			return pruned, nil
+		}
+	}
+}
+
+func getSecurityEvents(
+	ctx context.Context, repository SecurityEventsRepository, filter dto.SecurityEventsFilter,
+) ([]models.SecurityEvent, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultSecurityEventsPageSize
+	}
+	filter.Limit = min(filter.Limit, maxSecurityEventsPageSize)
+	filter.Offset = max(filter.Offset, 0)
+
+	events, err := repository.GetSecurityEvents(ctx, filter)
+	if err != nil {
+		return nil, errors.Errorf("get security events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (a AuthService) recordLoginFailure(ctx context.Context, userID *uuid.UUID, client models.ClientInfo, reason string) {
+	a.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:   userID,
+		Type:     models.SecurityEventLogin,
+		Outcome:  models.SecurityEventFailure,
+		Metadata: map[string]string{"method": loginMethodPassword, "reason": reason},
+	})
+}
+
+// recordSecurityEvent appends the event to the audit log. The action has already happened,
+// so the failure to record it is only logged.
+func (a AuthService) recordSecurityEvent(ctx context.Context, client models.ClientInfo, event models.SecurityEvent) {
+	event.IP = client.IP
+	event.UserAgent = client.UserAgent
+
+	if err := a.securityEventsRepository.CreateSecurityEvent(ctx, event); err != nil {
+		a.logger.Error("record security event",
+			slog.String("event_type", string(event.Type)),
+			slog.String("outcome", string(event.Outcome)),
+			logger.Error(err),
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"log/slog"
+	"slices"
+
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/internal/models"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+)
+
+type serviceClient struct {
+	secretHash [sha256.Size]byte
+	scopes     []string
+}
+
+// ServiceClientsService authenticates other services of the backend, which get service tokens
+// with the client credentials grant to call internal procedures.
+type ServiceClientsService struct {
+	logger  *logger.Logger
+	clients map[string]serviceClient
+}
+
+func NewServiceClientsService(log *logger.Logger, cfg *config.Config) *ServiceClientsService {
+	clients := make(map[string]serviceClient, len(cfg.ServiceClients.Clients))
+	for _, client := range cfg.ServiceClients.Clients {
+		clients[client.ID] = serviceClient{
+			secretHash: sha256.Sum256([]byte(client.Secret)),
+			scopes:     client.Scopes,
+		}
+	}
+
+	return &ServiceClientsService{
+		logger:  log,
+		clients: clients,
+	}
+}
+
+// AuthenticateServiceClient checks the client credentials and returns the scopes to grant.
+// All scopes allowed for the client are granted if none are requested.
+func (s ServiceClientsService) AuthenticateServiceClient(
+	_ context.Context, clientID, clientSecret string, scopes []string,
+) (*models.ServiceClient, error) {
+	client, ok := s.clients[clientID]
+
+	// secrets are compared by hash, so that the comparison takes the same time for any secret length
+	secretHash := sha256.Sum256([]byte(clientSecret))
+	if subtle.ConstantTimeCompare(secretHash[:], client.secretHash[:]) != 1 || !ok {
+		s.logger.Warn("invalid service client credentials", slog.String("client_id", clientID))
+		return nil, apperrors.ErrInvalidClientCredentials
+	}
+
+	if len(scopes) == 0 {
+		return &models.ServiceClient{ID: clientID, Scopes: slices.Clone(client.scopes)}, nil
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(client.scopes, scope) {
+			return nil, apperrors.ErrScopeNotAllowed.WithMetadata(map[string]string{"scope": scope})
+		}
+	}
+
+	return &models.ServiceClient{ID: clientID, Scopes: slices.Compact(slices.Sorted(slices.Values(scopes)))}, nil
+}
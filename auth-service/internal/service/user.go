@@ -2,12 +2,13 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
 	"github.com/tech-inspire/backend/auth-service/internal/models"
 	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type UserService struct {
@@ -29,40 +30,84 @@ func (a UserService) GetUserInfoByID(ctx context.Context, userID uuid.UUID) (*mo
 	return user, nil
 }
 
-func (a UserService) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
-	_, err := a.userRepository.GetUserByID(ctx, userID)
+func (a UserService) UpdateUser(ctx context.Context, userID uuid.UUID, params dto.UpdateUsersInput) (*models.User, error) {
+	user, err := a.userRepository.GetUserByID(ctx, userID)
 	if err != nil {
-		return errors.Errorf("get user '%s': %w", userID, err)
+		return nil, errors.Errorf("get user by id: %w", err)
 	}
 
-	return a.cleanUpUser(ctx, userID)
-}
-
-func (a UserService) UpdateUser(ctx context.Context, userID uuid.UUID, params dto.UpdateUsersInput) error {
-	if params.Username != nil {
-		if err := a.authService.checkUsername(ctx, *params.Username); err != nil {
-			return err
-		}
+	if err = validateUserUpdate(&params); err != nil {
+		return nil, err
 	}
 
-	var passwordHash *[]byte
+	if params.Username != nil && *params.Username == user.Username {
+		// unchanged username is not checked against the new rules and not kept in the history
+		params.Username = nil
+	}
 
-	if params.Password != nil {
-		hash, err := bcrypt.GenerateFromPassword([]byte(*params.Password), bcrypt.DefaultCost)
-		if err != nil {
-			return errors.Errorf("hash password: %w", err)
+	if params.Username != nil {
+		if err = a.authService.checkUsername(ctx, userID, *params.Username); err != nil {
+			return nil, err
 		}
-
-		passwordHash = &hash
 	}
 
-	return a.userRepository.UpdateUserByID(ctx, userID, dto.UpdateUsersParams{
+	err = a.userRepository.UpdateUserByID(ctx, userID, dto.UpdateUsersParams{
 		Name:        params.Name,
-		Password:    passwordHash,
 		Username:    params.Username,
 		Description: params.Description,
 		AvatarUrl:   nil,
+		Locale:      params.Locale,
+
+		UsernameReleasedAt: time.Now().Add(a.authService.usernames.cooldown),
+
+		NotifyNewDevices: params.NotifyNewDevices,
+	})
+	if err != nil {
+		return nil, errors.Errorf("update user: %w", err)
+	}
+
+	user, err = a.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	return user, nil
+}
+
+// ChangePassword requires the current password, so that stolen session could not take over the account.
+func (a UserService) ChangePassword(
+	ctx context.Context, userID uuid.UUID, params dto.ChangePasswordInput, client models.ClientInfo,
+) error {
+	if _, err := a.authService.checkUserPassword(ctx, userID, params.CurrentPassword); err != nil {
+		return err
+	}
+
+	hash, err := a.authService.passwordHasher.Hash(params.NewPassword)
+	if err != nil {
+		return errors.Errorf("hash password: %w", err)
+	}
+
+	err = a.userRepository.UpdateUserByID(ctx, userID, dto.UpdateUsersParams{
+		Password: &hash,
+	})
+	if err != nil {
+		return errors.Errorf("update user password: %w", err)
+	}
+
+	a.authService.recordSecurityEvent(ctx, client, models.SecurityEvent{
+		UserID:    &userID,
+		Type:      models.SecurityEventPasswordChange,
+		Outcome:   models.SecurityEventSuccess,
+		SessionID: &params.CurrentSessionID,
 	})
+
+	if params.RevokeOtherSessions {
+		if err = a.authService.RevokeOtherSessions(ctx, userID, params.CurrentSessionID, client); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (a UserService) GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.GetUserByIDOutput, error) {
@@ -76,6 +121,33 @@ func (a UserService) GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.Ge
 	}, nil
 }
 
+// GetUserByUsername also finds the users by their previous usernames until they are released,
+// so that the links to the profiles keep working after the username is changed.
+func (a UserService) GetUserByUsername(ctx context.Context, username string) (*dto.GetUserByUsernameOutput, error) {
+	user, err := a.userRepository.GetUserByUsername(ctx, username)
+	if err == nil {
+		return &dto.GetUserByUsernameOutput{GetUserByIDOutput: dto.GetUserByIDOutput{User: user}}, nil
+	}
+	if !errors.Is(err, apperrors.ErrUserNotFound) {
+		return nil, errors.Errorf("get user by username: %w", err)
+	}
+
+	previous, err := a.userRepository.GetPreviousUsername(ctx, username, time.Now())
+	if err != nil {
+		return nil, errors.Errorf("get previous username: %w", err)
+	}
+
+	user, err = a.userRepository.GetUserByID(ctx, previous.UserID)
+	if err != nil {
+		return nil, errors.Errorf("get user by id: %w", err)
+	}
+
+	return &dto.GetUserByUsernameOutput{
+		GetUserByIDOutput: dto.GetUserByIDOutput{User: user},
+		Renamed:           true,
+	}, nil
+}
+
 func (a UserService) GetCurrentUserByID(ctx context.Context, userID uuid.UUID) (*dto.GetCurrentUser, error) {
 	user, err := a.GetUserByID(ctx, userID)
 	if err != nil {
@@ -115,19 +187,6 @@ func (a UserService) GetUsersInfoByID(ctx context.Context, userIDs []uuid.UUID)
 	return out, nil
 }
 
-func (a UserService) cleanUpUser(ctx context.Context, userID uuid.UUID) error {
-	_, err := a.userRepository.GetUserByID(ctx, userID)
-	if err != nil {
-		return errors.Errorf("get user by id: %w", err)
-	}
-
-	if err = a.userRepository.DeleteUserByID(ctx, userID); err != nil {
-		return errors.Errorf("delete user: %w", err)
-	}
-
-	return nil
-}
-
 func (a UserService) GetUsers(ctx context.Context, params dto.GetUsersParams) (*dto.GetUsersOutput, error) {
 	users, err := a.userRepository.GetUsers(ctx, params)
 	if err != nil {
@@ -144,3 +203,13 @@ func (a UserService) GetUsers(ctx context.Context, params dto.GetUsersParams) (*
 		Users: users,
 	}, nil
 }
+
+// PruneUsernameHistory deletes the released usernames from the history.
+func (a UserService) PruneUsernameHistory(ctx context.Context) (pruned int, err error) {
+	pruned, err = a.userRepository.PruneUsernameHistory(ctx, time.Now())
+	if err != nil {
+		return 0, errors.Errorf("prune username history: %w", err)
+	}
+
+	return pruned, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+)
+
+// UserEventsRelay publishes user events from the outbox. Events are written by the repositories
+// in the same transaction as the change of the user, so they are published even if NATS was down.
+type UserEventsRelay struct {
+	outbox    UserEventsOutbox
+	publisher UserEventsPublisher
+
+	batchSize int
+	lease     time.Duration
+}
+
+func NewUserEventsRelay(cfg *config.Config, outbox UserEventsOutbox, publisher UserEventsPublisher) *UserEventsRelay {
+	return &UserEventsRelay{
+		outbox:    outbox,
+		publisher: publisher,
+		batchSize: cfg.Outbox.RelayBatchSize,
+		lease:     cfg.Outbox.RelayLease,
+	}
+}
+
+// RelayUserEvents publishes batches until the outbox is empty.
+func (r UserEventsRelay) RelayUserEvents(ctx context.Context) (relayed int, err error) {
+	for {
+		n, err := r.outbox.RelayUserEvents(ctx, r.batchSize, r.lease, r.publisher.PublishUserEvent)
+		relayed += n
+		if err != nil {
+			return relayed, err
+		}
+		if n < r.batchSize {
+			return relayed, nil
+		}
+	}
+}
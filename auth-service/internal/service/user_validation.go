@@ -0,0 +1,84 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tech-inspire/backend/auth-service/internal/apperrors"
+	"github.com/tech-inspire/backend/auth-service/internal/service/dto"
+	"golang.org/x/text/language"
+)
+
+// patterns are the same as in the api contracts, so that the rules hold for every caller of the service.
+var (
+	usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9._]{0,28}[a-zA-Z0-9])?$`)
+	namePattern     = regexp.MustCompile(`^[A-Za-z0-9À-ÿ' .-]{1,50}$`)
+)
+
+const (
+	maxDescriptionLength = 500
+	maxLocaleLength      = 35
+	// minUsernameLength applies to the new usernames only, the existing ones are kept.
+	minUsernameLength = 3
+)
+
+// reservedUsernameReplacer drops the separators, so that "ad.min" and "ad_min" match "admin".
+var reservedUsernameReplacer = strings.NewReplacer(".", "", "_", "")
+
+func reservedUsernameKey(username string) string {
+	return strings.ToLower(reservedUsernameReplacer.Replace(username))
+}
+
+// validateUsername checks the rules for the new usernames on top of usernamePattern.
+func validateUsername(username string) error {
+	invalid := !usernamePattern.MatchString(username) ||
+		len(username) < minUsernameLength ||
+		strings.Contains(username, "..") ||
+		strings.Contains(username, "__") ||
+		strings.Contains(username, "._") ||
+		strings.Contains(username, "_.")
+	if invalid {
+		return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "username"})
+	}
+
+	return nil
+}
+
+// validateUserUpdate trims the fields and checks them.
+func validateUserUpdate(params *dto.UpdateUsersInput) error {
+	if params.Name != nil {
+		name := strings.TrimSpace(*params.Name)
+		if !namePattern.MatchString(name) {
+			return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "name"})
+		}
+		params.Name = &name
+	}
+
+	if params.Username != nil {
+		username := strings.TrimSpace(*params.Username)
+		if !usernamePattern.MatchString(username) {
+			return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "username"})
+		}
+		params.Username = &username
+	}
+
+	if params.Description != nil {
+		description := strings.TrimSpace(*params.Description)
+		if utf8.RuneCountInString(description) > maxDescriptionLength {
+			return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "description"})
+		}
+		params.Description = &description
+	}
+
+	if params.Locale != nil && *params.Locale != "" {
+		tag, err := language.Parse(strings.TrimSpace(*params.Locale))
+		if err != nil || len(tag.String()) > maxLocaleLength {
+			return apperrors.ErrInvalidUserField.WithMetadata(map[string]string{"field": "locale"})
+		}
+		locale := tag.String()
+		params.Locale = &locale
+	}
+
+	return nil
+}
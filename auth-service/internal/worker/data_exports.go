@@ -0,0 +1,37 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+)
+
+type DataExportsProcessor interface {
+	ProcessDueDataExports(ctx context.Context) (processed int, err error)
+	ExpireDataExports(ctx context.Context) (expired int, err error)
+}
+
+// StartDataExportsWorker periodically builds the archives of the collected exports and deletes
+// the expired ones. It is safe to run on every replica: exports are locked while they are processed.
+func StartDataExportsWorker(lc fx.Lifecycle, cfg *config.Config, processor DataExportsProcessor) {
+	runPeriodically(lc, cfg.DataExports.ProcessInterval, func(ctx context.Context) {
+		processed, err := processor.ProcessDueDataExports(ctx)
+		if err != nil {
+			slog.Error("process data exports", logger.Error(err))
+		}
+		if processed > 0 {
+			slog.Info("processed data exports", slog.Int("count", processed))
+		}
+
+		expired, err := processor.ExpireDataExports(ctx)
+		if err != nil {
+			slog.Error("expire data exports", logger.Error(err))
+		}
+		if expired > 0 {
+			slog.Info("expired data exports", slog.Int("count", expired))
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+)
+
+type AccountsPurger interface {
+	PurgeDueAccounts(ctx context.Context) (purged int, err error)
+}
+
+// StartAccountDeletionsWorker periodically deletes accounts whose grace period is over.
+// It is safe to run on every replica: each deletion is locked by the replica processing it.
+func StartAccountDeletionsWorker(lc fx.Lifecycle, cfg *config.Config, purger AccountsPurger) {
+	runPeriodically(lc, cfg.AccountDeletion.PurgeInterval, func(ctx context.Context) {
+		purged, err := purger.PurgeDueAccounts(ctx)
+		if err != nil {
+			// the rest is retried on the next tick
+			slog.Error("purge deleted accounts", logger.Error(err))
+		}
+		if purged > 0 {
+			slog.Info("purged deleted accounts", slog.Int("count", purged))
+		}
+	})
+}
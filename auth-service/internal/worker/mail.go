@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+)
+
+type MailDeliverer interface {
+	DeliverQueuedMails(ctx context.Context) (delivered int, err error)
+}
+
+// StartMailDeliveryWorker periodically sends queued mails.
+// It is safe to run on every replica: each mail is claimed by the replica sending it.
+func StartMailDeliveryWorker(lc fx.Lifecycle, cfg *config.Config, deliverer MailDeliverer) {
+	runPeriodically(lc, cfg.Mail.DeliveryInterval, func(ctx context.Context) {
+		delivered, err := deliverer.DeliverQueuedMails(ctx)
+		if err != nil {
+			slog.Error("deliver queued mails", logger.Error(err))
+		}
+		if delivered > 0 {
+			slog.Debug("delivered queued mails", slog.Int("count", delivered))
+		}
+	})
+}
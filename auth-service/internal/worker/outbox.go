@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+)
+
+type UserEventsRelay interface {
+	RelayUserEvents(ctx context.Context) (relayed int, err error)
+}
+
+// StartUserEventsRelay periodically publishes user events from the outbox.
+// It is safe to run on every replica: only one of them relays at a time.
+func StartUserEventsRelay(lc fx.Lifecycle, cfg *config.Config, relay UserEventsRelay) {
+	runPeriodically(lc, cfg.Outbox.RelayInterval, func(ctx context.Context) {
+		relayed, err := relay.RelayUserEvents(ctx)
+		if err != nil {
+			// unpublished events are kept in the outbox
+			slog.Error("relay user events", logger.Error(err))
+		}
+		if relayed > 0 {
+			slog.Debug("relayed user events", slog.Int("count", relayed))
+		}
+	})
+}
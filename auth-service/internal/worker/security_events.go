@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+)
+
+type SecurityEventsPruner interface {
+	PruneSecurityEvents(ctx context.Context) (pruned int, err error)
+}
+
+// StartSecurityEventsPruner periodically deletes security events older than the retention period.
+// It is safe to run on every replica: the same events are deleted at most once.
+func StartSecurityEventsPruner(lc fx.Lifecycle, cfg *config.Config, pruner SecurityEventsPruner) {
+	runPeriodically(lc, cfg.SecurityEvents.PruneInterval, func(ctx context.Context) {
+		pruned, err := pruner.PruneSecurityEvents(ctx)
+		if err != nil {
+			slog.Error("prune security events", logger.Error(err))
+		}
+		if pruned > 0 {
+			slog.Info("pruned security events", slog.Int("count", pruned))
+		}
+	})
+}
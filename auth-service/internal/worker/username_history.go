@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tech-inspire/backend/auth-service/internal/config"
+	"github.com/tech-inspire/backend/auth-service/pkg/logger"
+	"go.uber.org/fx"
+)
+
+type UsernameHistoryPruner interface {
+	PruneUsernameHistory(ctx context.Context) (pruned int, err error)
+}
+
+// StartUsernameHistoryPruner periodically deletes the previous usernames after their cooldown.
+// Released usernames are ignored by the lookups, so the pruning only keeps the table small.
+func StartUsernameHistoryPruner(lc fx.Lifecycle, cfg *config.Config, pruner UsernameHistoryPruner) {
+	runPeriodically(lc, cfg.Usernames.PruneInterval, func(ctx context.Context) {
+		pruned, err := pruner.PruneUsernameHistory(ctx)
+		if err != nil {
+			slog.Error("prune username history", logger.Error(err))
+		}
+		if pruned > 0 {
+			slog.Info("pruned username history", slog.Int("count", pruned))
+		}
+	})
+}
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS user_totp
+(
+    user_id           UUID PRIMARY KEY REFERENCES users (user_id) ON DELETE CASCADE,
+    secret            bytea                   NOT NULL,
+
+    -- enrollment is finished only after the user proves possession of the secret
+    confirmed_at      TIMESTAMP               NULL,
+    -- time step of the last accepted code, used to reject replays
+    last_used_counter BIGINT    DEFAULT 0     NOT NULL,
+
+    created_at        TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes
+(
+    code_hash  bytea PRIMARY KEY,
+    user_id    UUID                    NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+
+    used_at    TIMESTAMP               NULL,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_recovery_codes_user_id ON user_recovery_codes (user_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_recovery_codes_user_id;
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS user_suspensions
+(
+    user_id      UUID PRIMARY KEY REFERENCES users (user_id) ON DELETE CASCADE,
+    reason       VARCHAR(500)            NOT NULL,
+
+    -- NULL means the user is banned permanently
+    expires_at   TIMESTAMP               NULL,
+    suspended_by UUID                    NULL REFERENCES users (user_id) ON DELETE SET NULL,
+
+    created_at   TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_suspensions;
+-- +goose StatementEnd
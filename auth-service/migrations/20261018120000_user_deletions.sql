@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- rows are kept after the user is deleted, to track the cleanup in other services
+CREATE TABLE IF NOT EXISTS user_deletions
+(
+    user_id      UUID PRIMARY KEY,
+
+    requested_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    -- the deletion can be cancelled until this time
+    purge_after  TIMESTAMP               NOT NULL,
+    -- set when the user is deleted and other services are notified
+    deleted_at   TIMESTAMP               NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_deletions_purge_after ON user_deletions (purge_after) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS user_deletion_services
+(
+    user_id      UUID         NOT NULL,
+    service      VARCHAR(100) NOT NULL,
+    completed_at TIMESTAMP    NOT NULL,
+
+    PRIMARY KEY (user_id, service)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_deletion_services;
+DROP INDEX IF EXISTS idx_user_deletions_purge_after;
+DROP TABLE IF EXISTS user_deletions;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- events are inserted in the same transaction as the change of the user and deleted once published
+CREATE TABLE IF NOT EXISTS user_events_outbox
+(
+    id          BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    -- used by the stream to drop duplicates, when the event is published again after a failure
+    event_id    UUID                    NOT NULL UNIQUE,
+    -- no foreign key, as the deleted event outlives the user
+    user_id     UUID                    NOT NULL,
+    event_type  VARCHAR(50)             NOT NULL,
+    payload     BYTEA                   NOT NULL,
+    occurred_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    -- events are claimed by a relay for the lease duration and published outside of the transaction,
+    -- the lease of a relay that crashed expires and the events are claimed again
+    claimed_by    UUID,
+    claimed_until TIMESTAMP
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_events_outbox;
+-- +goose StatementEnd
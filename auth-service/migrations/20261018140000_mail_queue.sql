@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- mails are deleted once delivered
+CREATE TABLE IF NOT EXISTS mail_queue
+(
+    id              BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    recipient       VARCHAR(320)            NOT NULL,
+    subject         TEXT                    NOT NULL,
+    body            TEXT                    NOT NULL,
+
+    attempts        INT       DEFAULT 0     NOT NULL,
+    -- moved forward while the mail is being sent, so that other replicas skip it
+    next_attempt_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    last_error      TEXT                    NULL,
+
+    created_at      TIMESTAMP DEFAULT NOW() NOT NULL,
+    -- set when all attempts have failed, such mails are kept for inspection without the body,
+    -- as it contains codes and links
+    dead_at         TIMESTAMP               NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_mail_queue_next_attempt_at ON mail_queue (next_attempt_at) WHERE dead_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_mail_queue_next_attempt_at;
+DROP TABLE IF EXISTS mail_queue;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- preferred language of the mails, the default one is used if it is not set
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NULL;
+
+-- mails are rendered in both formats, body keeps the text one
+ALTER TABLE mail_queue
+    ADD COLUMN IF NOT EXISTS html_body TEXT DEFAULT '' NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE mail_queue
+    DROP COLUMN IF EXISTS html_body;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS locale;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- external accounts of OpenID Connect providers, which the user can sign in with
+CREATE TABLE IF NOT EXISTS user_identities
+(
+    provider   VARCHAR(64)             NOT NULL,
+    -- "sub" claim, unique only within the provider
+    subject    VARCHAR(255)            NOT NULL,
+    user_id    UUID                    NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    email      VARCHAR(150)            NOT NULL,
+
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL,
+
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities (user_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_identities_user_id;
+DROP TABLE IF EXISTS user_identities;
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- append-only history of security relevant actions: sign ins, password and email changes,
+-- session revocations and admin actions, old events are deleted by the retention job
+CREATE TABLE IF NOT EXISTS security_events
+(
+    id         BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    -- NULL for failed sign ins with unknown login. No foreign key: the events of the deleted users are kept
+    -- until the retention period is over, so that the actions preceding a deletion can be investigated
+    user_id    UUID                    NULL,
+    -- the administrator, if the action was made on behalf of the user
+    actor_id   UUID                    NULL,
+    event_type VARCHAR(64)             NOT NULL,
+    outcome    VARCHAR(16)             NOT NULL,
+
+    ip         VARCHAR(64)  DEFAULT '' NOT NULL,
+    user_agent VARCHAR(512) DEFAULT '' NOT NULL,
+    session_id UUID                    NULL,
+    metadata   JSONB        DEFAULT '{}' NOT NULL,
+
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_events_user_id_created_at ON security_events (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_security_events_created_at ON security_events (created_at);
+
+-- deletes are rejected as well, except the ones of the retention job, which marks its transaction
+CREATE OR REPLACE FUNCTION reject_security_events_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' AND current_setting('auth.security_events_retention', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+
+    RAISE EXCEPTION 'security_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER security_events_append_only
+    BEFORE UPDATE OR DELETE
+    ON security_events
+    FOR EACH ROW
+EXECUTE FUNCTION reject_security_events_update();
+
+CREATE TRIGGER security_events_no_truncate
+    BEFORE TRUNCATE
+    ON security_events
+    FOR EACH STATEMENT
+EXECUTE FUNCTION reject_security_events_update();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS security_events_no_truncate ON security_events;
+DROP TRIGGER IF EXISTS security_events_append_only ON security_events;
+DROP FUNCTION IF EXISTS reject_security_events_update();
+DROP INDEX IF EXISTS idx_security_events_created_at;
+DROP INDEX IF EXISTS idx_security_events_user_id_created_at;
+DROP TABLE IF EXISTS security_events;
+-- +goose StatementEnd
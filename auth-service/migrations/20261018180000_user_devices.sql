@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the user is emailed when the account is signed in from a new device or location, unless opted out
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS notify_new_devices BOOLEAN DEFAULT TRUE NOT NULL;
+
+-- devices and locations the user has signed in from
+CREATE TABLE IF NOT EXISTS user_devices
+(
+    user_id      UUID                     NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    -- sha256 of the device identifier from the cookie or provided by the client
+    device_hash  BYTEA                    NOT NULL,
+    -- approximate location resolved by the proxy, empty if unknown
+    location     VARCHAR(128) DEFAULT ''  NOT NULL,
+
+    created_at   TIMESTAMP DEFAULT NOW()  NOT NULL,
+    last_seen_at TIMESTAMP DEFAULT NOW()  NOT NULL,
+
+    PRIMARY KEY (user_id, device_hash, location)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_devices;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS notify_new_devices;
+-- +goose StatementEnd
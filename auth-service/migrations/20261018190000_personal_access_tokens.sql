@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- long-lived tokens of the users for scripts and integrations, the token itself is shown only once
+CREATE TABLE IF NOT EXISTS personal_access_tokens
+(
+    id           UUID PRIMARY KEY,
+    user_id      UUID                    NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    name         VARCHAR(64)             NOT NULL,
+    -- sha256 of the token, tokens are random, so salt is not needed
+    token_hash   BYTEA                   NOT NULL UNIQUE,
+    scopes       TEXT[]                  NOT NULL,
+
+    expires_at   TIMESTAMP               NOT NULL,
+    last_used_at TIMESTAMP               NULL,
+    created_at   TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens (user_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_personal_access_tokens_user_id;
+DROP TABLE IF EXISTS personal_access_tokens;
+-- +goose StatementEnd
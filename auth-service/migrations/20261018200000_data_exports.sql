@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS data_exports
+(
+    id           UUID PRIMARY KEY,
+    user_id      UUID REFERENCES users (user_id) ON DELETE CASCADE NOT NULL,
+
+    -- pending, ready, failed or expired
+    status       VARCHAR(16) DEFAULT 'pending'                     NOT NULL,
+    -- the object in the exports bucket, removed when the export expires
+    archive_key  TEXT                                              NULL,
+
+    requested_at TIMESTAMP   DEFAULT NOW()                         NOT NULL,
+    completed_at TIMESTAMP                                         NULL,
+    expires_at   TIMESTAMP                                         NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_exports_user_id ON data_exports (user_id, requested_at DESC);
+CREATE INDEX IF NOT EXISTS idx_data_exports_pending ON data_exports (requested_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_data_exports_expires_at ON data_exports (expires_at) WHERE status = 'ready';
+
+-- the data collected by other services, either uploaded to the exports bucket or sent in the event
+CREATE TABLE IF NOT EXISTS data_export_parts
+(
+    export_id    UUID REFERENCES data_exports (id) ON DELETE CASCADE NOT NULL,
+    service      VARCHAR(100)                                        NOT NULL,
+    archive_key  TEXT                                                NULL,
+    data         JSONB                                               NULL,
+    completed_at TIMESTAMP                                           NOT NULL,
+
+    PRIMARY KEY (export_id, service)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS data_export_parts;
+DROP INDEX IF EXISTS idx_data_exports_expires_at;
+DROP INDEX IF EXISTS idx_data_exports_pending;
+DROP INDEX IF EXISTS idx_data_exports_user_id;
+DROP TABLE IF EXISTS data_exports;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- square sizes of the processed avatar, avatar_url keeps the largest one for the older clients
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS avatar_variants JSONB NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS avatar_variants;
+-- +goose StatementEnd
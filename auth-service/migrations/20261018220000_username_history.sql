@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- previous usernames keep resolving to the user and can not be taken by others until released_at
+CREATE TABLE IF NOT EXISTS username_history
+(
+    username    VARCHAR(150)            NOT NULL,
+    user_id     UUID                    NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    changed_at  TIMESTAMP DEFAULT NOW() NOT NULL,
+    released_at TIMESTAMP               NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_username_history_username_lower ON username_history (LOWER(username));
+CREATE INDEX IF NOT EXISTS idx_username_history_released_at ON username_history (released_at);
+
+-- usernames differing only in case could be registered before, the later ones get a suffix
+-- from their id, so that the usernames are unique ignoring the case
+WITH renamed AS (UPDATE users
+                 SET username = LEFT(users.username, 23) || '_' || LEFT(REPLACE(users.user_id::TEXT, '-', ''), 6)
+                 FROM (SELECT user_id,
+                              username,
+                              ROW_NUMBER() OVER (PARTITION BY LOWER(username) ORDER BY created_at, user_id) AS n
+                       FROM users) AS duplicates
+                 WHERE users.user_id = duplicates.user_id
+                   AND duplicates.n > 1
+                 RETURNING users.user_id, duplicates.username AS previous_username, duplicates.n)
+-- the previous username is recorded for the first renamed user of the name, the history is unique
+-- ignoring the case as well; it is released after the default USERNAMES_COOLDOWN
+INSERT
+INTO username_history (username, user_id, released_at)
+SELECT DISTINCT ON (LOWER(previous_username)) previous_username, user_id, NOW() + INTERVAL '720 hours'
+FROM renamed
+ORDER BY LOWER(previous_username), n
+ON CONFLICT ((LOWER(username))) DO NOTHING;
+
+DROP INDEX IF EXISTS idx_users_username;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username_lower ON users (LOWER(username));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_username_history_released_at;
+DROP INDEX IF EXISTS idx_username_history_username_lower;
+DROP TABLE IF EXISTS username_history;
+
+DROP INDEX IF EXISTS idx_users_username_lower;
+CREATE INDEX IF NOT EXISTS idx_users_username ON users (username);
+-- +goose StatementEnd
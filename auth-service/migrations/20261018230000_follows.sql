@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- follower sees the posts of followee in the feed
+CREATE TABLE IF NOT EXISTS follows
+(
+    follower_id UUID                    NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    followee_id UUID                    NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    created_at  TIMESTAMP DEFAULT NOW() NOT NULL,
+
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- the lists are paginated by (created_at, user id) from the newest follows
+CREATE INDEX IF NOT EXISTS idx_follows_followee_id ON follows (followee_id, created_at DESC, follower_id DESC);
+CREATE INDEX IF NOT EXISTS idx_follows_follower_id ON follows (follower_id, created_at DESC, followee_id DESC);
+
+-- denormalized counters, they are updated in the same transaction as the follows
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS followers_count BIGINT DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS following_count BIGINT DEFAULT 0 NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS following_count,
+    DROP COLUMN IF EXISTS followers_count;
+DROP INDEX IF EXISTS idx_follows_follower_id;
+DROP INDEX IF EXISTS idx_follows_followee_id;
+DROP TABLE IF EXISTS follows;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- incremented when all access tokens of the user are revoked, the tokens carry the revision they were issued with
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS tokens_revision BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS tokens_revision;
+-- +goose StatementEnd
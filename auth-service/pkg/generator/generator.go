@@ -1,8 +1,6 @@
 package generator
 
 import (
-	"math/rand"
-
 	"github.com/google/uuid"
 	"github.com/matoous/go-nanoid/v2"
 )
@@ -21,12 +19,17 @@ func (Generator) NewUUID() uuid.UUID {
 	return uuid.Must(uuid.NewV7())
 }
 
+// NumberCode returns random digits, generated with crypto/rand as the codes are used to confirm emails.
 func NumberCode(length int) string {
-	buf := make([]byte, length)
+	const digits = "0123456789"
+
+	return gonanoid.MustGenerate(digits, length)
+}
 
-	for i := range buf {
-		buf[i] = '0' + byte(rand.Intn(9))
-	}
+// unambiguous lowercase alphabet: without 0/o and 1/l
+const readableAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
 
-	return string(buf)
+// ReadableCode returns random code, which is easy to read and type manually.
+func ReadableCode(length int) string {
+	return gonanoid.MustGenerate(readableAlphabet, length)
 }
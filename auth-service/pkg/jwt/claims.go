@@ -10,6 +10,8 @@ type TokenUse string
 const (
 	AccessToken  TokenUse = "access"
 	RefreshToken TokenUse = "refresh"
+	// ServiceToken is issued to the service clients, its subject is the client id.
+	ServiceToken TokenUse = "service"
 )
 
 type UserAccessTokenClaims struct {
@@ -19,11 +21,16 @@ type UserAccessTokenClaims struct {
 	IsAdmin bool `json:"is_admin,omitempty"`
 
 	SessionID uuid.UUID `json:"session_id"`
+	// TokensRevision is the revision of the user's tokens at the time of issue, the tokens of
+	// the revisions older than the revoked one are rejected.
+	TokensRevision int64 `json:"tokens_revision,omitempty"`
 }
 
 const (
 	Issuer   = "inspire-auth"
 	Audience = "inspire-web"
+	// ServiceAudience is the audience of the service tokens, so that they are not accepted as user tokens.
+	ServiceAudience = "inspire-services"
 )
 
 type UserRefreshTokenClaims struct {
@@ -33,3 +40,10 @@ type UserRefreshTokenClaims struct {
 	SessionID    uuid.UUID `json:"session_id"`
 	SessionToken string    `json:"session_token"`
 }
+
+type ServiceTokenClaims struct {
+	jwt.RegisteredClaims
+	TokenUse TokenUse `json:"token_use"`
+
+	Scopes []string `json:"scopes"`
+}
@@ -4,15 +4,29 @@ go 1.24.3
 
 require (
 	connectrpc.com/authn v0.2.0
+	connectrpc.com/connect v1.18.1
 	github.com/MicahParks/keyfunc/v3 v3.3.11
 	github.com/go-errors/errors v1.5.1
 	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/google/uuid v1.6.0
+	github.com/nats-io/nats.go v1.43.0
+	github.com/tech-inspire/api-contracts v0.4.0
+	google.golang.org/protobuf v1.36.6
 )
 
 require (
-	connectrpc.com/connect v1.16.2 // indirect
+	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.5-20250307204501-0409229c3780.1 // indirect
 	github.com/MicahParks/jwkset v0.8.0 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
+	github.com/nats-io/nkeys v0.4.11 // indirect
+	github.com/nats-io/nuid v1.0.1 // indirect
+	golang.org/x/crypto v0.37.0 // indirect
+	golang.org/x/net v0.37.0 // indirect
+	golang.org/x/sys v0.32.0 // indirect
+	golang.org/x/text v0.24.0 // indirect
 	golang.org/x/time v0.9.0 // indirect
-	google.golang.org/protobuf v1.34.2 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250224174004-546df14abb99 // indirect
+	google.golang.org/grpc v1.70.0 // indirect
 )
+
+replace github.com/tech-inspire/api-contracts => ../../../api-contracts
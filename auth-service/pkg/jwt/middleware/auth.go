@@ -6,15 +6,62 @@ import (
 
 	"connectrpc.com/authn"
 	"github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
 )
 
-func New(m *jwt.Validator, noAuthenticationProcedures []string) func(_ context.Context, req *http.Request) (any, error) {
+type options struct {
+	revocations    *revocation.List
+	policy         Policy
+	personalTokens PersonalTokenValidator
+}
+
+// PersonalTokenValidator checks personal access tokens, they are known only to auth-service.
+type PersonalTokenValidator interface {
+	ValidatePersonalToken(ctx context.Context, token string) (*jwt.ValidateUserAccessTokenOutput, error)
+}
+
+type Option func(*options)
+
+// WithRevocationList rejects access tokens of revoked sessions before they expire.
+func WithRevocationList(list *revocation.List) Option {
+	return func(o *options) {
+		o.revocations = list
+	}
+}
+
+// WithPolicy skips authentication of the public procedures of the policy and accepts service tokens
+// for the procedures allowed for RoleService. The rules are enforced by Policy.Interceptor.
+func WithPolicy(policy Policy) Option {
+	return func(o *options) {
+		o.policy = policy
+	}
+}
+
+// WithPersonalTokens accepts personal access tokens alongside the access tokens. Their scopes are checked
+// by Policy.Interceptor, so they should be used together with WithPolicy.
+func WithPersonalTokens(validator PersonalTokenValidator) Option {
+	return func(o *options) {
+		o.personalTokens = validator
+	}
+}
+
+func New(m *jwt.Validator, noAuthenticationProcedures []string, opts ...Option) func(_ context.Context, req *http.Request) (any, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	noAuthenticationList := make(map[string]struct{}, len(noAuthenticationProcedures))
 	for _, procedure := range noAuthenticationProcedures {
 		noAuthenticationList[procedure] = struct{}{}
 	}
+	for procedure, rule := range o.policy {
+		if rule.Public {
+			noAuthenticationList[procedure] = struct{}{}
+		}
+	}
 
-	return func(_ context.Context, req *http.Request) (any, error) {
+	return func(ctx context.Context, req *http.Request) (any, error) {
 		// Infer the procedure from the request URL.
 		procedure, _ := authn.InferProcedure(req.URL)
 
@@ -30,15 +77,47 @@ func New(m *jwt.Validator, noAuthenticationProcedures []string) func(_ context.C
 			return nil, err
 		}
 
+		if jwt.IsPersonalToken(token) {
+			if o.personalTokens == nil {
+				return nil, authn.Errorf("invalid token: personal access tokens are not accepted")
+			}
+
+			out, err := o.personalTokens.ValidatePersonalToken(ctx, token)
+			if err != nil {
+				return nil, authn.Errorf("invalid token: %w", err)
+			}
+
+			return out, nil
+		}
+
+		// scopes of the service token are checked by the policy interceptor
+		if rule, ok := o.policy[procedure]; ok && rule.allows(RoleService) {
+			if out, err := m.ValidateServiceToken(token); err == nil {
+				return out, nil
+			}
+		}
+
 		out, err := m.ValidateUserAccessToken(token)
 		if err != nil {
 			return nil, authn.Errorf("invalid token: %w", err)
 		}
 
+		if o.revocations != nil && o.revocations.IsRevoked(out.UserID, out.SessionID, out.TokensRevision) {
+			return nil, authn.Errorf("invalid token: token was revoked")
+		}
+
 		return out, nil
 	}
 }
 
+// GetUserInfo panics if the request was authenticated with a service token,
+// use GetServiceInfo first in the procedures accepting them.
 func GetUserInfo(ctx context.Context) *jwt.ValidateUserAccessTokenOutput {
 	return authn.GetInfo(ctx).(*jwt.ValidateUserAccessTokenOutput)
 }
+
+// GetServiceInfo returns nil if the request was not authenticated with a service token.
+func GetServiceInfo(ctx context.Context) *jwt.ValidateServiceTokenOutput {
+	info, _ := authn.GetInfo(ctx).(*jwt.ValidateServiceTokenOutput)
+	return info
+}
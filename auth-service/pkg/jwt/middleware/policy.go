@@ -0,0 +1,207 @@
+package authmiddleware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type Role string
+
+const (
+	// RoleUser is any signed in user.
+	RoleUser Role = "user"
+	// RoleModerator is granted to admins until users have a separate moderator flag.
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+	// RoleService is a service client authenticated with a service token.
+	RoleService Role = "service"
+)
+
+// Reasons of PermissionDeniedError, sent to the clients in the error details.
+const (
+	ReasonRoleRequired  = "ROLE_REQUIRED"
+	ReasonScopeRequired = "SCOPE_REQUIRED"
+	ReasonNoPolicy      = "NO_POLICY"
+	// ReasonPersonalTokenNotAllowed is returned for the procedures without scopes.
+	ReasonPersonalTokenNotAllowed = "PERSONAL_TOKEN_NOT_ALLOWED"
+)
+
+// Rule is the access rule of the procedure.
+type Rule struct {
+	// Public procedures are called without authentication.
+	Public bool
+	// Roles allowed to call the procedure, any of them is enough.
+	Roles []Role
+	// Scopes are required from the service tokens and the personal access tokens, all of them.
+	// Personal access tokens can not call the procedures without scopes.
+	Scopes []string
+}
+
+func Public() Rule {
+	return Rule{Public: true}
+}
+
+func Roles(roles ...Role) Rule {
+	return Rule{Roles: roles}
+}
+
+// Service allows the procedure for the service tokens with the scopes.
+// Use Rule.Or to allow it for the users as well.
+func Service(scopes ...string) Rule {
+	return Rule{Roles: []Role{RoleService}, Scopes: scopes}
+}
+
+// Or allows the procedure for the roles in addition to the ones of the rule.
+func (r Rule) Or(roles ...Role) Rule {
+	r.Roles = append(slices.Clone(r.Roles), roles...)
+	return r
+}
+
+// WithScopes lets the personal access tokens with the scopes call the procedure.
+func (r Rule) WithScopes(scopes ...string) Rule {
+	r.Scopes = append(slices.Clone(r.Scopes), scopes...)
+	return r
+}
+
+func (r Rule) allows(role Role) bool {
+	return slices.Contains(r.Roles, role)
+}
+
+// Policy maps connect procedures to their rules. Procedures without rules are denied.
+type Policy map[string]Rule
+
+// CheckServiceDescriptor returns an error if any procedure of the services of the file has no rule.
+// The services call it before serving the file, so that a new procedure is not left without a rule.
+func (p Policy) CheckServiceDescriptor(fd protoreflect.FileDescriptor) error {
+	var missing []string
+
+	services := fd.Services()
+	for i := range services.Len() {
+		service := services.Get(i)
+
+		methods := service.Methods()
+		for j := range methods.Len() {
+			procedure := "/" + string(service.FullName()) + "/" + string(methods.Get(j).Name())
+			if _, ok := p[procedure]; !ok {
+				missing = append(missing, procedure)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s: no policy for procedures: %s", fd.Path(), strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// PermissionDeniedError is returned by the policy interceptor, the error interceptor of the service
+// converts it to connect.CodePermissionDenied with the reason in the details.
+type PermissionDeniedError struct {
+	Procedure string
+	Reason    string
+	// Required lists the roles or the scopes the caller lacks.
+	Required []string
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("permission denied for %s: %s %s", e.Procedure, e.Reason, strings.Join(e.Required, ","))
+}
+
+// Metadata is sent to the clients in the error details.
+func (e *PermissionDeniedError) Metadata() map[string]string {
+	return map[string]string{
+		"procedure": e.Procedure,
+		"required":  strings.Join(e.Required, ","),
+	}
+}
+
+// Interceptor enforces the policy for the callers authenticated by the middleware created with WithPolicy.
+func (p Policy) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if err := p.authorize(ctx, req.Spec().Procedure); err != nil {
+				return nil, err
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+func (p Policy) authorize(ctx context.Context, procedure string) error {
+	rule, ok := p[procedure]
+	if !ok {
+		return &PermissionDeniedError{Procedure: procedure, Reason: ReasonNoPolicy}
+	}
+
+	if rule.Public {
+		return nil
+	}
+
+	switch info := authn.GetInfo(ctx).(type) {
+	case *jwt.ValidateServiceTokenOutput:
+		if !rule.allows(RoleService) {
+			return roleRequired(procedure, rule)
+		}
+
+		return scopesRequired(procedure, rule, info.HasScope)
+
+	case *jwt.ValidateUserAccessTokenOutput:
+		if !slices.ContainsFunc(userRoles(info), rule.allows) {
+			return roleRequired(procedure, rule)
+		}
+
+		if info.PersonalToken() {
+			if len(rule.Scopes) == 0 {
+				return &PermissionDeniedError{Procedure: procedure, Reason: ReasonPersonalTokenNotAllowed}
+			}
+			return scopesRequired(procedure, rule, info.HasScope)
+		}
+
+		return nil
+
+	default:
+		return roleRequired(procedure, rule)
+	}
+}
+
+func userRoles(info *jwt.ValidateUserAccessTokenOutput) []Role {
+	if info.IsAdmin {
+		return []Role{RoleUser, RoleModerator, RoleAdmin}
+	}
+
+	return []Role{RoleUser}
+}
+
+// scopesRequired returns nil if the caller has every scope of the rule.
+func scopesRequired(procedure string, rule Rule, hasScope func(string) bool) error {
+	var missing []string
+	for _, scope := range rule.Scopes {
+		if !hasScope(scope) {
+			missing = append(missing, scope)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &PermissionDeniedError{Procedure: procedure, Reason: ReasonScopeRequired, Required: missing}
+	}
+
+	return nil
+}
+
+func roleRequired(procedure string, rule Rule) *PermissionDeniedError {
+	required := make([]string, 0, len(rule.Roles))
+	for _, role := range rule.Roles {
+		required = append(required, string(role))
+	}
+
+	return &PermissionDeniedError{Procedure: procedure, Reason: ReasonRoleRequired, Required: required}
+}
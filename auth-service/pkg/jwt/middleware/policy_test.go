@@ -0,0 +1,51 @@
+package authmiddleware
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestCheckServiceDescriptor(t *testing.T) {
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test/v1/test.proto"),
+		Package: proto.String("test.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Empty")},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("TestService"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("Get"), InputType: proto.String(".test.v1.Empty"), OutputType: proto.String(".test.v1.Empty")},
+					{Name: proto.String("Delete"), InputType: proto.String(".test.v1.Empty"), OutputType: proto.String(".test.v1.Empty")},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("build file descriptor: %v", err)
+	}
+
+	policy := Policy{
+		"/test.v1.TestService/Get": Public(),
+	}
+
+	err = policy.CheckServiceDescriptor(fd)
+	if err == nil {
+		t.Fatal("no error for the procedure without a rule")
+	}
+	if !strings.Contains(err.Error(), "/test.v1.TestService/Delete") || strings.Contains(err.Error(), "/test.v1.TestService/Get") {
+		t.Fatalf("error = %q, want only /test.v1.TestService/Delete reported", err)
+	}
+
+	policy["/test.v1.TestService/Delete"] = Roles(RoleAdmin)
+
+	if err = policy.CheckServiceDescriptor(fd); err != nil {
+		t.Fatalf("check covered policy: %v", err)
+	}
+}
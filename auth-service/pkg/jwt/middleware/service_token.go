@@ -0,0 +1,142 @@
+package authmiddleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/api-contracts/api/gen/go/auth/v1/authv1connect"
+)
+
+// refreshBefore is the time before the expiration, when the token is replaced,
+// so that the token does not expire while the request is in flight.
+const refreshBefore = 30 * time.Second
+
+// ServiceTokenSource requests a new service token from auth-service with the client credentials.
+type ServiceTokenSource func(ctx context.Context) (token string, expiresAt time.Time, err error)
+
+// NewServiceTokenSource requests the service tokens from auth-service at the url with the client credentials.
+func NewServiceTokenSource(url, clientID, clientSecret string, scopes ...string) ServiceTokenSource {
+	client := authv1connect.NewAuthServiceClient(http.DefaultClient, url)
+
+	return func(ctx context.Context) (string, time.Time, error) {
+		resp, err := client.IssueServiceToken(ctx, connect.NewRequest(&authv1.IssueServiceTokenRequest{
+			ClientId:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       scopes,
+		}))
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("auth service: IssueServiceToken(%s): %w", clientID, err)
+		}
+
+		return resp.Msg.ServiceToken, resp.Msg.ExpiresAt.AsTime(), nil
+	}
+}
+
+// ServiceTokenInterceptor adds the service token to the requests of the connect client.
+// The token is requested on the first call and refreshed before it expires.
+type ServiceTokenInterceptor struct {
+	source ServiceTokenSource
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+var _ connect.Interceptor = (*ServiceTokenInterceptor)(nil)
+
+func NewServiceTokenInterceptor(source ServiceTokenSource) *ServiceTokenInterceptor {
+	return &ServiceTokenInterceptor{source: source}
+}
+
+func (i *ServiceTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		token, err := i.getToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		setBearer(req.Header(), token)
+
+		resp, err := next(ctx, req)
+		i.invalidateIfRejected(token, err)
+
+		return resp, err
+	}
+}
+
+func (i *ServiceTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+
+		token, err := i.getToken(ctx)
+		if err != nil {
+			return &failedStreamingClientConn{StreamingClientConn: conn, err: err}
+		}
+		setBearer(conn.RequestHeader(), token)
+
+		return conn
+	}
+}
+
+func (*ServiceTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (i *ServiceTokenInterceptor) getToken(ctx context.Context) (string, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.token != "" && time.Until(i.expiresAt) > refreshBefore {
+		return i.token, nil
+	}
+
+	token, expiresAt, err := i.source(ctx)
+	if err != nil {
+		return "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("get service token: %w", err))
+	}
+
+	i.token, i.expiresAt = token, expiresAt
+
+	return token, nil
+}
+
+// invalidateIfRejected drops the token rejected by the server, e.g. when the signing key was rotated,
+// so that the next request gets a new one.
+func (i *ServiceTokenInterceptor) invalidateIfRejected(token string, err error) {
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.token == token {
+		i.token = ""
+	}
+}
+
+func setBearer(header http.Header, token string) {
+	header.Set("Authorization", "Bearer "+token)
+}
+
+// failedStreamingClientConn reports the error of getting the token on the first send.
+type failedStreamingClientConn struct {
+	connect.StreamingClientConn
+	err error
+}
+
+func (c *failedStreamingClientConn) Send(any) error {
+	return c.err
+}
+
+func (c *failedStreamingClientConn) Receive(any) error {
+	return c.err
+}
@@ -0,0 +1,11 @@
+package jwt
+
+import "strings"
+
+// PersonalTokenPrefix starts every personal access token. The tokens are opaque and are validated
+// by auth-service, the prefix tells them apart from JWTs and lets secret scanners find leaked ones.
+const PersonalTokenPrefix = "inspire_pat_"
+
+func IsPersonalToken(token string) bool {
+	return strings.HasPrefix(token, PersonalTokenPrefix)
+}
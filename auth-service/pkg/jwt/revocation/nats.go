@@ -0,0 +1,75 @@
+package revocation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Subject is used to publish revocation events. The stream should keep messages
+// for at least the access token duration, so that new subscribers receive all active revocations.
+const Subject = "auth.revocations"
+
+const cleanupInterval = time.Minute
+
+// Subscribe replays active revocations from the stream and keeps the list up to date.
+// Returned function stops the subscription.
+func Subscribe(js nats.JetStreamContext, list *List) (stop func() error, err error) {
+	sub, err := js.Subscribe(Subject, func(msg *nats.Msg) {
+		var event Event
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			slog.Error("unmarshal revocation event",
+				slog.String("subject", msg.Subject),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		list.Add(event)
+	},
+		nats.OrderedConsumer(),
+		nats.DeliverAll(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe to revocations: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(cleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				list.Cleanup(now)
+			}
+		}
+	}()
+
+	return func() error {
+		cancel()
+		return sub.Unsubscribe()
+	}, nil
+}
+
+// Publish sends revocation event to the stream.
+func Publish(ctx context.Context, js nats.JetStreamContext, streamName string, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal revocation event: %w", err)
+	}
+
+	if _, err = js.Publish(Subject, payload, nats.Context(ctx), nats.ExpectStream(streamName)); err != nil {
+		return fmt.Errorf("publish %s: %w", Subject, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Package revocation keeps the set of revoked access tokens, which is published by auth-service.
+// Access tokens are short-lived, so revocations are kept only until the affected tokens expire.
+package revocation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event revokes either a single session or all tokens of the user with a revision lower than TokensRevision.
+// Revisions are used instead of the issue time, as 'iat' has seconds precision and the tokens issued
+// right after the revocation could not be told apart from the revoked ones.
+type Event struct {
+	SessionID uuid.UUID `json:"session_id,omitzero"`
+
+	UserID         uuid.UUID `json:"user_id,omitzero"`
+	TokensRevision int64     `json:"tokens_revision,omitzero"`
+
+	// ExpiresAt is the time after which every affected token has expired.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type userRevocation struct {
+	tokensRevision int64
+	expiresAt      time.Time
+}
+
+// List is in-memory set of revocations, safe for concurrent use.
+type List struct {
+	mu       sync.RWMutex
+	sessions map[uuid.UUID]time.Time
+	users    map[uuid.UUID]userRevocation
+}
+
+func NewList() *List {
+	return &List{
+		sessions: make(map[uuid.UUID]time.Time),
+		users:    make(map[uuid.UUID]userRevocation),
+	}
+}
+
+func (l *List) Add(event Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if event.SessionID != uuid.Nil {
+		l.sessions[event.SessionID] = event.ExpiresAt
+	}
+
+	if event.UserID != uuid.Nil && event.TokensRevision > 0 {
+		if current, ok := l.users[event.UserID]; !ok || current.tokensRevision < event.TokensRevision {
+			l.users[event.UserID] = userRevocation{
+				tokensRevision: event.TokensRevision,
+				expiresAt:      event.ExpiresAt,
+			}
+		}
+	}
+}
+
+// IsRevoked reports whether the access token with the given claims was revoked.
+func (l *List) IsRevoked(userID, sessionID uuid.UUID, tokensRevision int64) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if _, ok := l.sessions[sessionID]; ok {
+		return true
+	}
+
+	if user, ok := l.users[userID]; ok && tokensRevision < user.tokensRevision {
+		return true
+	}
+
+	return false
+}
+
+// Cleanup removes revocations of the tokens that have already expired.
+func (l *List) Cleanup(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for sessionID, expiresAt := range l.sessions {
+		if now.After(expiresAt) {
+			delete(l.sessions, sessionID)
+		}
+	}
+
+	for userID, user := range l.users {
+		if now.After(user.expiresAt) {
+			delete(l.users, userID)
+		}
+	}
+}
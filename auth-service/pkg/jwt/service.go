@@ -0,0 +1,48 @@
+package jwt
+
+import (
+	"slices"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type ValidateServiceTokenOutput struct {
+	ClientID  string
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+func (o ValidateServiceTokenOutput) HasScope(scope string) bool {
+	return slices.Contains(o.Scopes, scope)
+}
+
+func (j Validator) ValidateServiceToken(serviceToken string) (*ValidateServiceTokenOutput, error) {
+	token, err := jwt.ParseWithClaims(serviceToken, new(ServiceTokenClaims), j.keyFunc,
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(ServiceAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, errors.Errorf("jwt: parse token: %w", err)
+	}
+
+	claims := token.Claims.(*ServiceTokenClaims)
+
+	if claims.TokenUse != ServiceToken {
+		return nil, errors.Errorf("jwt: invalid token used: expected '%s', got '%s'",
+			ServiceToken, claims.TokenUse,
+		)
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("jwt: 'sub' is empty")
+	}
+
+	return &ValidateServiceTokenOutput{
+		ClientID:  claims.Subject,
+		Scopes:    claims.Scopes,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
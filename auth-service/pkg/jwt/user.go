@@ -1,6 +1,7 @@
 package jwt
 
 import (
+	"slices"
 	"time"
 
 	"github.com/go-errors/errors"
@@ -9,10 +10,28 @@ import (
 )
 
 type ValidateUserAccessTokenOutput struct {
+	// SessionID is empty for personal access tokens.
 	SessionID uuid.UUID
 	UserID    uuid.UUID
+	IssuedAt  time.Time
 
 	IsAdmin bool
+	// TokensRevision is compared with the revocation list.
+	TokensRevision int64
+
+	// PersonalTokenID is set if the user is authenticated with a personal access token.
+	PersonalTokenID *uuid.UUID
+	// Scopes limit the personal access token, the access tokens of the sessions are not limited.
+	Scopes []string
+}
+
+func (o ValidateUserAccessTokenOutput) PersonalToken() bool {
+	return o.PersonalTokenID != nil
+}
+
+// HasScope is always true for the access tokens of the sessions.
+func (o ValidateUserAccessTokenOutput) HasScope(scope string) bool {
+	return !o.PersonalToken() || slices.Contains(o.Scopes, scope)
 }
 
 func (j Validator) ValidateUserAccessToken(accessToken string) (*ValidateUserAccessTokenOutput, error) {
@@ -34,10 +53,17 @@ func (j Validator) ValidateUserAccessToken(accessToken string) (*ValidateUserAcc
 		return nil, errors.Errorf("jwt: parse 'sub' into uuid: %w", err)
 	}
 
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+
 	return &ValidateUserAccessTokenOutput{
-		SessionID: claims.SessionID,
-		UserID:    userID,
-		IsAdmin:   claims.IsAdmin,
+		SessionID:      claims.SessionID,
+		UserID:         userID,
+		IssuedAt:       issuedAt,
+		IsAdmin:        claims.IsAdmin,
+		TokensRevision: claims.TokensRevision,
 	}, nil
 }
 
@@ -18,6 +18,8 @@ func NewValidatorFromKeyFunc(keyFunc jwt.Keyfunc) *Validator {
 	}
 }
 
+// NewValidatorFromURL creates validator from JWKS json or URL. Keys from the URL are refreshed
+// every hour and when a token signed with an unknown key is validated, so rotated keys are picked up without restart.
 func NewValidatorFromURL(jwksUrl string) (*Validator, error) {
 	var (
 		kf  keyfunc.Keyfunc
@@ -0,0 +1,82 @@
+// Package linktoken signs tokens of the links sent by email:
+//
+//	<purpose>.<id>.<expires at, unix seconds>.<signature>
+//
+// The signature lets the server reject forged and expired tokens without looking them up,
+// the id refers to the stored link, which makes the token single-use.
+package linktoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid link token")
+	ErrExpiredToken = errors.New("link token expired")
+)
+
+type Signer struct {
+	key []byte
+}
+
+func New(key string) *Signer {
+	return &Signer{key: []byte(key)}
+}
+
+// Sign expects purpose and id without dots.
+func (s Signer) Sign(purpose, id string, expiresAt time.Time) string {
+	payload := purpose + "." + id + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(s.signature(payload))
+}
+
+// Verify returns the id of the link if the token was signed for the purpose and has not expired.
+func (s Signer) Verify(token, purpose string, now time.Time) (id string, err error) {
+	payload, signature, ok := cut(token)
+	if !ok {
+		return "", ErrInvalidToken
+	}
+
+	actual, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(actual, s.signature(payload)) {
+		return "", ErrInvalidToken
+	}
+
+	parts := strings.Split(payload, ".")
+	if len(parts) != 3 || parts[0] != purpose {
+		return "", ErrInvalidToken
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+
+	if now.After(time.Unix(expiresAt, 0)) {
+		return "", ErrExpiredToken
+	}
+
+	return parts[1], nil
+}
+
+func (s Signer) signature(payload string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	_, _ = mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// cut splits the token by the last dot.
+func cut(token string) (payload, signature string, ok bool) {
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return "", "", false
+	}
+
+	return token[:i], token[i+1:], true
+}
@@ -17,7 +17,7 @@ func (l *Logger) Ctx(ctx context.Context) *Logger {
 
 func (l *Logger) With(fields ...any) *Logger {
 	return &Logger{
-		Logger:      l.Logger.With(fields),
+		Logger:      l.Logger.With(fields...),
 		Environment: l.Environment,
 	}
 }
@@ -0,0 +1,152 @@
+// Package password hashes passwords with argon2id and stores them in PHC string format:
+//
+//	$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+//
+// Hashes created with bcrypt are still verified, but they are reported as outdated.
+package password
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrInvalidHash = errors.New("invalid password hash")
+
+type Params struct {
+	// Memory is in KiB.
+	Memory      uint32
+	Time        uint32
+	Parallelism uint8
+
+	SaltLength uint32
+	KeyLength  uint32
+}
+
+type Hasher struct {
+	params Params
+	pepper []byte
+}
+
+// New creates hasher. If the pepper is not empty, it is mixed into every new hash,
+// so that leaked hashes could not be cracked without the server secret.
+// Changing the pepper makes existing argon2id hashes unverifiable.
+func New(params Params, pepper string) *Hasher {
+	return &Hasher{
+		params: params,
+		pepper: []byte(pepper),
+	}
+}
+
+func (h Hasher) Hash(password string) ([]byte, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("read random: %w", err)
+	}
+
+	key := argon2.IDKey(h.peppered(password), salt, h.params.Time, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	encoding := base64.RawStdEncoding
+	hash := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.params.Memory, h.params.Time, h.params.Parallelism,
+		encoding.EncodeToString(salt), encoding.EncodeToString(key),
+	)
+
+	return []byte(hash), nil
+}
+
+// Verify checks the password. needsRehash is true if the hash was created with
+// another algorithm or parameters, and it should be replaced with a new one.
+// Empty, unknown and malformed hashes, e.g. of the users without password, match no password.
+func (h Hasher) Verify(password string, hash []byte) (ok, needsRehash bool, err error) {
+	if isBcrypt(hash) {
+		err = bcrypt.CompareHashAndPassword(hash, []byte(password))
+		if err != nil {
+			return false, false, nil
+		}
+
+		return true, true, nil
+	}
+
+	params, salt, key, err := parseArgon2id(string(hash))
+	if err != nil {
+		// takes as long as a real check, so the users without password can not be told apart
+		_ = argon2.IDKey(h.peppered(password), make([]byte, h.params.SaltLength),
+			h.params.Time, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+		return false, false, nil
+	}
+
+	actual := argon2.IDKey(h.peppered(password), salt, params.Time, params.Memory, params.Parallelism, params.KeyLength)
+	if subtle.ConstantTimeCompare(actual, key) != 1 {
+		return false, false, nil
+	}
+
+	needsRehash = params.Memory != h.params.Memory ||
+		params.Time != h.params.Time ||
+		params.Parallelism != h.params.Parallelism ||
+		params.KeyLength != h.params.KeyLength ||
+		params.SaltLength != h.params.SaltLength
+
+	return true, needsRehash, nil
+}
+
+func (h Hasher) peppered(password string) []byte {
+	if len(h.pepper) == 0 {
+		return []byte(password)
+	}
+
+	mac := hmac.New(sha256.New, h.pepper)
+	_, _ = mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+func isBcrypt(hash []byte) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if bytes.HasPrefix(hash, []byte(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseArgon2id(hash string) (params Params, salt, key []byte, err error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Params{}, nil, nil, fmt.Errorf("%w: unsupported version", ErrInvalidHash)
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Parallelism)
+	if err != nil {
+		return Params{}, nil, nil, fmt.Errorf("%w: parse params: %w", ErrInvalidHash, err)
+	}
+
+	encoding := base64.RawStdEncoding
+
+	if salt, err = encoding.DecodeString(parts[4]); err != nil {
+		return Params{}, nil, nil, fmt.Errorf("%w: decode salt: %w", ErrInvalidHash, err)
+	}
+	if key, err = encoding.DecodeString(parts[5]); err != nil {
+		return Params{}, nil, nil, fmt.Errorf("%w: decode key: %w", ErrInvalidHash, err)
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
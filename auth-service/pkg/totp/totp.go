@@ -0,0 +1,100 @@
+// Package totp implements time-based one-time passwords (RFC 6238) compatible with
+// common authenticator applications: HMAC-SHA1, 6 digits, 30 seconds period.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // RFC 6238 default algorithm, supported by all authenticator apps
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	Digits     = 6
+	Period     = 30 * time.Second
+	SecretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns new random shared secret.
+func GenerateSecret() ([]byte, error) {
+	secret := make([]byte, SecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("read random: %w", err)
+	}
+
+	return secret, nil
+}
+
+// EncodeSecret returns base32 representation of the secret, which users can enter manually.
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// ProvisioningURI builds otpauth:// URI, which is usually rendered as a QR code.
+func ProvisioningURI(issuer, accountName string, secret []byte) string {
+	query := url.Values{}
+	query.Set("secret", EncodeSecret(secret))
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
+// Counter returns time step number for the given time.
+func Counter(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// Code generates code for the given time step.
+func Code(secret []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, secret)
+	_, _ = mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range Digits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
+
+// Validate checks the code against the time steps around t, allowing the clock drift of skew steps.
+// It returns the matched time step, so that the caller could reject its reuse.
+func Validate(secret []byte, code string, t time.Time, skew int) (counter int64, ok bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := Counter(t)
+	for i := -skew; i <= skew; i++ {
+		c := current + int64(i)
+		if subtle.ConstantTimeCompare([]byte(Code(secret, c)), []byte(code)) == 1 {
+			return c, true
+		}
+	}
+
+	return 0, false
+}
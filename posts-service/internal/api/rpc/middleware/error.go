@@ -9,6 +9,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-errors/errors"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
 	"github.com/tech-inspire/backend/posts-service/internal/apperrors"
 	"github.com/tech-inspire/backend/posts-service/internal/apperrors/codes"
 	"github.com/tech-inspire/backend/posts-service/pkg/logger"
@@ -52,6 +53,22 @@ func ErrorInterceptor(l *logger.Logger, serviceName string) connect.UnaryInterce
 				return nil, err
 			}
 
+			// denied by the policy of the procedure
+			deniedErr := new(authmiddleware.PermissionDeniedError)
+			if errors.As(err, &deniedErr) {
+				connectErr = connect.NewError(connect.CodePermissionDenied, err)
+				info := &errdetails.ErrorInfo{
+					Reason:   deniedErr.Reason,
+					Domain:   serviceName,
+					Metadata: deniedErr.Metadata(),
+				}
+				if detail, detailErr := connect.NewErrorDetail(info); detailErr == nil {
+					connectErr.AddDetail(detail)
+				}
+
+				return nil, connectErr
+			}
+
 			appError := new(apperrors.Error)
 			if errors.As(err, &appError) {
 				info := &errdetails.ErrorInfo{
@@ -0,0 +1,21 @@
+package rpc
+
+import (
+	"github.com/tech-inspire/api-contracts/api/gen/go/posts/v1/postsv1connect"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+// policy of the public and the internal posts services, checked against both files in RegisterRoutes.
+var policy = authmiddleware.Policy{
+	// the posts are shown to the visitors without account
+	postsv1connect.PostsServiceGetPostByIDProcedure: authmiddleware.Public(),
+	postsv1connect.PostsServiceGetPostsProcedure:    authmiddleware.Public(),
+
+	// the personal tokens with the scope can publish and delete the posts of the user
+	postsv1connect.PostsServiceAddPostProcedure:      authmiddleware.Roles(authmiddleware.RoleUser).WithScopes("posts:write"),
+	postsv1connect.PostsServiceDeletePostProcedure:   authmiddleware.Roles(authmiddleware.RoleUser).WithScopes("posts:write"),
+	postsv1connect.PostsServiceGetUploadUrlProcedure: authmiddleware.Roles(authmiddleware.RoleUser).WithScopes("posts:write"),
+
+	// the internal procedures are called by the other services only, with the service tokens
+	postsv1connect.PostsInternalServiceGetPostByIDProcedure: authmiddleware.Service("posts:read"),
+}
@@ -16,9 +16,11 @@ import (
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/cors"
+	postsv1 "github.com/tech-inspire/api-contracts/api/gen/go/posts/v1"
 	"github.com/tech-inspire/api-contracts/api/gen/go/posts/v1/postsv1connect"
 	authjwt "github.com/tech-inspire/backend/auth-service/pkg/jwt"
 	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
 	"github.com/tech-inspire/backend/posts-service/internal/api/metrics"
 	"github.com/tech-inspire/backend/posts-service/internal/api/rpc/handlers"
 	"github.com/tech-inspire/backend/posts-service/internal/api/rpc/middleware"
@@ -27,6 +29,7 @@ import (
 	"go.uber.org/fx"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func CORSMiddleware(cfg *config.Config) func(http.Handler) http.Handler {
@@ -49,6 +52,9 @@ type Params struct {
 	Logger *logger.Logger
 
 	JwtValidator *authjwt.Validator
+	Revocations  *revocation.List
+
+	PersonalTokenValidator authmiddleware.PersonalTokenValidator
 
 	PostsHandler *handlers.PostsHandler
 }
@@ -59,35 +65,54 @@ func RegisterRoutes(params Params, r *chi.Mux) error {
 		return fmt.Errorf("protovalidate: create interceptor: %w", err)
 	}
 
+	for _, file := range []protoreflect.FileDescriptor{
+		postsv1.File_posts_v1_posts_proto,
+		postsv1.File_posts_v1_internal_proto,
+	} {
+		if err = policy.CheckServiceDescriptor(file); err != nil {
+			return fmt.Errorf("policy: %w", err)
+		}
+	}
+
 	type postsService struct {
 		*handlers.PostsHandler
 	}
 
-	authServicePath, authServiceHandler := postsv1connect.NewPostsServiceHandler(
+	postsServicePath, postsServiceHandler := postsv1connect.NewPostsServiceHandler(
 		postsService{
 			params.PostsHandler,
 		},
 		connect.WithInterceptors(
 			middleware.ErrorInterceptor(params.Logger, postsv1connect.PostsServiceName),
+			policy.Interceptor(),
 			validateInterceptor,
 		),
 	)
 
-	// without auth
-	noAuthenticationProcedures := []string{
-		postsv1connect.PostsServiceGetPostByIDProcedure,
-		postsv1connect.PostsServiceGetPostsProcedure,
-	}
+	// the internal service is served on its own path, so that it can be kept off the public gateway
+	internalServicePath, internalServiceHandler := postsv1connect.NewPostsInternalServiceHandler(
+		params.PostsHandler,
+		connect.WithInterceptors(
+			middleware.ErrorInterceptor(params.Logger, postsv1connect.PostsInternalServiceName),
+			policy.Interceptor(),
+			validateInterceptor,
+		),
+	)
 
 	authMiddleware := authn.NewMiddleware(
-		authmiddleware.New(params.JwtValidator, noAuthenticationProcedures),
+		authmiddleware.New(params.JwtValidator, nil,
+			authmiddleware.WithRevocationList(params.Revocations),
+			authmiddleware.WithPolicy(policy),
+			authmiddleware.WithPersonalTokens(params.PersonalTokenValidator),
+		),
 	)
 
 	reflector := grpcreflect.NewStaticReflector(postsv1connect.PostsServiceName)
 	r.Mount(grpcreflect.NewHandlerV1(reflector))
 	r.Mount(grpcreflect.NewHandlerV1Alpha(reflector))
 
-	r.Mount(authServicePath, authMiddleware.Wrap(authServiceHandler))
+	r.Mount(postsServicePath, authMiddleware.Wrap(postsServiceHandler))
+	r.Mount(internalServicePath, authMiddleware.Wrap(internalServiceHandler))
 
 	return nil
 }
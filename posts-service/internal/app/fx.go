@@ -9,11 +9,14 @@ import (
 	redigo "github.com/redis/go-redis/v9"
 	"github.com/scylladb/gocqlx/v3"
 	"github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
 	"github.com/tech-inspire/backend/posts-service/internal/api/metrics"
 	"github.com/tech-inspire/backend/posts-service/internal/api/rpc"
 	"github.com/tech-inspire/backend/posts-service/internal/api/rpc/handlers"
 	"github.com/tech-inspire/backend/posts-service/internal/clients"
 	"github.com/tech-inspire/backend/posts-service/internal/config"
+	"github.com/tech-inspire/backend/posts-service/internal/consumer"
 	"github.com/tech-inspire/backend/posts-service/internal/repository/cache"
 	"github.com/tech-inspire/backend/posts-service/internal/repository/nats"
 	"github.com/tech-inspire/backend/posts-service/internal/repository/redis"
@@ -51,6 +54,11 @@ func Run() {
 		fx.Provide(func(cfg *config.Config) (*jwt.Validator, error) {
 			return jwt.NewValidatorFromURL(cfg.AuthJWKSPath)
 		}),
+		fx.Provide(revocation.NewList),
+		fx.Provide(
+			fx.Annotate(clients.NewPersonalTokenValidator, fx.As(new(authmiddleware.PersonalTokenValidator))),
+		),
+		fx.Invoke(consumer.StartRevocationsConsumer),
 
 		fx.Provide(
 			fx.Annotate(clients.NewRedis, fx.As(new(redigo.UniversalClient))),
@@ -63,19 +71,32 @@ func Run() {
 		),
 
 		fx.Provide(
+			clients.NewNatsJetstreamClient,
 			fx.Annotate(nats.NewPostsEventDispatcher, fx.As(new(service.PostsEventDispatcher))),
+			fx.Annotate(nats.NewUsersEventDispatcher,
+				fx.As(new(service.UserDeletionReporter)),
+				fx.As(new(service.UserExportReporter)),
+			),
 		),
 
 		fx.Provide(
 			clients.NewS3Client,
 			fx.Annotate(imagestorage.New, fx.As(new(service.ImageStorage))),
+			fx.Annotate(imagestorage.NewExportStorage, fx.As(new(service.ExportStorage))),
 		),
 
 		fx.Provide(
 
 			fx.Annotate(service.NewPostsService, fx.As(new(handlers.PostsService))),
+			fx.Annotate(service.NewUsersService,
+				fx.As(new(consumer.UsersEventProcessor)),
+				fx.As(new(consumer.UserExportProcessor)),
+			),
 		),
 
+		fx.Invoke(consumer.StartUserDeletedEventsConsumer),
+		fx.Invoke(consumer.StartUserExportRequestedEventsConsumer),
+
 		//
 
 		fx.Provide(
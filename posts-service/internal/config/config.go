@@ -20,12 +20,21 @@ type Config struct {
 		Endpoint         string `env:"S3_ENDPOINT,required"`
 		BucketName       string `env:"S3_BUCKET_NAME,required"`
 		MinioResolveMode bool   `env:"S3_MINIO_RESOLVE_MODE" envDefault:"false"`
+		// ExportsBucketName is shared with auth-service, which builds the data exports of the users.
+		ExportsBucketName string `env:"S3_EXPORTS_BUCKET_NAME" envDefault:"exports"`
 	}
 
 	DisableStackTrace bool `env:"DISABLE_STACK_TRACE"`
 
 	AuthJWKSPath string `env:"JWKS_PATH,required"`
 
+	// ServiceClient gets the service tokens from auth-service to call its internal procedures.
+	ServiceClient struct {
+		AuthServiceURL string `env:"AUTH_SERVICE_URL,required"`
+		ID             string `env:"SERVICE_CLIENT_ID" envDefault:"posts-service"`
+		Secret         string `env:"SERVICE_CLIENT_SECRET,required"`
+	}
+
 	ScyllaDB struct {
 		Hosts    []string `env:"SCYLLA_HOSTS,required"`
 		Username string   `env:"SCYLLA_USERNAME,required"`
@@ -36,6 +45,7 @@ type Config struct {
 	Nats struct {
 		URL             string `env:"NATS_URL,required"`
 		PostsStreamName string `env:"POSTS_STREAM_NAME,required"`
+		UsersStreamName string `env:"USERS_STREAM_NAME" envDefault:"USERS"`
 	}
 
 	Redis struct {
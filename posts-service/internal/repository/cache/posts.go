@@ -97,6 +97,10 @@ func (r PostsRepository) GetPostsByIDs(ctx context.Context, postIDs []uuid.UUID)
 	return out, nil
 }
 
+func (r PostsRepository) GetPostIDsByAuthor(ctx context.Context, authorID uuid.UUID) ([]uuid.UUID, error) {
+	return r.main.GetIDsByAuthor(ctx, authorID)
+}
+
 func (r PostsRepository) DeletePostByID(ctx context.Context, postID uuid.UUID) error {
 	err := r.main.Delete(ctx, postID)
 	if err != nil {
@@ -20,25 +20,11 @@ type PostsEventDispatcher struct {
 	streamName string
 }
 
-func NewPostsEventDispatcher(cfg *config.Config) (*PostsEventDispatcher, error) {
-	nc, err := nats.Connect(cfg.Nats.URL,
-		nats.Name("posts-service-event-dispatcher"),
-		nats.MaxReconnects(-1),
-		nats.ReconnectWait(5*time.Second),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("connect to nats: %w", err)
-	}
-
-	js, err := nc.JetStream()
-	if err != nil {
-		return nil, fmt.Errorf("get jetstream context: %w", err)
-	}
-
+func NewPostsEventDispatcher(js nats.JetStreamContext, cfg *config.Config) *PostsEventDispatcher {
 	return &PostsEventDispatcher{
 		js:         js,
 		streamName: cfg.Nats.PostsStreamName,
-	}, nil
+	}
 }
 
 func (d *PostsEventDispatcher) DispatchPostCreatedEvent(ctx context.Context, post *models.Post) error {
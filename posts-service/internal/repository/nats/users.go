@@ -0,0 +1,82 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/posts-service/internal/config"
+	"github.com/tech-inspire/backend/posts-service/internal/service/dto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// serviceName is reported to auth-service, which tracks the deletion and the export of the user data in every service.
+const serviceName = "posts-service"
+
+type UsersEventDispatcher struct {
+	js         nats.JetStreamContext
+	streamName string
+}
+
+func NewUsersEventDispatcher(js nats.JetStreamContext, cfg *config.Config) *UsersEventDispatcher {
+	return &UsersEventDispatcher{
+		js:         js,
+		streamName: cfg.Nats.UsersStreamName,
+	}
+}
+
+func (d *UsersEventDispatcher) ReportUserDeletionCompleted(ctx context.Context, userID uuid.UUID, completedAt time.Time) error {
+	data, err := proto.Marshal(&authv1.UserDeletionCompletedEvent{
+		UserId:      userID.String(),
+		Service:     serviceName,
+		CompletedAt: timestamppb.New(completedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	subject := fmt.Sprintf("users.%s.deletion_completed", userID)
+
+	pubOpts := []nats.PubOpt{
+		nats.Context(ctx),
+		nats.ExpectStream(d.streamName),
+	}
+	if _, err = d.js.Publish(subject, data, pubOpts...); err != nil {
+		return fmt.Errorf("publish %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+func (d *UsersEventDispatcher) ReportUserExportCompleted(
+	ctx context.Context, event dto.UserExportRequestedEvent, archiveKey string, completedAt time.Time,
+) error {
+	data, err := proto.Marshal(&authv1.UserExportPartCompletedEvent{
+		ExportId:    event.ExportID.String(),
+		UserId:      event.UserID.String(),
+		Service:     serviceName,
+		Part:        &authv1.UserExportPartCompletedEvent_ArchiveKey{ArchiveKey: archiveKey},
+		CompletedAt: timestamppb.New(completedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	subject := fmt.Sprintf("users.%s.export_part_completed", event.UserID)
+
+	pubOpts := []nats.PubOpt{
+		nats.Context(ctx),
+		nats.ExpectStream(d.streamName),
+		// the part is reported again if the export request is redelivered
+		nats.MsgId(event.ExportID.String() + ":" + serviceName),
+	}
+	if _, err = d.js.Publish(subject, data, pubOpts...); err != nil {
+		return fmt.Errorf("publish %s: %w", subject, err)
+	}
+
+	return nil
+}
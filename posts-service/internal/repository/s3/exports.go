@@ -0,0 +1,46 @@
+package imagestorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/posts-service/internal/config"
+)
+
+// ExportStorage uploads the parts of the data exports to the private bucket shared with auth-service.
+type ExportStorage struct {
+	client     *s3.Client
+	bucketName string
+}
+
+func NewExportStorage(cfg *config.Config, client *s3.Client) *ExportStorage {
+	return &ExportStorage{
+		client:     client,
+		bucketName: cfg.S3.ExportsBucketName,
+	}
+}
+
+func (ExportStorage) exportPartObjectName(exportID uuid.UUID) string {
+	return fmt.Sprintf("exports/%s/posts-service.zip", exportID)
+}
+
+func (s ExportStorage) UploadExportPart(ctx context.Context, exportID uuid.UUID, body io.ReadSeeker, size int64) (string, error) {
+	objectName := s.exportPartObjectName(exportID)
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Body:          body,
+		Bucket:        &s.bucketName,
+		Key:           &objectName,
+		ContentLength: &size,
+		ContentType:   aws.String("application/zip"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("put object %s: %w", objectName, err)
+	}
+
+	return objectName, nil
+}
@@ -3,6 +3,7 @@ package imagestorage
 import (
 	"context"
 	"fmt"
+	"io"
 	"path/filepath"
 	"time"
 
@@ -103,3 +104,17 @@ func (fs ImageStorage) DeletePostImage(ctx context.Context, postID uuid.UUID) er
 
 	return nil
 }
+
+func (fs ImageStorage) GetPostImage(ctx context.Context, postID uuid.UUID) (io.ReadCloser, string, error) {
+	objectName := fs.imageObjectName(postID)
+
+	out, err := fs.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &fs.bucketName,
+		Key:    &objectName,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("get object %s: %w", objectName, err)
+	}
+
+	return out.Body, aws.ToString(out.ContentType), nil
+}
@@ -81,6 +81,27 @@ func (r *PostsRepository) GetMany(ctx context.Context, ids []uuid.UUID) ([]*mode
 	return generics.Convert(posts, (*Post).toModel), nil
 }
 
+// GetIDsByAuthor lists ids of all posts of the author using the secondary index on author_id.
+func (r *PostsRepository) GetIDsByAuthor(ctx context.Context, authorID uuid.UUID) ([]uuid.UUID, error) {
+	stmt, names := qb.Select(postMetadata.Name).
+		Columns("post_id").
+		Where(qb.Eq("author_id")).
+		ToCql()
+
+	q := r.session.Query(stmt, names).
+		WithContext(ctx).
+		Bind(gocql.UUID(authorID))
+
+	var ids []gocql.UUID
+	if err := q.SelectRelease(&ids); err != nil {
+		return nil, fmt.Errorf("get author post ids: %w", err)
+	}
+
+	return generics.Convert(ids, func(id gocql.UUID) uuid.UUID {
+		return uuid.UUID(id)
+	}), nil
+}
+
 // Update replaces an existing Post. It must include the post_id.
 func (r *PostsRepository) Update(ctx context.Context, postID uuid.UUID, params dto.UpdatePostParams) (*models.Post, error) {
 	// stmt, names := postTable.Update().Where(qb.Eq("post_id")).ToCql()
@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserExportRequestedEvent is published by auth-service to users.<id>.export_requested.
+type UserExportRequestedEvent struct {
+	ExportID    uuid.UUID
+	UserID      uuid.UUID
+	RequestedAt time.Time
+}
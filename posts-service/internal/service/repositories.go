@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,12 +21,18 @@ type PostsRepository interface {
 	GetPostByID(ctx context.Context, postID uuid.UUID) (*models.Post, error)
 	GetPostsByIDs(ctx context.Context, postIDs []uuid.UUID) ([]*models.Post, error)
 	DeletePostByID(ctx context.Context, postID uuid.UUID) error
+	GetPostIDsByAuthor(ctx context.Context, authorID uuid.UUID) ([]uuid.UUID, error)
 }
 
 type ImageStorage interface {
 	GenerateTempImageUpload(ctx context.Context, params dto.GenerateImageUploadURLParams, expire time.Duration) (*dto.GeneratedImageUpload, error)
 	CreatePostImage(ctx context.Context, tempImageKey string, postID uuid.UUID) (*dto.CreatedPostImage, error)
 	DeletePostImage(ctx context.Context, postID uuid.UUID) error
+	GetPostImage(ctx context.Context, postID uuid.UUID) (body io.ReadCloser, contentType string, err error)
+}
+
+type ExportStorage interface {
+	UploadExportPart(ctx context.Context, exportID uuid.UUID, body io.ReadSeeker, size int64) (key string, err error)
 }
 
 type PostsEventDispatcher interface {
@@ -34,6 +41,14 @@ type PostsEventDispatcher interface {
 	DispatchPostDeletedEvent(ctx context.Context, post *models.Post, deletedAt time.Time) error
 }
 
+type UserDeletionReporter interface {
+	ReportUserDeletionCompleted(ctx context.Context, userID uuid.UUID, completedAt time.Time) error
+}
+
+type UserExportReporter interface {
+	ReportUserExportCompleted(ctx context.Context, event dto.UserExportRequestedEvent, archiveKey string, completedAt time.Time) error
+}
+
 type PendingImagesRepository interface {
 	Add(ctx context.Context, s3Key string, expiry time.Time) error
 	Remove(ctx context.Context, keys ...string) error
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UsersService struct {
+	repo           PostsRepository
+	imageStorage   ImageStorage
+	exportStorage  ExportStorage
+	dispatcher     PostsEventDispatcher
+	reporter       UserDeletionReporter
+	exportReporter UserExportReporter
+}
+
+func NewUsersService(
+	repo PostsRepository,
+	imageStorage ImageStorage,
+	exportStorage ExportStorage,
+	dispatcher PostsEventDispatcher,
+	reporter UserDeletionReporter,
+	exportReporter UserExportReporter,
+) *UsersService {
+	return &UsersService{
+		repo:           repo,
+		imageStorage:   imageStorage,
+		exportStorage:  exportStorage,
+		dispatcher:     dispatcher,
+		reporter:       reporter,
+		exportReporter: exportReporter,
+	}
+}
+
+// ProcessUserDeleted deletes all posts of the user with their images and reports it to auth-service.
+// The event is redelivered on failure, posts deleted before that are not found again.
+func (s UsersService) ProcessUserDeleted(ctx context.Context, userID uuid.UUID) error {
+	postIDs, err := s.repo.GetPostIDsByAuthor(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get author post ids: %w", err)
+	}
+
+	posts, err := s.repo.GetPostsByIDs(ctx, postIDs)
+	if err != nil {
+		return fmt.Errorf("get posts: %w", err)
+	}
+
+	for _, post := range posts {
+		if err = s.imageStorage.DeletePostImage(ctx, post.PostID); err != nil {
+			return fmt.Errorf("delete post image: %w", err)
+		}
+
+		if err = s.repo.DeletePostByID(ctx, post.PostID); err != nil {
+			return fmt.Errorf("delete post: %w", err)
+		}
+
+		// lets search-service drop the post even if it has not processed the user deletion yet
+		if err = s.dispatcher.DispatchPostDeletedEvent(ctx, post, time.Now()); err != nil {
+			return fmt.Errorf("dispatch post deleted event: %w", err)
+		}
+	}
+
+	slog.Info("purged posts of deleted user",
+		slog.String("user_id", userID.String()),
+		slog.Int("count", len(posts)),
+	)
+
+	if err = s.reporter.ReportUserDeletionCompleted(ctx, userID, time.Now()); err != nil {
+		return fmt.Errorf("report user deletion completed: %w", err)
+	}
+
+	return nil
+}
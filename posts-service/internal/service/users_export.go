@@ -0,0 +1,162 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/posts-service/internal/models"
+	"github.com/tech-inspire/backend/posts-service/internal/service/dto"
+)
+
+// imageExtensions are used for the names of the original images in the export.
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+type exportPost struct {
+	ID                       uuid.UUID     `json:"id"`
+	Description              string        `json:"description"`
+	SoundCloudSongURL        *string       `json:"soundcloud_song_url,omitempty"`
+	SoundCloudSongStartMilli *int          `json:"soundcloud_song_start_milli,omitempty"`
+	Images                   []exportImage `json:"images"`
+	// Media is the path of the original image in the archive.
+	Media     string    `json:"media"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportImage struct {
+	Variant models.VariantType `json:"variant"`
+	URL     string             `json:"url"`
+	Width   int                `json:"width"`
+	Height  int                `json:"height"`
+	Size    int32              `json:"size"`
+}
+
+// ProcessUserExportRequested uploads the archive with the posts of the user and their original images
+// to the exports bucket and reports it to auth-service. The archive is overwritten if the event is redelivered.
+func (s UsersService) ProcessUserExportRequested(ctx context.Context, event dto.UserExportRequestedEvent) error {
+	postIDs, err := s.repo.GetPostIDsByAuthor(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("get author post ids: %w", err)
+	}
+
+	posts, err := s.repo.GetPostsByIDs(ctx, postIDs)
+	if err != nil {
+		return fmt.Errorf("get posts: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "export-posts-*.zip")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	zw := zip.NewWriter(file)
+
+	exported := make([]exportPost, 0, len(posts))
+	for _, post := range posts {
+		media, err := s.writePostImage(ctx, zw, post.PostID)
+		if err != nil {
+			return fmt.Errorf("write image of post %s: %w", post.PostID, err)
+		}
+
+		exported = append(exported, exportPostOf(post, media))
+	}
+
+	w, err := zw.Create("posts.json")
+	if err != nil {
+		return fmt.Errorf("create posts.json: %w", err)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(exported); err != nil {
+		return fmt.Errorf("encode posts: %w", err)
+	}
+
+	if err = zw.Close(); err != nil {
+		return fmt.Errorf("close archive: %w", err)
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("get archive size: %w", err)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind archive: %w", err)
+	}
+
+	key, err := s.exportStorage.UploadExportPart(ctx, event.ExportID, file, size)
+	if err != nil {
+		return fmt.Errorf("upload export part: %w", err)
+	}
+
+	slog.Info("exported posts of user",
+		slog.String("user_id", event.UserID.String()),
+		slog.String("export_id", event.ExportID.String()),
+		slog.Int("count", len(posts)),
+	)
+
+	if err = s.exportReporter.ReportUserExportCompleted(ctx, event, key, time.Now()); err != nil {
+		return fmt.Errorf("report user export completed: %w", err)
+	}
+
+	return nil
+}
+
+// writePostImage copies the original image of the post to media/ and returns its path.
+func (s UsersService) writePostImage(ctx context.Context, zw *zip.Writer, postID uuid.UUID) (string, error) {
+	body, contentType, err := s.imageStorage.GetPostImage(ctx, postID)
+	if err != nil {
+		return "", fmt.Errorf("get post image: %w", err)
+	}
+	defer body.Close()
+
+	name := "media/" + postID.String() + imageExtensions[contentType]
+
+	// images are already compressed
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return "", fmt.Errorf("create %s: %w", name, err)
+	}
+
+	if _, err = io.Copy(w, body); err != nil {
+		return "", fmt.Errorf("copy %s: %w", name, err)
+	}
+
+	return name, nil
+}
+
+func exportPostOf(post *models.Post, media string) exportPost {
+	images := make([]exportImage, len(post.Images))
+	for i, image := range post.Images {
+		images[i] = exportImage{
+			Variant: image.VariantType,
+			URL:     image.URL,
+			Width:   image.Width,
+			Height:  image.Height,
+			Size:    image.Size,
+		}
+	}
+
+	return exportPost{
+		ID:                       post.PostID,
+		Description:              post.Description,
+		SoundCloudSongURL:        post.SoundCloudSongURL,
+		SoundCloudSongStartMilli: post.SoundCloudSongStartMilli,
+		Images:                   images,
+		Media:                    media,
+		CreatedAt:                post.CreatedAt,
+	}
+}
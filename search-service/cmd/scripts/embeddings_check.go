@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nats-io/nats.go"
 	pgxvec "github.com/pgvector/pgvector-go/pgx"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
 	"github.com/tech-inspire/backend/search-service/internal/clients"
 	"github.com/tech-inspire/backend/search-service/internal/config"
 	natsrepo "github.com/tech-inspire/backend/search-service/internal/repository/nats"
@@ -20,6 +21,7 @@ type Config struct {
 	config.Database
 	config.Nats
 	config.ImageEmbeddings
+	ServiceClient   config.ServiceClient
 	PostsServiceURL string `env:"POSTS_SERVICE_URL,required"`
 }
 
@@ -66,7 +68,11 @@ func main() {
 		log.Fatalf("create dispatcher: %w", err)
 	}
 
-	client, err := clients.NewPostsServiceClient(cfg.PostsServiceURL)
+	tokenSource := authmiddleware.NewServiceTokenSource(cfg.ServiceClient.AuthServiceURL,
+		cfg.ServiceClient.ID, cfg.ServiceClient.Secret, "posts:read",
+	)
+
+	client, err := clients.NewPostsServiceClient(cfg.PostsServiceURL, tokenSource)
 	if err != nil {
 		log.Fatalf("create posts service: %w", err)
 	}
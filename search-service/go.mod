@@ -116,3 +116,7 @@ require (
 	modernc.org/memory v1.10.0 // indirect
 	modernc.org/sqlite v1.37.0 // indirect
 )
+
+replace github.com/tech-inspire/api-contracts => ../api-contracts
+
+replace github.com/tech-inspire/backend/auth-service/pkg/jwt => ../auth-service/pkg/jwt
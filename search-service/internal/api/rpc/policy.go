@@ -0,0 +1,12 @@
+package rpc
+
+import (
+	"github.com/tech-inspire/api-contracts/api/gen/go/search/v1/searchv1connect"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+)
+
+// policy of the search service. SearchPosts is the only procedure and it is public.
+var policy = authmiddleware.Policy{
+	// the search is available to the visitors without account
+	searchv1connect.SearchServiceSearchPostsProcedure: authmiddleware.Public(),
+}
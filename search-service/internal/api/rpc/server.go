@@ -16,9 +16,11 @@ import (
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/cors"
+	searchv1 "github.com/tech-inspire/api-contracts/api/gen/go/search/v1"
 	"github.com/tech-inspire/api-contracts/api/gen/go/search/v1/searchv1connect"
 	authjwt "github.com/tech-inspire/backend/auth-service/pkg/jwt"
 	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
 	"github.com/tech-inspire/backend/search-service/internal/api/metrics"
 	"github.com/tech-inspire/backend/search-service/internal/api/rpc/handlers"
 	"github.com/tech-inspire/backend/search-service/internal/api/rpc/middleware"
@@ -47,6 +49,9 @@ type Params struct {
 
 	Logger       *logger.Logger
 	JwtValidator *authjwt.Validator
+	Revocations  *revocation.List
+
+	PersonalTokenValidator authmiddleware.PersonalTokenValidator
 
 	SearchHandler *handlers.SearchHandler
 }
@@ -57,20 +62,25 @@ func RegisterRoutes(params Params, r *chi.Mux) error {
 		return fmt.Errorf("protovalidate: create interceptor: %w", err)
 	}
 
+	if err = policy.CheckServiceDescriptor(searchv1.File_search_v1_search_proto); err != nil {
+		return fmt.Errorf("policy: %w", err)
+	}
+
 	searchServicePath, searchService := searchv1connect.NewSearchServiceHandler(
 		params.SearchHandler,
 		connect.WithInterceptors(
 			middleware.ErrorInterceptor(params.Logger, searchv1connect.SearchServiceName),
+			policy.Interceptor(),
 			validateInterceptor,
 		),
 	)
 
-	noAuthenticationProcedures := []string{
-		searchv1connect.SearchServiceSearchPostsProcedure,
-	}
-
 	authMiddleware := authn.NewMiddleware(
-		authmiddleware.New(params.JwtValidator, noAuthenticationProcedures),
+		authmiddleware.New(params.JwtValidator, nil,
+			authmiddleware.WithRevocationList(params.Revocations),
+			authmiddleware.WithPolicy(policy),
+			authmiddleware.WithPersonalTokens(params.PersonalTokenValidator),
+		),
 	)
 
 	reflector := grpcreflect.NewStaticReflector(searchv1connect.SearchServiceName)
@@ -8,6 +8,8 @@ import (
 
 	nats "github.com/nats-io/nats.go"
 	"github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
 	"github.com/tech-inspire/backend/search-service/internal/api/metrics"
 	"github.com/tech-inspire/backend/search-service/internal/api/rpc"
 	"github.com/tech-inspire/backend/search-service/internal/api/rpc/handlers"
@@ -41,15 +43,25 @@ func Run() {
 			fx.Annotate(postgres.NewSearchRepository, fx.As(new(service.SearchRepository))),
 		),
 
+		fx.Provide(
+			fx.Annotate(clients.NewPersonalTokenValidator, fx.As(new(authmiddleware.PersonalTokenValidator))),
+		),
+
 		fx.Provide(clients.NewNatsJetstreamClient),
 		fx.Invoke(consumer.StartPostDeletedEventsConsumer),
 		fx.Invoke(consumer.StartPostCreatedEventsConsumer),
 		fx.Invoke(consumer.StartImageEmbeddingsUpdatesConsumer),
+		fx.Invoke(consumer.StartUserDeletedEventsConsumer),
+		fx.Invoke(consumer.StartUserExportRequestedEventsConsumer),
 
 		fx.Provide(
 			fx.Annotate(service.NewSearchService, fx.As(new(handlers.SearchService))),
 			fx.Annotate(service.NewSearchService, fx.As(new(consumer.PostsEventProcessor))),
 			fx.Annotate(service.NewSearchService, fx.As(new(consumer.ImageEmbeddingsUpdatesConsumerProcessor))),
+			fx.Annotate(service.NewUsersService,
+				fx.As(new(consumer.UsersEventProcessor)),
+				fx.As(new(consumer.UserExportProcessor)),
+			),
 		),
 
 		fx.Provide(
@@ -61,6 +73,10 @@ func Run() {
 					cfg.ImageEmbeddings.ImageURLBasePath,
 				)
 			}, fx.As(new(service.ImageEmbeddingsTaskManager))),
+
+			fx.Annotate(func(js nats.JetStreamContext, cfg *config.Config) *natsrepo.UsersEventDispatcher {
+				return natsrepo.NewUsersEventDispatcher(js, cfg.Nats.UsersStreamName)
+			}, fx.As(new(service.UserDeletionReporter)), fx.As(new(service.UserExportReporter))),
 		),
 
 		//
@@ -74,6 +90,8 @@ func Run() {
 		fx.Provide(func(cfg *config.Config) (*jwt.Validator, error) {
 			return jwt.NewValidatorFromURL(cfg.AuthJWKSPath)
 		}),
+		fx.Provide(revocation.NewList),
+		fx.Invoke(consumer.StartRevocationsConsumer),
 
 		fx.Invoke(metrics.NewServer),
 		fx.Invoke(metrics.RegisterCollectors),
@@ -0,0 +1,61 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/api-contracts/api/gen/go/auth/v1/authv1connect"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
+	"github.com/tech-inspire/backend/search-service/internal/config"
+)
+
+// PersonalTokenValidator checks the personal access tokens with auth-service, which is the only one knowing them.
+type PersonalTokenValidator struct {
+	client authv1connect.AuthInternalServiceClient
+}
+
+// NewPersonalTokenValidator requires the "personal_tokens:validate" scope for the service client.
+func NewPersonalTokenValidator(cfg *config.Config) *PersonalTokenValidator {
+	tokenSource := authmiddleware.NewServiceTokenSource(cfg.ServiceClient.AuthServiceURL,
+		cfg.ServiceClient.ID, cfg.ServiceClient.Secret, "personal_tokens:validate",
+	)
+
+	client := authv1connect.NewAuthInternalServiceClient(
+		http.DefaultClient,
+		cfg.ServiceClient.AuthServiceURL,
+		connect.WithInterceptors(authmiddleware.NewServiceTokenInterceptor(tokenSource)),
+	)
+
+	return &PersonalTokenValidator{client: client}
+}
+
+func (v PersonalTokenValidator) ValidatePersonalToken(ctx context.Context, token string) (*jwt.ValidateUserAccessTokenOutput, error) {
+	resp, err := v.client.ValidatePersonalToken(ctx, connect.NewRequest(&authv1.ValidatePersonalTokenRequest{
+		Token: token,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("auth service: ValidatePersonalToken: %w", err)
+	}
+
+	userID, err := uuid.Parse(resp.Msg.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	tokenID, err := uuid.Parse(resp.Msg.PersonalTokenId)
+	if err != nil {
+		return nil, fmt.Errorf("parse personal token id: %w", err)
+	}
+
+	return &jwt.ValidateUserAccessTokenOutput{
+		UserID:          userID,
+		IssuedAt:        resp.Msg.CreatedAt.AsTime(),
+		PersonalTokenID: &tokenID,
+		Scopes:          resp.Msg.Scopes,
+	}, nil
+}
@@ -9,17 +9,21 @@ import (
 	"github.com/google/uuid"
 	postsv1 "github.com/tech-inspire/api-contracts/api/gen/go/posts/v1"
 	"github.com/tech-inspire/api-contracts/api/gen/go/posts/v1/postsv1connect"
+	authmiddleware "github.com/tech-inspire/backend/auth-service/pkg/jwt/middleware"
 	"github.com/tech-inspire/backend/search-service/internal/service/dto"
 )
 
 type PostsServiceClient struct {
-	client postsv1connect.PostsServiceClient
+	client postsv1connect.PostsInternalServiceClient
 }
 
-func NewPostsServiceClient(url string) (*PostsServiceClient, error) {
-	c := postsv1connect.NewPostsServiceClient(
+// NewPostsServiceClient calls the internal procedures of posts-service with the service tokens of the source,
+// the client must be allowed the "posts:read" scope.
+func NewPostsServiceClient(url string, tokenSource authmiddleware.ServiceTokenSource) (*PostsServiceClient, error) {
+	c := postsv1connect.NewPostsInternalServiceClient(
 		http.DefaultClient,
 		url,
+		connect.WithInterceptors(authmiddleware.NewServiceTokenInterceptor(tokenSource)),
 	)
 
 	client := &PostsServiceClient{
@@ -15,6 +15,7 @@ type Server struct {
 type Nats struct {
 	URL             string `env:"NATS_URL,required"`
 	PostsStreamName string `env:"POSTS_STREAM_NAME,required"`
+	UsersStreamName string `env:"USERS_STREAM_NAME" envDefault:"USERS"`
 }
 
 type ImageEmbeddings struct {
@@ -26,6 +27,13 @@ type Database struct {
 	// RedisDSN    string `env:"REDIS_DSN,required"`
 }
 
+// ServiceClient gets the service tokens from auth-service to call the internal procedures of the other services.
+type ServiceClient struct {
+	AuthServiceURL string `env:"AUTH_SERVICE_URL,required"`
+	ID             string `env:"SERVICE_CLIENT_ID" envDefault:"search-service"`
+	Secret         string `env:"SERVICE_CLIENT_SECRET,required"`
+}
+
 type Config struct {
 	Server
 
@@ -35,6 +43,8 @@ type Config struct {
 
 	Database
 
+	ServiceClient ServiceClient
+
 	EmbeddingsClient struct {
 		URL string `env:"EMBEDDINGS_CLIENT_URL,required"`
 	}
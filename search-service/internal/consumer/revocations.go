@@ -0,0 +1,32 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/tech-inspire/backend/auth-service/pkg/jwt/revocation"
+	"go.uber.org/fx"
+)
+
+// StartRevocationsConsumer keeps the list of the access tokens revoked by auth-service up to date,
+// the auth middleware rejects them before they expire.
+func StartRevocationsConsumer(js nats.JetStreamContext, lc fx.Lifecycle, list *revocation.List) {
+	var stop func() error
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			var err error
+
+			stop, err = revocation.Subscribe(js, list)
+			if err != nil {
+				return fmt.Errorf("subscribe to revocations: %w", err)
+			}
+
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			return stop()
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/search-service/pkg/logger"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+)
+
+type UsersEventProcessor interface {
+	ProcessUserDeleted(ctx context.Context, userID uuid.UUID) error
+}
+
+func StartUserDeletedEventsConsumer(js nats.JetStreamContext, lc fx.Lifecycle, processor UsersEventProcessor) error {
+	process := func(msg *nats.Msg) error {
+		// only the id is set in the payload of the deleted user
+		var user authv1.User
+		if err := proto.Unmarshal(msg.Data, &user); err != nil {
+			// the message can not be processed anyway
+			_ = msg.Term()
+			return fmt.Errorf("unmarshal user deleted event: %w", err)
+		}
+
+		userID, err := uuid.Parse(user.Id)
+		if err != nil {
+			_ = msg.Term()
+			return fmt.Errorf("parse user id: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		err = processor.ProcessUserDeleted(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("handle user deleted event: %w", err)
+		}
+
+		err = msg.Ack()
+		if err != nil {
+			return fmt.Errorf("ack event: %w", err)
+		}
+
+		slog.Info("processed user deleted event", slog.String("sub", msg.Subject))
+
+		return nil
+	}
+
+	shutDownCtx, cancel := context.WithCancel(context.Background())
+
+	sub, err := js.QueueSubscribe(
+		"users.*.deleted",
+		"search-service-users-workers",
+		func(msg *nats.Msg) {
+			if err := process(msg); err != nil {
+				slog.Error("failed to process user deleted event",
+					slog.String("subject", msg.Subject),
+					logger.Error(err),
+				)
+			}
+		},
+		nats.Durable("search-service-consumer-users-deleted"),
+		nats.ManualAck(),
+		nats.AckWait(time.Minute),
+		nats.Context(shutDownCtx),
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			cancel()
+
+			err = sub.Drain()
+			if err != nil {
+				return fmt.Errorf("drain subscription: %w", err)
+			}
+
+			return nil
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	authv1 "github.com/tech-inspire/api-contracts/api/gen/go/auth/v1"
+	"github.com/tech-inspire/backend/search-service/internal/service/dto"
+	"github.com/tech-inspire/backend/search-service/pkg/logger"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+)
+
+type UserExportProcessor interface {
+	ProcessUserExportRequested(ctx context.Context, event dto.UserExportRequestedEvent) error
+}
+
+// StartUserExportRequestedEventsConsumer collects the search data of the users who have requested their data export.
+func StartUserExportRequestedEventsConsumer(js nats.JetStreamContext, lc fx.Lifecycle, processor UserExportProcessor) error {
+	process := func(msg *nats.Msg) error {
+		var payload authv1.UserExportRequestedEvent
+		if err := proto.Unmarshal(msg.Data, &payload); err != nil {
+			// the message can not be processed anyway
+			_ = msg.Term()
+			return fmt.Errorf("unmarshal user export requested event: %w", err)
+		}
+
+		event, err := userExportRequestedEventFromPB(&payload)
+		if err != nil {
+			_ = msg.Term()
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		err = processor.ProcessUserExportRequested(ctx, event)
+		if err != nil {
+			return fmt.Errorf("handle user export requested event: %w", err)
+		}
+
+		err = msg.Ack()
+		if err != nil {
+			return fmt.Errorf("ack event: %w", err)
+		}
+
+		slog.Info("processed user export requested event", slog.String("sub", msg.Subject))
+
+		return nil
+	}
+
+	shutDownCtx, cancel := context.WithCancel(context.Background())
+
+	sub, err := js.QueueSubscribe(
+		"users.*.export_requested",
+		"search-service-users-workers",
+		func(msg *nats.Msg) {
+			if err := process(msg); err != nil {
+				slog.Error("failed to process user export requested event",
+					slog.String("subject", msg.Subject),
+					logger.Error(err),
+				)
+			}
+		},
+		nats.Durable("search-service-consumer-users-export-requested"),
+		nats.ManualAck(),
+		nats.AckWait(time.Minute),
+		nats.Context(shutDownCtx),
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			cancel()
+
+			err = sub.Drain()
+			if err != nil {
+				return fmt.Errorf("drain subscription: %w", err)
+			}
+
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func userExportRequestedEventFromPB(payload *authv1.UserExportRequestedEvent) (dto.UserExportRequestedEvent, error) {
+	exportID, err := uuid.Parse(payload.ExportId)
+	if err != nil {
+		return dto.UserExportRequestedEvent{}, fmt.Errorf("parse export id: %w", err)
+	}
+
+	userID, err := uuid.Parse(payload.UserId)
+	if err != nil {
+		return dto.UserExportRequestedEvent{}, fmt.Errorf("parse user id: %w", err)
+	}
+
+	return dto.UserExportRequestedEvent{
+		ExportID:    exportID,
+		UserID:      userID,
+		RequestedAt: payload.RequestedAt.AsTime(),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
@@ -51,6 +52,46 @@ func (r SearchRepository) DeletePostInfo(ctx context.Context, postID uuid.UUID)
 	return nil
 }
 
+func (r SearchRepository) DeleteAuthorPosts(ctx context.Context, authorID uuid.UUID) (int64, error) {
+	res, err := r.pool.Exec(ctx, "DELETE FROM posts_search_info WHERE author_id = $1", authorID)
+	if err != nil {
+		return 0, fmt.Errorf("delete author posts: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
+
+type authorPostRow struct {
+	PostID             uuid.UUID `db:"post_id"`
+	Description        string    `db:"description"`
+	ImagePath          string    `db:"image_path"`
+	ImageWidth         int       `db:"image_width"`
+	ImageHeight        int       `db:"image_height"`
+	HasImageEmbeddings bool      `db:"has_image_embeddings"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+}
+
+func (r SearchRepository) GetAuthorPosts(ctx context.Context, authorID uuid.UUID) ([]dto.PostSearchInfo, error) {
+	rows, err := r.pool.Query(ctx, `SELECT post_id, description, image_path, image_width, image_height,
+       image_embedding IS NOT NULL AS has_image_embeddings, created_at, updated_at
+FROM posts_search_info
+WHERE author_id = $1
+ORDER BY created_at`, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[authorPostRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect query: %w", err)
+	}
+
+	return generics.Convert(results, func(row authorPostRow) dto.PostSearchInfo {
+		return dto.PostSearchInfo(row)
+	}), nil
+}
+
 func (r SearchRepository) UpsertImageEmbeddings(ctx context.Context, postID uuid.UUID, embeddings []float32) error {
 	v := pgvector.NewVector(embeddings)
 
@@ -23,3 +23,23 @@ type Iterator interface {
 	Err() error
 	PostIDs(yield func(uuid.UUID) bool)
 }
+
+// PostSearchInfo is the data stored for the search of the post, except the embeddings.
+type PostSearchInfo struct {
+	PostID      uuid.UUID
+	Description string
+	ImagePath   string
+	ImageWidth  int
+	ImageHeight int
+	// HasImageEmbeddings is false until the image is processed by embedding-service.
+	HasImageEmbeddings bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// UserExportRequestedEvent is published by auth-service to users.<id>.export_requested.
+type UserExportRequestedEvent struct {
+	ExportID    uuid.UUID
+	UserID      uuid.UUID
+	RequestedAt time.Time
+}
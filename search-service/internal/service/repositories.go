@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tech-inspire/backend/search-service/internal/service/dto"
@@ -20,4 +21,14 @@ type SearchRepository interface {
 	UpsertPost(ctx context.Context, params dto.CreatePostParams) error
 	UpsertImageEmbeddings(ctx context.Context, postID uuid.UUID, embeddings []float32) error
 	DeletePostInfo(ctx context.Context, postID uuid.UUID) error
+	DeleteAuthorPosts(ctx context.Context, authorID uuid.UUID) (int64, error)
+	GetAuthorPosts(ctx context.Context, authorID uuid.UUID) ([]dto.PostSearchInfo, error)
+}
+
+type UserDeletionReporter interface {
+	ReportUserDeletionCompleted(ctx context.Context, userID uuid.UUID, completedAt time.Time) error
+}
+
+type UserExportReporter interface {
+	ReportUserExportCompleted(ctx context.Context, event dto.UserExportRequestedEvent, data []byte, completedAt time.Time) error
 }
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tech-inspire/backend/search-service/internal/service/dto"
+	"github.com/tech-inspire/backend/search-service/pkg/generics"
+)
+
+type UsersService struct {
+	repo           SearchRepository
+	reporter       UserDeletionReporter
+	exportReporter UserExportReporter
+}
+
+func NewUsersService(repo SearchRepository, reporter UserDeletionReporter, exportReporter UserExportReporter) *UsersService {
+	return &UsersService{repo: repo, reporter: reporter, exportReporter: exportReporter}
+}
+
+// ProcessUserDeleted purges posts of the deleted user and reports it to auth-service.
+func (s *UsersService) ProcessUserDeleted(ctx context.Context, userID uuid.UUID) error {
+	deleted, err := s.repo.DeleteAuthorPosts(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("delete author posts: %w", err)
+	}
+
+	slog.Info("purged posts of deleted user",
+		slog.String("user_id", userID.String()),
+		slog.Int64("count", deleted),
+	)
+
+	if err = s.reporter.ReportUserDeletionCompleted(ctx, userID, time.Now()); err != nil {
+		return fmt.Errorf("report user deletion completed: %w", err)
+	}
+
+	return nil
+}
+
+type exportPost struct {
+	PostID             uuid.UUID `json:"post_id"`
+	Description        string    `json:"description"`
+	ImagePath          string    `json:"image_path"`
+	ImageWidth         int       `json:"image_width"`
+	ImageHeight        int       `json:"image_height"`
+	HasImageEmbeddings bool      `json:"has_image_embeddings"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// ProcessUserExportRequested reports the search data of the posts of the user to auth-service.
+// The embeddings are not exported, they are derived from the images exported by posts-service.
+func (s *UsersService) ProcessUserExportRequested(ctx context.Context, event dto.UserExportRequestedEvent) error {
+	posts, err := s.repo.GetAuthorPosts(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("get author posts: %w", err)
+	}
+
+	data, err := json.Marshal(struct {
+		Posts []exportPost `json:"posts"`
+	}{
+		Posts: generics.Convert(posts, func(post dto.PostSearchInfo) exportPost {
+			return exportPost(post)
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal export: %w", err)
+	}
+
+	slog.Info("exported search data of user",
+		slog.String("user_id", event.UserID.String()),
+		slog.String("export_id", event.ExportID.String()),
+		slog.Int("count", len(posts)),
+	)
+
+	if err = s.exportReporter.ReportUserExportCompleted(ctx, event, data, time.Now()); err != nil {
+		return fmt.Errorf("report user export completed: %w", err)
+	}
+
+	return nil
+}